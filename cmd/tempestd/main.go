@@ -16,9 +16,15 @@ func main() {
 	var (
 		address = "0.0.0.0"
 		port    = 20919
+		dataDir = "data"
+		// The policy token is read from the environment by default so it
+		// does not show up in process listings.
+		policyToken = os.Getenv("TEMPEST_POLICY_TOKEN")
 	)
 	flag.StringVar(&address, "a", address, "Bind tempest-core service to a specific TCP/IPv4 address")
 	flag.IntVar(&port, "p", port, "Bind tempest-core service to a specific TCP/IPv4 port")
+	flag.StringVar(&dataDir, "d", dataDir, "Directory for persistent data such as scheduled jobs; empty disables persistence")
+	flag.StringVar(&policyToken, "policy-token", policyToken, "Token that may manage the command policy (default $TEMPEST_POLICY_TOKEN); empty refuses policy changes")
 	flag.Parse()
 
 	listenAddr := fmt.Sprintf("%s:%d", address, port)
@@ -27,8 +33,10 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	server, err := launcher.Start(ctx, launcher.Options{
-		Address: address,
-		Port:    port,
+		Address:     address,
+		Port:        port,
+		DataDir:     dataDir,
+		PolicyToken: policyToken,
 		Callback: func() {
 			log.Printf("tempest-core server has stopped")
 			close(exited)
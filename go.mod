@@ -15,6 +15,8 @@ replace github.com/Yeah114/bdump => ./modules/Fatalder/modules/WaterStructure/mo
 require (
 	github.com/Yeah114/Fatalder v0.0.0-00010101000000-000000000000
 	github.com/Yeah114/FunShuttler v0.0.0-00010101000000-000000000000
+	github.com/Yeah114/blocks v0.0.0-00010101000000-000000000000
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250804133106-a7a43d27e69b
 	google.golang.org/grpc v1.76.0
 	google.golang.org/protobuf v1.36.10
 )
@@ -72,5 +74,4 @@ require (
 	golang.org/x/sys v0.36.0 // indirect
 	golang.org/x/term v0.35.0 // indirect
 	golang.org/x/text v0.29.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20250804133106-a7a43d27e69b // indirect
 )
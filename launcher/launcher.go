@@ -14,17 +14,25 @@ import (
 
 // Options defines the listening configuration for the embedded gRPC server.
 type Options struct {
-	Address  string
-	Port     int
-	Callback func()
+	Address string
+	Port    int
+	// DataDir holds persistent state such as scheduled jobs. Empty keeps
+	// everything in memory.
+	DataDir string
+	// PolicyToken lets callers presenting it in x-tempest-token manage the
+	// command policy. Empty leaves management to policy roles, so a fresh
+	// server refuses policy changes.
+	PolicyToken string
+	Callback    func()
 }
 
 // Server manages the lifecycle of a tempest-core gRPC server.
 type Server struct {
-	opts  Options
-	srv   *grpc.Server
-	lis   net.Listener
-	state *app.FatalderState
+	opts     Options
+	srv      *grpc.Server
+	lis      net.Listener
+	state    *app.FatalderState
+	services *core.Services
 
 	once sync.Once
 }
@@ -45,17 +53,24 @@ func Start(ctx context.Context, opts Options) (*Server, error) {
 	}
 
 	state := app.NewFatalderState()
-	services := core.NewServices(state)
+	state.SetDataDir(opts.DataDir)
+	state.CommandPolicy().SetManagerToken(opts.PolicyToken)
+	services, err := core.NewServices(state)
+	if err != nil {
+		_ = lis.Close()
+		return nil, fmt.Errorf("launcher: %w", err)
+	}
 
 	srv := grpc.NewServer()
 	services.Register(srv)
 	reflection.Register(srv)
 
 	l := &Server{
-		opts:  opts,
-		srv:   srv,
-		lis:   lis,
-		state: state,
+		opts:     opts,
+		srv:      srv,
+		lis:      lis,
+		state:    state,
+		services: services,
 	}
 
 	go func() {
@@ -95,6 +110,9 @@ func (s *Server) Stop() {
 		if s.lis != nil {
 			_ = s.lis.Close()
 		}
+		if s.services != nil {
+			s.services.Close()
+		}
 		if s.state != nil {
 			_ = s.state.Disconnect()
 		}
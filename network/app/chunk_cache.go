@@ -0,0 +1,263 @@
+package app
+
+import (
+	"bytes"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/blocks"
+)
+
+// ChunkPacketIDs lists the packets a ChunkCache consumes.
+var ChunkPacketIDs = []uint32{
+	packet.IDLevelChunk,
+	packet.IDSubChunk,
+	packet.IDUpdateBlock,
+	packet.IDUpdateBlockSynced,
+	packet.IDUpdateSubChunkBlocks,
+	packet.IDChangeDimension,
+}
+
+const (
+	// LevelChunk uses these sub-chunk counts when the client has to request
+	// sub-chunks separately through SubChunk packets.
+	subChunkRequestModeLimited   = math.MaxUint32 - 1
+	subChunkRequestModeLimitless = math.MaxUint32
+
+	overworldMinSubChunk = -4
+
+	// maxSubChunks bounds the cache; the sub-chunks received first are
+	// dropped once it is full.
+	maxSubChunks = 1 << 15
+)
+
+// BlockChange describes a block update observed by the cache.
+type BlockChange struct {
+	Position  [3]int32
+	Layer     uint32
+	RuntimeID uint32
+	// Previous is the cached runtime ID before the change; PreviousKnown is
+	// false when the surrounding sub-chunk had not been received yet.
+	Previous      uint32
+	PreviousKnown bool
+	Timestamp     time.Time
+}
+
+// BlockState is the decoded form of a block runtime ID.
+type BlockState struct {
+	RuntimeID uint32
+	Name      string
+	States    map[string]any
+	Known     bool
+}
+
+// LookupBlock resolves a runtime ID through the vendored block table.
+func LookupBlock(runtimeID uint32) BlockState {
+	name, states, found := blocks.RuntimeIDToState(runtimeID)
+	return BlockState{
+		RuntimeID: runtimeID,
+		Name:      name,
+		States:    states,
+		Known:     found,
+	}
+}
+
+type subChunkKey [3]int32
+
+// ChunkCache keeps the sub-chunks the bot has received so single blocks and
+// small areas can be read without decoding packets on the client side. Only
+// the dimension the bot is in is cached.
+type ChunkCache struct {
+	mu        sync.RWMutex
+	dimension int32
+	subChunks map[subChunkKey][]*blockStorage
+	// order lists the keys of subChunks by arrival for eviction.
+	order []subChunkKey
+
+	changes *Broadcast[BlockChange]
+}
+
+// NewChunkCache constructs an empty cache.
+func NewChunkCache() *ChunkCache {
+	return &ChunkCache{
+		subChunks: make(map[subChunkKey][]*blockStorage),
+		changes:   NewBroadcast[BlockChange](),
+	}
+}
+
+// HandlePacket applies a chunk or block packet to the cache.
+func (c *ChunkCache) HandlePacket(pk packet.Packet) {
+	now := time.Now()
+	switch p := pk.(type) {
+	case *packet.LevelChunk:
+		c.storeLevelChunk(p)
+	case *packet.SubChunk:
+		c.storeSubChunks(p)
+	case *packet.UpdateBlock:
+		c.setBlock(p.Position, p.Layer, p.NewBlockRuntimeID, now)
+	case *packet.UpdateBlockSynced:
+		c.setBlock(p.Position, p.Layer, p.NewBlockRuntimeID, now)
+	case *packet.UpdateSubChunkBlocks:
+		for _, entry := range p.Blocks {
+			c.setBlock(entry.BlockPos, 0, entry.BlockRuntimeID, now)
+		}
+		for _, entry := range p.Extra {
+			c.setBlock(entry.BlockPos, 1, entry.BlockRuntimeID, now)
+		}
+	case *packet.ChangeDimension:
+		c.mu.Lock()
+		c.dimension = p.Dimension
+		c.clearLocked()
+		c.mu.Unlock()
+	}
+}
+
+// Block returns the runtime ID at pos on layer. ok is false when the
+// containing sub-chunk has not been received.
+func (c *ChunkCache) Block(pos [3]int32, layer uint32) (runtimeID uint32, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.blockLocked(pos, layer)
+}
+
+// Area calls fn for every position inside the inclusive box min..max in
+// y, z, x order while holding a single read lock. Callers bound the volume.
+func (c *ChunkCache) Area(min, max [3]int32, layer uint32, fn func(pos [3]int32, runtimeID uint32, ok bool)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	// The counters are wider than the coordinates so a box ending at
+	// math.MaxInt32 still terminates.
+	for y := int64(min[1]); y <= int64(max[1]); y++ {
+		for z := int64(min[2]); z <= int64(max[2]); z++ {
+			for x := int64(min[0]); x <= int64(max[0]); x++ {
+				pos := [3]int32{int32(x), int32(y), int32(z)}
+				id, ok := c.blockLocked(pos, layer)
+				fn(pos, id, ok)
+			}
+		}
+	}
+}
+
+// Changes subscribes to block change notifications.
+func (c *ChunkCache) Changes(buffer int) (<-chan BlockChange, func()) {
+	return c.changes.Subscribe(buffer)
+}
+
+// Reset drops every cached sub-chunk.
+func (c *ChunkCache) Reset() {
+	c.mu.Lock()
+	c.dimension = 0
+	c.clearLocked()
+	c.mu.Unlock()
+}
+
+func (c *ChunkCache) clearLocked() {
+	c.subChunks = make(map[subChunkKey][]*blockStorage)
+	c.order = nil
+}
+
+// storeLocked caches a sub-chunk, evicting the oldest ones past
+// maxSubChunks.
+func (c *ChunkCache) storeLocked(key subChunkKey, layers []*blockStorage) {
+	if _, ok := c.subChunks[key]; !ok {
+		c.order = append(c.order, key)
+	}
+	c.subChunks[key] = layers
+	for len(c.subChunks) > maxSubChunks {
+		delete(c.subChunks, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+func (c *ChunkCache) blockLocked(pos [3]int32, layer uint32) (uint32, bool) {
+	layers, ok := c.subChunks[subChunkKey{pos[0] >> 4, pos[1] >> 4, pos[2] >> 4}]
+	if !ok {
+		return 0, false
+	}
+	if int(layer) >= len(layers) {
+		// Layers that were not sent, such as the liquid layer, are air.
+		return blocks.AIR_RUNTIMEID, true
+	}
+	return layers[layer].at(subChunkIndex(pos[0], pos[1], pos[2])), true
+}
+
+func (c *ChunkCache) storeLevelChunk(p *packet.LevelChunk) {
+	if p.CacheEnabled || p.SubChunkCount == 0 {
+		return
+	}
+	if p.SubChunkCount == subChunkRequestModeLimited || p.SubChunkCount == subChunkRequestModeLimitless {
+		return
+	}
+	buf := bytes.NewBuffer(p.RawPayload)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	minSubChunk := int32(0)
+	if c.dimension == 0 {
+		minSubChunk = overworldMinSubChunk
+	}
+	for i := uint32(0); i < p.SubChunkCount; i++ {
+		layers, yIndex, hasY, err := decodeSubChunk(buf)
+		if err != nil {
+			return
+		}
+		y := int32(i) + minSubChunk
+		if hasY {
+			y = int32(yIndex)
+		}
+		c.storeLocked(subChunkKey{p.Position[0], y, p.Position[1]}, layers)
+	}
+}
+
+func (c *ChunkCache) storeSubChunks(p *packet.SubChunk) {
+	if p.CacheEnabled {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if p.Dimension != c.dimension {
+		return
+	}
+	for _, entry := range p.SubChunkEntries {
+		key := subChunkKey{
+			p.Position[0] + int32(entry.Offset[0]),
+			p.Position[1] + int32(entry.Offset[1]),
+			p.Position[2] + int32(entry.Offset[2]),
+		}
+		switch entry.Result {
+		case protocol.SubChunkResultSuccess:
+			layers, _, _, err := decodeSubChunk(bytes.NewBuffer(entry.RawPayload))
+			if err != nil {
+				continue
+			}
+			c.storeLocked(key, layers)
+		case protocol.SubChunkResultSuccessAllAir:
+			c.storeLocked(key, []*blockStorage{uniformStorage(blocks.AIR_RUNTIMEID)})
+		}
+	}
+}
+
+func (c *ChunkCache) setBlock(pos protocol.BlockPos, layer uint32, runtimeID uint32, at time.Time) {
+	change := BlockChange{
+		Position:  [3]int32{pos[0], pos[1], pos[2]},
+		Layer:     layer,
+		RuntimeID: runtimeID,
+		Timestamp: at,
+	}
+	key := subChunkKey{pos[0] >> 4, pos[1] >> 4, pos[2] >> 4}
+	c.mu.Lock()
+	layers, ok := c.subChunks[key]
+	if ok && layer <= 1 {
+		for int(layer) >= len(layers) {
+			layers = append(layers, uniformStorage(blocks.AIR_RUNTIMEID))
+		}
+		c.subChunks[key] = layers
+		index := subChunkIndex(pos[0], pos[1], pos[2])
+		change.Previous, change.PreviousKnown = layers[layer].at(index), true
+		layers[layer].set(index, runtimeID)
+	}
+	c.mu.Unlock()
+	c.changes.Publish(change)
+}
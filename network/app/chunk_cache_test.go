@@ -0,0 +1,50 @@
+package app
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChunkCacheAreaAtCoordinateLimit(t *testing.T) {
+	c := NewChunkCache()
+	tests := []struct {
+		name     string
+		min, max [3]int32
+		want     int
+	}{
+		{name: "max corner", min: [3]int32{math.MaxInt32, math.MaxInt32, math.MaxInt32}, max: [3]int32{math.MaxInt32, math.MaxInt32, math.MaxInt32}, want: 1},
+		{name: "min corner", min: [3]int32{math.MinInt32, 0, math.MinInt32}, max: [3]int32{math.MinInt32 + 1, 0, math.MinInt32}, want: 2},
+		{name: "ending at max", min: [3]int32{math.MaxInt32 - 2, 0, 0}, max: [3]int32{math.MaxInt32, 0, 0}, want: 3},
+	}
+	for _, tt := range tests {
+		visited := 0
+		c.Area(tt.min, tt.max, 0, func(pos [3]int32, runtimeID uint32, ok bool) {
+			visited++
+			if ok {
+				t.Errorf("%s: %v reported cached in an empty cache", tt.name, pos)
+			}
+		})
+		if visited != tt.want {
+			t.Errorf("%s: visited %d positions, want %d", tt.name, visited, tt.want)
+		}
+	}
+}
+
+func TestChunkCacheEviction(t *testing.T) {
+	c := NewChunkCache()
+	c.mu.Lock()
+	for i := 0; i < maxSubChunks+10; i++ {
+		c.storeLocked(subChunkKey{int32(i), 0, 0}, nil)
+	}
+	c.storeLocked(subChunkKey{int32(maxSubChunks), 0, 0}, nil)
+	c.mu.Unlock()
+	if len(c.subChunks) != maxSubChunks || len(c.order) != maxSubChunks {
+		t.Fatalf("cache holds %d sub-chunks in %d slots, want %d", len(c.subChunks), len(c.order), maxSubChunks)
+	}
+	if _, ok := c.Block([3]int32{0, 0, 0}, 0); ok {
+		t.Error("oldest sub-chunk was not evicted")
+	}
+	if _, ok := c.subChunks[subChunkKey{int32(maxSubChunks + 9), 0, 0}]; !ok {
+		t.Error("newest sub-chunk was evicted")
+	}
+}
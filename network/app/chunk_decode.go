@@ -0,0 +1,169 @@
+package app
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const subChunkVolume = 4096
+
+// blockStorage is one layer of a sub-chunk. Indices is nil when every block
+// in the layer uses palette[0].
+type blockStorage struct {
+	palette []uint32
+	indices []uint16
+}
+
+func uniformStorage(runtimeID uint32) *blockStorage {
+	return &blockStorage{palette: []uint32{runtimeID}}
+}
+
+func (s *blockStorage) at(index int) uint32 {
+	if s.indices == nil {
+		return s.palette[0]
+	}
+	return s.palette[s.indices[index]]
+}
+
+func (s *blockStorage) set(index int, runtimeID uint32) {
+	paletteIndex := -1
+	for i, id := range s.palette {
+		if id == runtimeID {
+			paletteIndex = i
+			break
+		}
+	}
+	if paletteIndex == 0 && s.indices == nil {
+		return
+	}
+	if paletteIndex < 0 {
+		paletteIndex = len(s.palette)
+		s.palette = append(s.palette, runtimeID)
+	}
+	if s.indices == nil {
+		s.indices = make([]uint16, subChunkVolume)
+	}
+	s.indices[index] = uint16(paletteIndex)
+}
+
+// subChunkIndex converts local block coordinates into the storage index used
+// by the Bedrock sub-chunk layout (x, then z, then y).
+func subChunkIndex(x, y, z int32) int {
+	return int((x&15)<<8 | (z&15)<<4 | (y & 15))
+}
+
+// decodeSubChunk reads a network-encoded sub-chunk. The returned y index is
+// only meaningful when hasY is true (sub-chunk format version 9).
+func decodeSubChunk(buf *bytes.Buffer) (layers []*blockStorage, yIndex int8, hasY bool, err error) {
+	version, err := buf.ReadByte()
+	if err != nil {
+		return nil, 0, false, err
+	}
+	storageCount := byte(1)
+	switch version {
+	case 1:
+	case 8, 9:
+		if storageCount, err = buf.ReadByte(); err != nil {
+			return nil, 0, false, err
+		}
+		if version == 9 {
+			y, err := buf.ReadByte()
+			if err != nil {
+				return nil, 0, false, err
+			}
+			yIndex, hasY = int8(y), true
+		}
+	default:
+		return nil, 0, false, fmt.Errorf("unsupported sub-chunk version %d", version)
+	}
+	layers = make([]*blockStorage, 0, storageCount)
+	for i := byte(0); i < storageCount; i++ {
+		storage, err := decodeBlockStorage(buf)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		layers = append(layers, storage)
+	}
+	return layers, yIndex, hasY, nil
+}
+
+func decodeBlockStorage(buf *bytes.Buffer) (*blockStorage, error) {
+	header, err := buf.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	bitsPerBlock := int(header >> 1)
+	if header&1 != 1 {
+		return nil, errors.New("block storage is not runtime encoded")
+	}
+	if bitsPerBlock == 0 {
+		id, err := readVarint32(buf)
+		if err != nil {
+			return nil, err
+		}
+		return uniformStorage(uint32(id)), nil
+	}
+	if bitsPerBlock > 16 {
+		return nil, fmt.Errorf("invalid bits per block %d", bitsPerBlock)
+	}
+	blocksPerWord := 32 / bitsPerBlock
+	wordCount := (subChunkVolume + blocksPerWord - 1) / blocksPerWord
+	words := make([]uint32, wordCount)
+	if err := binary.Read(buf, binary.LittleEndian, words); err != nil {
+		return nil, err
+	}
+	paletteSize, err := readVarint32(buf)
+	if err != nil {
+		return nil, err
+	}
+	if paletteSize <= 0 || paletteSize > subChunkVolume {
+		return nil, fmt.Errorf("invalid palette size %d", paletteSize)
+	}
+	palette := make([]uint32, paletteSize)
+	for i := range palette {
+		id, err := readVarint32(buf)
+		if err != nil {
+			return nil, err
+		}
+		palette[i] = uint32(id)
+	}
+
+	mask := uint32(1)<<bitsPerBlock - 1
+	indices := make([]uint16, subChunkVolume)
+	for i := 0; i < subChunkVolume; i++ {
+		word := words[i/blocksPerWord]
+		value := (word >> ((i % blocksPerWord) * bitsPerBlock)) & mask
+		if int(value) >= len(palette) {
+			return nil, fmt.Errorf("palette index %d out of range", value)
+		}
+		indices[i] = uint16(value)
+	}
+	if len(palette) == 1 {
+		indices = nil
+	}
+	return &blockStorage{palette: palette, indices: indices}, nil
+}
+
+// readVarint32 reads a zig-zag encoded signed varint as used by the network
+// block palette.
+func readVarint32(r io.ByteReader) (int32, error) {
+	var ux uint32
+	for i := 0; i < 35; i += 7 {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		ux |= uint32(b&0x7f) << i
+		if b&0x80 == 0 {
+			x := int32(ux >> 1)
+			if ux&1 != 0 {
+				x = ^x
+			}
+			return x, nil
+		}
+	}
+	return 0, errors.New("varint32 overflows")
+}
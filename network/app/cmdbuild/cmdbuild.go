@@ -0,0 +1,145 @@
+// Package cmdbuild assembles Bedrock command fragments from untrusted input.
+// Every value that ends up inside a command goes through quoting or
+// validation here, so a player name or tag cannot change the shape of the
+// surrounding command.
+package cmdbuild
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidValue is wrapped by every validation error of this package.
+var ErrInvalidValue = errors.New("invalid command value")
+
+var identifierPattern = regexp.MustCompile(`^[a-z0-9_.\-]+(:[a-z0-9_.\-/]+)?$`)
+
+// Quote wraps s in double quotes, escaping backslashes and quotes. Control
+// characters are rejected because the command parser ends at line breaks.
+func Quote(s string) (string, error) {
+	if err := checkPrintable(s); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String(), nil
+}
+
+// Identifier validates a namespaced identifier such as an entity type.
+// Bare identifiers are accepted unchanged.
+func Identifier(id string) (string, error) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if !identifierPattern.MatchString(id) {
+		return "", fmt.Errorf("%w: identifier %q", ErrInvalidValue, id)
+	}
+	return id, nil
+}
+
+// Number formats a finite float without exponent notation.
+func Number(v float64) (string, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "", fmt.Errorf("%w: number %v", ErrInvalidValue, v)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64), nil
+}
+
+// CoordMode selects absolute, relative (~) or local (^) notation.
+type CoordMode int
+
+const (
+	Absolute CoordMode = iota
+	Relative
+	Local
+)
+
+// Coord is one axis of a position.
+type Coord struct {
+	Mode  CoordMode
+	Value float64
+}
+
+// Position is a command position such as "10 ~1 ~".
+type Position struct {
+	X, Y, Z Coord
+}
+
+// At returns an absolute position.
+func At(x, y, z float64) Position {
+	return Position{X: Coord{Value: x}, Y: Coord{Value: y}, Z: Coord{Value: z}}
+}
+
+// String renders c, omitting a zero offset for relative and local axes.
+func (c Coord) String() (string, error) {
+	prefix := ""
+	switch c.Mode {
+	case Absolute:
+	case Relative:
+		prefix = "~"
+	case Local:
+		prefix = "^"
+	default:
+		return "", fmt.Errorf("%w: coordinate mode %d", ErrInvalidValue, c.Mode)
+	}
+	if prefix != "" && c.Value == 0 {
+		return prefix, nil
+	}
+	n, err := Number(c.Value)
+	if err != nil {
+		return "", err
+	}
+	return prefix + n, nil
+}
+
+// String renders p. Local notation cannot be mixed with the other modes.
+func (p Position) String() (string, error) {
+	axes := [3]Coord{p.X, p.Y, p.Z}
+	local := 0
+	parts := make([]string, 3)
+	for i, c := range axes {
+		if c.Mode == Local {
+			local++
+		}
+		s, err := c.String()
+		if err != nil {
+			return "", err
+		}
+		parts[i] = s
+	}
+	if local != 0 && local != 3 {
+		return "", fmt.Errorf("%w: local coordinates cannot be mixed", ErrInvalidValue)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// Execute prefixes cmd with "execute as <selector> at @s run".
+func Execute(as Selector, cmd string) (string, error) {
+	sel, err := as.String()
+	if err != nil {
+		return "", err
+	}
+	if err := checkPrintable(cmd); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("execute as %s at @s run %s", sel, strings.TrimSpace(cmd)), nil
+}
+
+func checkPrintable(s string) error {
+	for _, r := range s {
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("%w: control character %U", ErrInvalidValue, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package cmdbuild
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "Steve", want: `"Steve"`},
+		{in: "", want: `""`},
+		{in: "two words", want: `"two words"`},
+		{in: `a" @a[`, want: `"a\" @a["`},
+		{in: `back\slash`, want: `"back\\slash"`},
+		{in: `\"`, want: `"\\\""`},
+		{in: "名字", want: `"名字"`},
+		{in: "line\nbreak", wantErr: true},
+		{in: "tab\t", wantErr: true},
+		{in: "del\x7f", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Quote(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("Quote(%q) error = %v, want ErrInvalidValue", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Quote(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "zombie", want: "zombie"},
+		{in: " Minecraft:Zombie ", want: "minecraft:zombie"},
+		{in: "mod:path/to.thing", want: "mod:path/to.thing"},
+		{in: "zombie]", wantErr: true},
+		{in: "a b", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "a:b:c", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Identifier(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("Identifier(%q) error = %v, want ErrInvalidValue", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Identifier(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+	tests := []struct {
+		name    string
+		pos     Position
+		want    string
+		wantErr bool
+	}{
+		{name: "absolute", pos: At(1, -2.5, 1e7), want: "1 -2.5 10000000"},
+		{name: "relative", pos: Position{X: Coord{Mode: Relative}, Y: Coord{Mode: Relative, Value: 1}, Z: Coord{Value: 3}}, want: "~ ~1 3"},
+		{name: "local", pos: Position{X: Coord{Mode: Local}, Y: Coord{Mode: Local}, Z: Coord{Mode: Local, Value: 2}}, want: "^ ^ ^2"},
+		{name: "mixed local", pos: Position{X: Coord{Mode: Local}, Y: Coord{}, Z: Coord{}}, wantErr: true},
+		{name: "nan", pos: At(math.NaN(), 0, 0), wantErr: true},
+		{name: "bad mode", pos: Position{X: Coord{Mode: 7}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := tt.pos.String()
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("%s: error = %v, want ErrInvalidValue", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %q, %v; want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestSelector(t *testing.T) {
+	count := 3
+	radius := 5.5
+	minScore, maxScore := int32(1), int32(10)
+	tests := []struct {
+		name    string
+		sel     Selector
+		want    string
+		wantErr bool
+	}{
+		{name: "bare", sel: Selector{Target: AllPlayers}, want: "@a"},
+		{name: "default target", sel: Selector{}, want: "@e"},
+		{name: "player named", sel: PlayerNamed(`a" @a[`), want: `@a[name="a\" @a["]`},
+		{name: "entity runtime id", sel: EntityByRuntimeID(42), want: "@e[runtime_id=42]"},
+		{
+			name: "tags and types",
+			sel: Selector{
+				Target:       Entities,
+				Tags:         []string{"x y"},
+				ExcludeTags:  []string{"z"},
+				Type:         "Zombie",
+				ExcludeTypes: []string{"minecraft:player"},
+				Count:        &count,
+				Radius:       &radius,
+			},
+			want: `@e[tag="x y",tag=!"z",type=zombie,type=!minecraft:player,c=3,r=5.5]`,
+		},
+		{
+			name: "scores",
+			sel: Selector{Target: AllPlayers, Scores: map[string]ScoreRange{
+				"b": {Min: &minScore},
+				"a": {Min: &minScore, Max: &maxScore},
+				"c": {Min: &maxScore, Max: &maxScore},
+			}},
+			want: `@a[scores={"a"=1..10,"b"=1..,"c"=10}]`,
+		},
+		{name: "origin", sel: Selector{Target: Entities, Origin: &Position{X: Coord{Value: 1}, Y: Coord{Mode: Relative}, Z: Coord{Value: -1}}}, want: "@e[x=1,y=~,z=-1]"},
+		{name: "local origin", sel: Selector{Origin: &Position{X: Coord{Mode: Local}, Y: Coord{Mode: Local}, Z: Coord{Mode: Local}}}, wantErr: true},
+		{name: "bad target", sel: Selector{Target: "@x"}, wantErr: true},
+		{name: "bad type", sel: Selector{Type: "zombie,c=1"}, wantErr: true},
+		{name: "inverted scores", sel: Selector{Scores: map[string]ScoreRange{"a": {Min: &maxScore, Max: &minScore}}}, wantErr: true},
+		{name: "empty scores", sel: Selector{Scores: map[string]ScoreRange{"a": {}}}, wantErr: true},
+		{name: "control in name", sel: PlayerNamed("a\nb"), wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := tt.sel.String()
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("%s: error = %v, want ErrInvalidValue", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %q, %v; want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestRawSelector(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "@a", want: "@a"},
+		{in: " @e[type=zombie, r=5] ", want: "@e[type=zombie, r=5]"},
+		{in: `@a[name="two words"]`, want: `@a[name="two words"]`},
+		{in: `@a[hasitem=[{item=apple}]]`, want: `@a[hasitem=[{item=apple}]]`},
+		{in: "Steve", want: "Steve"},
+		{in: `"Steve Jobs"`, want: `"Steve Jobs"`},
+		{in: `@a[name="a]b"]`, want: `@a[name="a]b"]`},
+		{in: "", wantErr: true},
+		{in: "@a op", wantErr: true},
+		{in: "@a[tag=x] add y", wantErr: true},
+		{in: "@a[tag=x]@e", wantErr: true},
+		{in: "@a[tag=x", wantErr: true},
+		{in: "@a]", wantErr: true},
+		{in: `"open`, wantErr: true},
+		{in: "@a\u3000x", wantErr: true},
+		{in: "@a[tag=x\nop @s]", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := RawSelector(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("RawSelector(%q) error = %v, want ErrInvalidValue", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("RawSelector(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestExecute(t *testing.T) {
+	got, err := Execute(PlayerNamed("Steve"), " say hi ")
+	if want := `execute as @a[name="Steve"] at @s run say hi`; err != nil || got != want {
+		t.Errorf("Execute = %q, %v; want %q", got, err, want)
+	}
+	if _, err := Execute(PlayerNamed("Steve"), "say hi\nop @a"); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Execute with line break error = %v, want ErrInvalidValue", err)
+	}
+}
+
+func TestRawText(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []Component
+		want       string
+		wantErr    bool
+	}{
+		{name: "text", components: []Component{Text(`<b> "q"`)}, want: `{"rawtext":[{"text":"<b> \"q\""}]}`},
+		{
+			name:       "translate",
+			components: []Component{{Translate: "chat.type.text", With: []Component{Text("a"), {Selector: &Selector{Target: Self}}}}},
+			want:       `{"rawtext":[{"translate":"chat.type.text","with":{"rawtext":[{"text":"a"},{"selector":"@s"}]}}]}`,
+		},
+		{name: "score", components: []Component{{Score: &ScoreComponent{Name: "*", Objective: "money"}}}, want: `{"rawtext":[{"score":{"name":"*","objective":"money"}}]}`},
+		{name: "score without objective", components: []Component{{Score: &ScoreComponent{Name: "*"}}}, wantErr: true},
+		{name: "bad selector", components: []Component{{Selector: &Selector{Target: "@q"}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := RawText(tt.components...)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("%s: error = %v, want ErrInvalidValue", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %s, %v; want %s", tt.name, got, err, tt.want)
+		}
+	}
+}
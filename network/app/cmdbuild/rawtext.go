@@ -0,0 +1,87 @@
+package cmdbuild
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Component is one element of a rawtext message. Exactly one of Text,
+// Translate, Selector or Score should be set.
+type Component struct {
+	Text string
+	// Translate is a language key; With supplies its parameters.
+	Translate string
+	With      []Component
+	Selector  *Selector
+	Score     *ScoreComponent
+}
+
+// ScoreComponent renders the score of Name on Objective.
+type ScoreComponent struct {
+	Name      string
+	Objective string
+}
+
+// Text is a plain text component.
+func Text(s string) Component {
+	return Component{Text: s}
+}
+
+// RawText renders components as the JSON argument of tellraw and titleraw.
+func RawText(components ...Component) (string, error) {
+	nodes, err := rawNodes(components)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(map[string]any{"rawtext": nodes}); err != nil {
+		return "", err
+	}
+	return string(bytes.TrimRight(buf.Bytes(), "\n")), nil
+}
+
+func rawNodes(components []Component) ([]map[string]any, error) {
+	nodes := make([]map[string]any, 0, len(components))
+	for _, c := range components {
+		node, err := c.node()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func (c Component) node() (map[string]any, error) {
+	switch {
+	case c.Translate != "":
+		node := map[string]any{"translate": c.Translate}
+		if len(c.With) > 0 {
+			with, err := rawNodes(c.With)
+			if err != nil {
+				return nil, err
+			}
+			node["with"] = map[string]any{"rawtext": with}
+		}
+		return node, nil
+	case c.Selector != nil:
+		sel, err := c.Selector.String()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"selector": sel}, nil
+	case c.Score != nil:
+		if c.Score.Objective == "" {
+			return nil, fmt.Errorf("%w: score component needs an objective", ErrInvalidValue)
+		}
+		return map[string]any{"score": map[string]string{
+			"name":      c.Score.Name,
+			"objective": c.Score.Objective,
+		}}, nil
+	default:
+		return map[string]any{"text": c.Text}, nil
+	}
+}
@@ -0,0 +1,247 @@
+package cmdbuild
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Target is the base of a target selector.
+type Target string
+
+const (
+	AllPlayers    Target = "@a"
+	Entities      Target = "@e"
+	NearestPlayer Target = "@p"
+	RandomPlayer  Target = "@r"
+	Self          Target = "@s"
+	Initiator     Target = "@initiator"
+)
+
+// ScoreRange bounds a scoreboard value; nil ends are open.
+type ScoreRange struct {
+	Min, Max *int32
+}
+
+// Selector describes a target selector with typed arguments. Zero-valued
+// fields are left out of the rendered selector.
+type Selector struct {
+	Target Target
+
+	Name         string
+	ExcludeNames []string
+	Tags         []string
+	ExcludeTags  []string
+	Type         string
+	ExcludeTypes []string
+
+	Count     *int
+	RuntimeID *uint64
+	Origin    *Position
+	Radius    *float64
+	MinRadius *float64
+	Scores    map[string]ScoreRange
+}
+
+// PlayerNamed selects the online player with the exact name.
+func PlayerNamed(name string) Selector {
+	return Selector{Target: AllPlayers, Name: name}
+}
+
+// EntityByRuntimeID selects the entity with the runtime ID.
+func EntityByRuntimeID(runtimeID uint64) Selector {
+	return Selector{Target: Entities, RuntimeID: &runtimeID}
+}
+
+// RawSelector checks a selector written by hand, such as @a[tag=x] or a
+// player name, so it cannot carry extra command text. Whitespace is only
+// allowed inside brackets or quotes, brackets must balance and nothing may
+// follow the closing bracket.
+func RawSelector(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", fmt.Errorf("%w: empty selector", ErrInvalidValue)
+	}
+	if err := checkPrintable(s); err != nil {
+		return "", err
+	}
+	depth := 0
+	quoted, escaped := false, false
+	for i, r := range s {
+		if quoted {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				quoted = false
+			}
+			continue
+		}
+		switch {
+		case r == '"':
+			quoted = true
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+			if depth < 0 {
+				return "", fmt.Errorf("%w: unbalanced ] in selector %q", ErrInvalidValue, s)
+			}
+			if depth == 0 && i != len(s)-1 {
+				return "", fmt.Errorf("%w: text after selector %q", ErrInvalidValue, s)
+			}
+		case depth == 0 && unicode.IsSpace(r):
+			return "", fmt.Errorf("%w: whitespace in selector %q", ErrInvalidValue, s)
+		}
+	}
+	if quoted || depth != 0 {
+		return "", fmt.Errorf("%w: unterminated selector %q", ErrInvalidValue, s)
+	}
+	return s, nil
+}
+
+// String renders the selector with every argument escaped.
+func (s Selector) String() (string, error) {
+	switch s.Target {
+	case AllPlayers, Entities, NearestPlayer, RandomPlayer, Self, Initiator:
+	case "":
+		s.Target = Entities
+	default:
+		return "", fmt.Errorf("%w: selector target %q", ErrInvalidValue, s.Target)
+	}
+
+	var args []string
+	add := func(key, value string) {
+		args = append(args, key+"="+value)
+	}
+	quoted := func(key, value string, negate bool) error {
+		q, err := Quote(value)
+		if err != nil {
+			return err
+		}
+		if negate {
+			q = "!" + q
+		}
+		add(key, q)
+		return nil
+	}
+
+	if s.Name != "" {
+		if err := quoted("name", s.Name, false); err != nil {
+			return "", err
+		}
+	}
+	for _, name := range s.ExcludeNames {
+		if err := quoted("name", name, true); err != nil {
+			return "", err
+		}
+	}
+	for _, tag := range s.Tags {
+		if err := quoted("tag", tag, false); err != nil {
+			return "", err
+		}
+	}
+	for _, tag := range s.ExcludeTags {
+		if err := quoted("tag", tag, true); err != nil {
+			return "", err
+		}
+	}
+	if s.Type != "" {
+		id, err := Identifier(s.Type)
+		if err != nil {
+			return "", err
+		}
+		add("type", id)
+	}
+	for _, t := range s.ExcludeTypes {
+		id, err := Identifier(t)
+		if err != nil {
+			return "", err
+		}
+		add("type", "!"+id)
+	}
+	if s.Count != nil {
+		add("c", strconv.Itoa(*s.Count))
+	}
+	if s.RuntimeID != nil {
+		add("runtime_id", strconv.FormatUint(*s.RuntimeID, 10))
+	}
+	if s.Origin != nil {
+		axes := [3]Coord{s.Origin.X, s.Origin.Y, s.Origin.Z}
+		for i, key := range [3]string{"x", "y", "z"} {
+			if axes[i].Mode == Local {
+				return "", fmt.Errorf("%w: selector origin cannot use local coordinates", ErrInvalidValue)
+			}
+			v, err := axes[i].String()
+			if err != nil {
+				return "", err
+			}
+			add(key, v)
+		}
+	}
+	if s.Radius != nil {
+		v, err := Number(*s.Radius)
+		if err != nil {
+			return "", err
+		}
+		add("r", v)
+	}
+	if s.MinRadius != nil {
+		v, err := Number(*s.MinRadius)
+		if err != nil {
+			return "", err
+		}
+		add("rm", v)
+	}
+	if len(s.Scores) > 0 {
+		scores, err := renderScores(s.Scores)
+		if err != nil {
+			return "", err
+		}
+		add("scores", scores)
+	}
+
+	if len(args) == 0 {
+		return string(s.Target), nil
+	}
+	return string(s.Target) + "[" + strings.Join(args, ",") + "]", nil
+}
+
+func renderScores(scores map[string]ScoreRange) (string, error) {
+	objectives := make([]string, 0, len(scores))
+	for objective := range scores {
+		objectives = append(objectives, objective)
+	}
+	sort.Strings(objectives)
+	parts := make([]string, 0, len(objectives))
+	for _, objective := range objectives {
+		r := scores[objective]
+		if r.Min == nil && r.Max == nil {
+			return "", fmt.Errorf("%w: score range for %q is empty", ErrInvalidValue, objective)
+		}
+		if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+			return "", fmt.Errorf("%w: score range for %q is inverted", ErrInvalidValue, objective)
+		}
+		key, err := Quote(objective)
+		if err != nil {
+			return "", err
+		}
+		var value string
+		switch {
+		case r.Min != nil && r.Max != nil && *r.Min == *r.Max:
+			value = strconv.Itoa(int(*r.Min))
+		case r.Min != nil && r.Max != nil:
+			value = fmt.Sprintf("%d..%d", *r.Min, *r.Max)
+		case r.Min != nil:
+			value = fmt.Sprintf("%d..", *r.Min)
+		default:
+			value = fmt.Sprintf("..%d", *r.Max)
+		}
+		parts = append(parts, key+"="+value)
+	}
+	return "{" + strings.Join(parts, ",") + "}", nil
+}
@@ -0,0 +1,307 @@
+package cmdpolicy
+
+import (
+	"strconv"
+	"strings"
+)
+
+// limitingArguments narrow a selector enough that it is not considered
+// unbounded.
+var limitingArguments = []string{"c", "r", "name", "tag", "type", "dx", "dy", "dz", "scores", "runtime_id"}
+
+// token is one argument of a command with its byte offset.
+type token struct {
+	text   string
+	offset int
+}
+
+// tokenize splits cmd on spaces while keeping quoted strings, selector
+// arguments and JSON values together, the same way the server reads them.
+func tokenize(cmd string) []token {
+	var (
+		tokens []token
+		start  = -1
+		depth  int
+		quoted bool
+	)
+	for i := 0; i < len(cmd); i++ {
+		c := cmd[i]
+		if start < 0 {
+			if c == ' ' {
+				continue
+			}
+			start = i
+		}
+		switch {
+		case quoted:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				quoted = false
+			}
+		case c == '"':
+			quoted = true
+		case c == '[' || c == '{':
+			depth++
+		case (c == ']' || c == '}') && depth > 0:
+			depth--
+		case c == ' ' && depth == 0:
+			tokens = append(tokens, token{text: cmd[start:i], offset: start})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{text: cmd[start:], offset: start})
+	}
+	return tokens
+}
+
+// splitExecute separates an execute chain, in either the current or the
+// legacy syntax, from the command it finally runs. prefix keeps its trailing
+// space so prefix+inner is the original command.
+func splitExecute(cmd string) (prefix, inner string) {
+	rest := cmd
+	for {
+		tokens := tokenize(rest)
+		if len(tokens) == 0 || !strings.EqualFold(tokens[0].text, "execute") {
+			break
+		}
+		next, ok := executeRun(tokens)
+		if !ok {
+			next, ok = legacyExecuteRun(tokens)
+		}
+		if !ok {
+			// Subcommands this walk does not know: fall back to the first
+			// "run" that is a whole argument.
+			next, ok = firstRun(tokens)
+		}
+		if !ok || next >= len(tokens) {
+			break
+		}
+		// The server also accepts a slash before the inner command.
+		rest = strings.TrimPrefix(rest[tokens[next].offset:], "/")
+	}
+	return cmd[:len(cmd)-len(rest)], rest
+}
+
+// executeRun walks the subcommands of "execute ... run <command>" and
+// returns the index of the first token of the command.
+func executeRun(tokens []token) (int, bool) {
+	i := 1
+	arg := func(n int) bool {
+		i += n
+		return i < len(tokens)
+	}
+	for i < len(tokens) {
+		keyword := strings.ToLower(tokens[i].text)
+		i++
+		if i >= len(tokens) {
+			return 0, false
+		}
+		next := strings.ToLower(tokens[i].text)
+		var ok bool
+		switch keyword {
+		case "run":
+			return i, true
+		case "as", "at", "align", "anchored", "in":
+			ok = arg(1)
+		case "positioned":
+			if next == "as" {
+				ok = arg(2)
+			} else {
+				ok = coordinates(tokens[i:], 3) && arg(3)
+			}
+		case "rotated":
+			// Both "rotated <yaw> <pitch>" and "rotated as <target>".
+			ok = arg(2)
+		case "facing":
+			if next == "entity" {
+				ok = arg(3)
+			} else {
+				ok = coordinates(tokens[i:], 3) && arg(3)
+			}
+		case "if", "unless":
+			ok = condition(tokens, &i)
+		default:
+			return 0, false
+		}
+		if !ok {
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// condition skips the arguments of an execute if/unless subcommand, leaving
+// *i on the keyword that follows it.
+func condition(tokens []token, i *int) bool {
+	at := func(n int) string {
+		if *i+n >= len(tokens) {
+			return ""
+		}
+		return strings.ToLower(tokens[*i+n].text)
+	}
+	switch at(0) {
+	case "entity":
+		*i += 2
+	case "block":
+		if !coordinates(tokens[*i+1:], 3) {
+			return false
+		}
+		*i += 5
+		// Block states, or a data value in older versions.
+		if _, err := strconv.Atoi(at(0)); err == nil || strings.HasPrefix(at(0), "[") {
+			*i++
+		}
+	case "blocks":
+		if !coordinates(tokens[*i+1:], 9) {
+			return false
+		}
+		*i += 11
+	case "score":
+		if at(3) == "matches" {
+			*i += 5
+		} else {
+			*i += 6
+		}
+	default:
+		return false
+	}
+	return *i < len(tokens)
+}
+
+// legacyExecuteRun finds the command of "execute <target> <x> <y> <z>
+// [detect <x> <y> <z> <block> <data>] <command>".
+func legacyExecuteRun(tokens []token) (int, bool) {
+	if len(tokens) < 6 || !coordinates(tokens[2:], 3) {
+		return 0, false
+	}
+	if strings.EqualFold(tokens[5].text, "detect") {
+		if !coordinates(tokens[6:], 3) {
+			return 0, false
+		}
+		return 11, len(tokens) > 11
+	}
+	return 5, true
+}
+
+func firstRun(tokens []token) (int, bool) {
+	for i, t := range tokens {
+		if strings.EqualFold(t.text, "run") {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// coordinates reports whether the first n tokens are coordinates.
+func coordinates(tokens []token, n int) bool {
+	if len(tokens) < n {
+		return false
+	}
+	for _, t := range tokens[:n] {
+		text := t.text
+		if strings.HasPrefix(text, "~") || strings.HasPrefix(text, "^") {
+			text = text[1:]
+			if text == "" {
+				continue
+			}
+		}
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func hasUnboundedSelector(cmd string, selectors []string) bool {
+	for _, t := range tokenize(cmd) {
+		base, args, ok := selector(t.text)
+		if !ok {
+			continue
+		}
+		watched := false
+		for _, s := range selectors {
+			if strings.EqualFold(s, base) {
+				watched = true
+				break
+			}
+		}
+		if watched && !limited(args) {
+			return true
+		}
+	}
+	return false
+}
+
+// selector splits a target token into its base and bracketed arguments.
+// Tokens that are not selectors, such as quoted names, report false.
+func selector(text string) (base, args string, ok bool) {
+	if !strings.HasPrefix(text, "@") {
+		return "", "", false
+	}
+	base, args = text, ""
+	if i := strings.IndexByte(text, '['); i >= 0 {
+		base, args = text[:i], text[i:]
+	}
+	base = strings.ToLower(base)
+	switch base {
+	case "@a", "@e", "@p", "@r", "@s", "@initiator":
+		return base, args, true
+	}
+	return "", "", false
+}
+
+func limited(args string) bool {
+	args = strings.TrimPrefix(args, "[")
+	args = strings.TrimSuffix(args, "]")
+	if strings.TrimSpace(args) == "" {
+		return false
+	}
+	for _, arg := range selectorArguments(args) {
+		key, value, _ := strings.Cut(arg, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if strings.HasPrefix(strings.TrimSpace(value), "!") {
+			// Exclusions still match everything else.
+			continue
+		}
+		for _, limit := range limitingArguments {
+			if key == limit {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selectorArguments splits selector arguments on the commas that are not
+// inside quotes or nested values such as scores={...}.
+func selectorArguments(args string) []string {
+	var (
+		out    []string
+		start  int
+		depth  int
+		quoted bool
+	)
+	for i := 0; i < len(args); i++ {
+		c := args[i]
+		switch {
+		case quoted:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				quoted = false
+			}
+		case c == '"':
+			quoted = true
+		case c == '[' || c == '{':
+			depth++
+		case (c == ']' || c == '}') && depth > 0:
+			depth--
+		case c == ',' && depth == 0:
+			out = append(out, args[start:i])
+			start = i + 1
+		}
+	}
+	return append(out, args[start:])
+}
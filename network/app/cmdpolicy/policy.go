@@ -0,0 +1,320 @@
+// Package cmdpolicy decides whether an outbound command may be sent, and in
+// which form. Rules are evaluated in order against the command that will
+// actually run, so wrapping a command in "execute ... run" does not bypass
+// them.
+package cmdpolicy
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Action is what a matching rule does.
+type Action int
+
+const (
+	Allow Action = iota
+	Deny
+	// Rewrite replaces the matched command and continues evaluation.
+	Rewrite
+)
+
+// Rule matches commands by pattern and optional selector constraints.
+type Rule struct {
+	Name string
+	// Pattern is a case-insensitive regular expression matched against the
+	// command that will run, without the leading slash. Empty matches all.
+	Pattern string
+	Action  Action
+	// Replacement is the regexp replacement template used by Rewrite.
+	Replacement string
+	// UnboundedSelectors restricts the rule to commands containing one of
+	// these selectors (for example "@e") without a limiting argument.
+	UnboundedSelectors []string
+}
+
+// Override replaces the defaults for callers presenting Token.
+type Override struct {
+	Role  string
+	Token string
+	// Rules are evaluated before the base rules.
+	Rules       []Rule
+	DefaultDeny bool
+	// ManagePolicy lets the role replace the policy.
+	ManagePolicy bool
+	// ManagePermissions lets the role change permission roles, which are
+	// applied with operator rights regardless of the rules above.
+	ManagePermissions bool
+}
+
+// Policy is the complete rule set.
+type Policy struct {
+	Rules       []Rule
+	DefaultDeny bool
+	Overrides   []Override
+}
+
+// DefaultPolicy blocks commands that can take the server down or hand out
+// operator rights.
+var DefaultPolicy = Policy{
+	Rules: []Rule{
+		{Name: "deny-stop", Pattern: `^stop\b`, Action: Deny},
+		{Name: "deny-op", Pattern: `^(op|deop)\b`, Action: Deny},
+		{Name: "deny-unbounded-kill", Pattern: `^kill\b`, Action: Deny, UnboundedSelectors: []string{"@e", "@a"}},
+	},
+}
+
+// Decision is the outcome of Evaluate.
+type Decision struct {
+	Allowed bool
+	// Command is the command to send, after rewrites.
+	Command string
+	// Rule names the rule that decided, or is empty when the default applied.
+	Rule string
+	Role string
+}
+
+// ErrInvalidPolicy is wrapped by Set and NewEngine for malformed rules.
+var ErrInvalidPolicy = errors.New("invalid command policy")
+
+type compiledRule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+type compiledPolicy struct {
+	policy    Policy
+	rules     []compiledRule
+	overrides map[string]compiledOverride
+}
+
+type compiledOverride struct {
+	Override
+	rules []compiledRule
+}
+
+// Engine evaluates commands against the active policy.
+type Engine struct {
+	mu       sync.RWMutex
+	compiled *compiledPolicy
+	// managerToken may manage the policy regardless of its overrides. It is
+	// configured by the process owner and cannot be changed through Set.
+	managerToken string
+}
+
+// NewEngine compiles p into an engine.
+func NewEngine(p Policy) (*Engine, error) {
+	e := &Engine{}
+	if err := e.Set(p); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Set replaces the policy.
+func (e *Engine) Set(p Policy) error {
+	compiled, err := compile(p)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	e.compiled = compiled
+	e.mu.Unlock()
+	return nil
+}
+
+// Policy returns the active policy.
+func (e *Engine) Policy() Policy {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.compiled.policy
+}
+
+// Role returns the override bound to token, if any.
+func (e *Engine) Role(token string) (Override, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if token == "" {
+		return Override{}, false
+	}
+	o, ok := e.compiled.overrides[token]
+	return o.Override, ok
+}
+
+// SetManagerToken sets the token that may always manage the policy and
+// permission roles. An empty token leaves management to overrides with
+// ManagePolicy or ManagePermissions.
+func (e *Engine) SetManagerToken(token string) {
+	e.mu.Lock()
+	e.managerToken = token
+	e.mu.Unlock()
+}
+
+// CanManage reports whether a caller presenting token may replace the
+// policy. Management is closed until a manager token or an override with
+// ManagePolicy exists.
+func (e *Engine) CanManage(token string) bool {
+	return e.canManage(token, func(o Override) bool { return o.ManagePolicy })
+}
+
+// CanManagePermissions reports whether a caller presenting token may change
+// permission roles and assignments. Like CanManage it is closed by default.
+func (e *Engine) CanManagePermissions(token string) bool {
+	return e.canManage(token, func(o Override) bool { return o.ManagePermissions })
+}
+
+func (e *Engine) canManage(token string, granted func(Override) bool) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if token == "" {
+		return false
+	}
+	if e.managerToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(e.managerToken)) == 1 {
+		return true
+	}
+	o, ok := e.compiled.overrides[token]
+	return ok && granted(o.Override)
+}
+
+// Evaluate checks cmd for a caller presenting token.
+func (e *Engine) Evaluate(cmd, token string) Decision {
+	e.mu.RLock()
+	compiled := e.compiled
+	e.mu.RUnlock()
+
+	var override *compiledOverride
+	if o, ok := compiled.overrides[token]; ok && token != "" {
+		override = &o
+	}
+	return compiled.evaluate(cmd, override)
+}
+
+// EvaluateRole checks cmd on behalf of the named role, for work that runs
+// without a caller such as scheduled jobs. An unknown role gets the base
+// rules.
+func (e *Engine) EvaluateRole(cmd, role string) Decision {
+	e.mu.RLock()
+	compiled := e.compiled
+	e.mu.RUnlock()
+
+	var override *compiledOverride
+	for _, o := range compiled.policy.Overrides {
+		if role != "" && o.Role == role {
+			c := compiled.overrides[o.Token]
+			override = &c
+			break
+		}
+	}
+	return compiled.evaluate(cmd, override)
+}
+
+func (compiled *compiledPolicy) evaluate(cmd string, override *compiledOverride) Decision {
+	// Rules see a whitespace-collapsed copy so spacing cannot dodge a
+	// pattern; the original text is sent unless a rule rewrote it.
+	current := normalize(cmd)
+	rewritten := false
+	rules := compiled.rules
+	defaultDeny := compiled.policy.DefaultDeny
+	decision := Decision{}
+	if override != nil {
+		rules = append(append([]compiledRule(nil), override.rules...), rules...)
+		defaultDeny = override.DefaultDeny
+		decision.Role = override.Role
+	}
+	finish := func() Decision {
+		decision.Command = strings.TrimSpace(cmd)
+		if rewritten {
+			decision.Command = current
+		}
+		return decision
+	}
+
+	for _, rule := range rules {
+		prefix, inner := splitExecute(current)
+		if !rule.matches(current, inner) {
+			continue
+		}
+		switch rule.Action {
+		case Rewrite:
+			current = prefix + normalize(rule.re.ReplaceAllString(inner, rule.Replacement))
+			rewritten = true
+		case Deny:
+			decision.Rule = rule.Name
+			return finish()
+		default:
+			decision.Rule = rule.Name
+			decision.Allowed = true
+			return finish()
+		}
+	}
+	decision.Allowed = !defaultDeny
+	return finish()
+}
+
+func (r compiledRule) matches(full, inner string) bool {
+	if r.re != nil && !r.re.MatchString(inner) {
+		return false
+	}
+	if len(r.UnboundedSelectors) > 0 && !hasUnboundedSelector(full, r.UnboundedSelectors) {
+		return false
+	}
+	return true
+}
+
+func compile(p Policy) (*compiledPolicy, error) {
+	rules, err := compileRules(p.Rules)
+	if err != nil {
+		return nil, err
+	}
+	out := &compiledPolicy{
+		policy:    p,
+		rules:     rules,
+		overrides: make(map[string]compiledOverride, len(p.Overrides)),
+	}
+	for _, o := range p.Overrides {
+		if o.Token == "" {
+			return nil, fmt.Errorf("%w: role %q has no token", ErrInvalidPolicy, o.Role)
+		}
+		if _, dup := out.overrides[o.Token]; dup {
+			return nil, fmt.Errorf("%w: token of role %q is not unique", ErrInvalidPolicy, o.Role)
+		}
+		rules, err := compileRules(o.Rules)
+		if err != nil {
+			return nil, err
+		}
+		out.overrides[o.Token] = compiledOverride{Override: o, rules: rules}
+	}
+	return out, nil
+}
+
+func compileRules(rules []Rule) ([]compiledRule, error) {
+	out := make([]compiledRule, 0, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule-%d", i)
+		}
+		c := compiledRule{Rule: rule}
+		if rule.Pattern != "" {
+			re, err := regexp.Compile("(?i)" + rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("%w: rule %q: %v", ErrInvalidPolicy, rule.Name, err)
+			}
+			c.re = re
+		}
+		if rule.Action == Rewrite && c.re == nil {
+			return nil, fmt.Errorf("%w: rewrite rule %q needs a pattern", ErrInvalidPolicy, rule.Name)
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func normalize(cmd string) string {
+	cmd = strings.TrimSpace(cmd)
+	cmd = strings.TrimPrefix(cmd, "/")
+	return strings.Join(strings.Fields(cmd), " ")
+}
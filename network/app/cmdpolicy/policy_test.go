@@ -0,0 +1,182 @@
+package cmdpolicy
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	e, err := NewEngine(DefaultPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		cmd     string
+		allowed bool
+		rule    string
+	}{
+		{cmd: "say hi", allowed: true},
+		{cmd: "/stop", rule: "deny-stop"},
+		{cmd: "STOP", rule: "deny-stop"},
+		{cmd: "stopsound @a", allowed: true},
+		{cmd: "op Steve", rule: "deny-op"},
+		{cmd: "deop   Steve", rule: "deny-op"},
+		{cmd: "execute as @a at @s run op @s", rule: "deny-op"},
+		{cmd: "execute as @a run execute at @s run stop", rule: "deny-stop"},
+		{cmd: "kill @e", rule: "deny-unbounded-kill"},
+		{cmd: "kill @a[tag=!safe]", rule: "deny-unbounded-kill"},
+		{cmd: "kill @e[type=zombie]", allowed: true},
+		{cmd: "kill @a[r=5]", allowed: true},
+		{cmd: "kill @s", allowed: true},
+		// The execute chain counts: this kills every entity.
+		{cmd: "execute as @e run kill @s", rule: "deny-unbounded-kill"},
+		{cmd: "execute as @e[type=cow] run kill @s", allowed: true},
+		{cmd: "execute as @e[type=cow] at @s run kill @e", rule: "deny-unbounded-kill"},
+		// A quoted " run " is part of the selector, not the split point.
+		{cmd: `execute as @a[name=" run "] run op Steve`, rule: "deny-op"},
+		{cmd: `execute as @a[name=" run "] at @s run say hi`, allowed: true},
+		{cmd: "execute positioned ~ ~1 ~ if block ~ ~-1 ~ stone 0 run /op Steve", rule: "deny-op"},
+		{cmd: "execute if score @s points matches 1.. unless entity @p[r=3] run stop", rule: "deny-stop"},
+		{cmd: "execute rotated as @p facing entity @p eyes run deop Steve", rule: "deny-op"},
+		// Legacy execute and its detect form.
+		{cmd: "execute @a ~ ~ ~ op Steve", rule: "deny-op"},
+		{cmd: "execute @p ~ ~-1 ~ detect ~ ~ ~ stone 0 stop", rule: "deny-stop"},
+		{cmd: "execute @p ~ ~ ~ execute @s ~ ~ ~ say run", allowed: true},
+		{cmd: "execute as ~ ~ ~ op run", rule: "deny-op"},
+		// Quoted text is one argument: this name filter really limits.
+		{cmd: `kill @e[name=" run "]`, allowed: true},
+		{cmd: `kill @e[name=!"a,r=5"]`, rule: "deny-unbounded-kill"},
+		{cmd: `kill @e[tag=!x,scores={a=1,b=2}]`, allowed: true},
+		{cmd: `say "@e" kill`, allowed: true},
+	}
+	for _, tt := range tests {
+		d := e.Evaluate(tt.cmd, "")
+		if d.Allowed != tt.allowed || d.Rule != tt.rule {
+			t.Errorf("Evaluate(%q) = allowed %v rule %q; want allowed %v rule %q", tt.cmd, d.Allowed, d.Rule, tt.allowed, tt.rule)
+		}
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	e, err := NewEngine(Policy{Rules: []Rule{
+		{Name: "quiet-say", Pattern: `^say (.*)$`, Action: Rewrite, Replacement: "tellraw @a {\"rawtext\":[{\"text\":\"$1\"}]}"},
+		{Name: "gm", Pattern: `^gamemode creative\b`, Action: Rewrite, Replacement: "gamemode survival"},
+		{Name: "deny-tellraw", Pattern: `^tellraw @a \{"rawtext":\[\{"text":"secret`, Action: Deny},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		cmd     string
+		allowed bool
+		want    string
+		rule    string
+	}{
+		{cmd: "  say  hi  ", allowed: true, want: `tellraw @a {"rawtext":[{"text":"hi"}]}`},
+		{cmd: "say secret", rule: "deny-tellraw", want: `tellraw @a {"rawtext":[{"text":"secret"}]}`},
+		{cmd: "execute as @p run gamemode creative @s", allowed: true, want: "execute as @p run gamemode survival @s"},
+		// Unchanged commands keep their original spacing.
+		{cmd: " give  @p apple ", allowed: true, want: "give  @p apple"},
+	}
+	for _, tt := range tests {
+		d := e.Evaluate(tt.cmd, "")
+		if d.Allowed != tt.allowed || d.Command != tt.want || d.Rule != tt.rule {
+			t.Errorf("Evaluate(%q) = %+v; want allowed %v command %q rule %q", tt.cmd, d, tt.allowed, tt.want, tt.rule)
+		}
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	e, err := NewEngine(Policy{
+		Rules:       []Rule{{Name: "allow-say", Pattern: `^say\b`, Action: Allow}},
+		DefaultDeny: true,
+		Overrides: []Override{
+			{Role: "admin", Token: "admin-token", Rules: []Rule{{Name: "deny-stop", Pattern: `^stop\b`, Action: Deny}}, ManagePolicy: true},
+			{Role: "mod", Token: "mod-token", DefaultDeny: true, ManagePermissions: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		cmd     string
+		token   string
+		role    string
+		allowed bool
+		rule    string
+		roleOut string
+	}{
+		{name: "base allow", cmd: "say hi", allowed: true, rule: "allow-say"},
+		{name: "base default deny", cmd: "give @p apple"},
+		{name: "unknown token", cmd: "give @p apple", token: "nope"},
+		{name: "override default", cmd: "give @p apple", token: "admin-token", allowed: true, roleOut: "admin"},
+		{name: "override rule first", cmd: "stop", token: "admin-token", rule: "deny-stop", roleOut: "admin"},
+		{name: "base rules still apply", cmd: "say hi", token: "mod-token", allowed: true, rule: "allow-say", roleOut: "mod"},
+		{name: "by role", cmd: "give @p apple", role: "admin", allowed: true, roleOut: "admin"},
+		{name: "unknown role", cmd: "give @p apple", role: "ghost"},
+	}
+	for _, tt := range tests {
+		var d Decision
+		if tt.role != "" {
+			d = e.EvaluateRole(tt.cmd, tt.role)
+		} else {
+			d = e.Evaluate(tt.cmd, tt.token)
+		}
+		if d.Allowed != tt.allowed || d.Rule != tt.rule || d.Role != tt.roleOut {
+			t.Errorf("%s: got %+v; want allowed %v rule %q role %q", tt.name, d, tt.allowed, tt.rule, tt.roleOut)
+		}
+	}
+}
+
+func TestCanManage(t *testing.T) {
+	e, err := NewEngine(Policy{Overrides: []Override{
+		{Role: "admin", Token: "admin-token", ManagePolicy: true},
+		{Role: "mod", Token: "mod-token", ManagePermissions: true},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed, _ := NewEngine(DefaultPolicy)
+	if closed.CanManage("") || closed.CanManage("anything") || closed.CanManagePermissions("anything") {
+		t.Error("management is open without a manager token")
+	}
+
+	e.SetManagerToken("root")
+	tests := []struct {
+		token       string
+		policy      bool
+		permissions bool
+	}{
+		{token: ""},
+		{token: "nope"},
+		{token: "root", policy: true, permissions: true},
+		{token: "admin-token", policy: true},
+		{token: "mod-token", permissions: true},
+	}
+	for _, tt := range tests {
+		if got := e.CanManage(tt.token); got != tt.policy {
+			t.Errorf("CanManage(%q) = %v, want %v", tt.token, got, tt.policy)
+		}
+		if got := e.CanManagePermissions(tt.token); got != tt.permissions {
+			t.Errorf("CanManagePermissions(%q) = %v, want %v", tt.token, got, tt.permissions)
+		}
+	}
+}
+
+func TestInvalidPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+	}{
+		{name: "bad pattern", policy: Policy{Rules: []Rule{{Pattern: "("}}}},
+		{name: "rewrite without pattern", policy: Policy{Rules: []Rule{{Action: Rewrite}}}},
+		{name: "override without token", policy: Policy{Overrides: []Override{{Role: "a"}}}},
+		{name: "duplicate token", policy: Policy{Overrides: []Override{{Role: "a", Token: "t"}, {Role: "b", Token: "t"}}}},
+	}
+	for _, tt := range tests {
+		if _, err := NewEngine(tt.policy); !errors.Is(err, ErrInvalidPolicy) {
+			t.Errorf("%s: error = %v, want ErrInvalidPolicy", tt.name, err)
+		}
+	}
+}
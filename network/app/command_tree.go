@@ -0,0 +1,263 @@
+package app
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+)
+
+// ParamKind is the resolved type of a command parameter.
+type ParamKind int
+
+const (
+	ParamUnknown ParamKind = iota
+	ParamInt
+	ParamFloat
+	ParamValue
+	ParamWildcardInt
+	ParamOperator
+	ParamCompareOperator
+	ParamTarget
+	ParamWildcardTarget
+	ParamFilepath
+	ParamIntRange
+	ParamString
+	ParamBlockPosition
+	ParamPosition
+	ParamMessage
+	ParamRawText
+	ParamJSON
+	ParamCommand
+	ParamEnum
+	ParamSoftEnum
+)
+
+var paramKindNames = map[ParamKind]string{
+	ParamUnknown:         "unknown",
+	ParamInt:             "int",
+	ParamFloat:           "float",
+	ParamValue:           "value",
+	ParamWildcardInt:     "wildcard int",
+	ParamOperator:        "operator",
+	ParamCompareOperator: "compare operator",
+	ParamTarget:          "target",
+	ParamWildcardTarget:  "wildcard target",
+	ParamFilepath:        "filepath",
+	ParamIntRange:        "int range",
+	ParamString:          "string",
+	ParamBlockPosition:   "block position",
+	ParamPosition:        "position",
+	ParamMessage:         "message",
+	ParamRawText:         "rawtext",
+	ParamJSON:            "json",
+	ParamCommand:         "command",
+	ParamEnum:            "enum",
+	ParamSoftEnum:        "soft enum",
+}
+
+func (k ParamKind) String() string {
+	return paramKindNames[k]
+}
+
+var paramKindByArgType = map[uint32]ParamKind{
+	protocol.CommandArgTypeInt:             ParamInt,
+	protocol.CommandArgTypeFloat:           ParamFloat,
+	protocol.CommandArgTypeValue:           ParamValue,
+	protocol.CommandArgTypeWildcardInt:     ParamWildcardInt,
+	protocol.CommandArgTypeOperator:        ParamOperator,
+	protocol.CommandArgTypeCompareOperator: ParamCompareOperator,
+	protocol.CommandArgTypeTarget:          ParamTarget,
+	protocol.CommandArgTypeWildcardTarget:  ParamWildcardTarget,
+	protocol.CommandArgTypeFilepath:        ParamFilepath,
+	protocol.CommandArgTypeIntegerRange:    ParamIntRange,
+	protocol.CommandArgTypeString:          ParamString,
+	protocol.CommandArgTypeBlockPosition:   ParamBlockPosition,
+	protocol.CommandArgTypePosition:        ParamPosition,
+	protocol.CommandArgTypeMessage:         ParamMessage,
+	protocol.CommandArgTypeRawText:         ParamRawText,
+	protocol.CommandArgTypeJSON:            ParamJSON,
+	protocol.CommandArgTypeCommand:         ParamCommand,
+}
+
+// CommandParam is one parameter of an overload.
+type CommandParam struct {
+	Name     string
+	Kind     ParamKind
+	Optional bool
+	// EnumName and EnumValues are set for enum and soft enum parameters.
+	EnumName   string
+	EnumValues []string
+	// Suffix is set for suffixed numbers such as "10L".
+	Suffix string
+}
+
+// CommandSpec describes a command and its overloads.
+type CommandSpec struct {
+	Name            string
+	Description     string
+	Aliases         []string
+	PermissionLevel byte
+	Overloads       [][]CommandParam
+}
+
+// CommandTree is the command set announced by the server.
+type CommandTree struct {
+	commands map[string]*CommandSpec
+	aliases  map[string]string
+}
+
+// Lookup resolves a command by name or alias.
+func (t *CommandTree) Lookup(name string) (*CommandSpec, bool) {
+	if t == nil {
+		return nil, false
+	}
+	name = strings.ToLower(strings.TrimPrefix(name, "/"))
+	if real, ok := t.aliases[name]; ok {
+		name = real
+	}
+	spec, ok := t.commands[name]
+	return spec, ok
+}
+
+// Commands returns every command sorted by name.
+func (t *CommandTree) Commands() []*CommandSpec {
+	if t == nil {
+		return nil
+	}
+	out := make([]*CommandSpec, 0, len(t.commands))
+	for _, spec := range t.commands {
+		out = append(out, spec)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// names returns command names and aliases starting with prefix.
+func (t *CommandTree) names(prefix string) []string {
+	if t == nil {
+		return nil
+	}
+	prefix = strings.ToLower(prefix)
+	var out []string
+	for name := range t.commands {
+		if strings.HasPrefix(name, prefix) {
+			out = append(out, name)
+		}
+	}
+	for alias := range t.aliases {
+		if strings.HasPrefix(alias, prefix) {
+			out = append(out, alias)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// BuildCommandTree resolves the index tables of an AvailableCommands packet.
+func BuildCommandTree(pk *packet.AvailableCommands) *CommandTree {
+	enum := func(index int) (string, []string) {
+		if index < 0 || index >= len(pk.Enums) {
+			return "", nil
+		}
+		e := pk.Enums[index]
+		values := make([]string, 0, len(e.ValueIndices))
+		for _, vi := range e.ValueIndices {
+			if int(vi) < len(pk.EnumValues) {
+				values = append(values, pk.EnumValues[vi])
+			}
+		}
+		return e.Type, values
+	}
+
+	tree := &CommandTree{
+		commands: make(map[string]*CommandSpec, len(pk.Commands)),
+		aliases:  make(map[string]string),
+	}
+	for _, cmd := range pk.Commands {
+		name := strings.ToLower(cmd.Name)
+		spec := &CommandSpec{
+			Name:            name,
+			Description:     cmd.Description,
+			PermissionLevel: cmd.PermissionLevel,
+		}
+		if cmd.AliasesOffset != ^uint32(0) {
+			_, aliases := enum(int(cmd.AliasesOffset))
+			for _, alias := range aliases {
+				alias = strings.ToLower(alias)
+				if alias != name {
+					spec.Aliases = append(spec.Aliases, alias)
+					tree.aliases[alias] = name
+				}
+			}
+		}
+		for _, overload := range cmd.Overloads {
+			params := make([]CommandParam, 0, len(overload.Parameters))
+			for _, p := range overload.Parameters {
+				param := CommandParam{Name: p.Name, Optional: p.Optional}
+				index := int(p.Type & 0xffff)
+				switch {
+				case p.Type&protocol.CommandArgSoftEnum != 0:
+					param.Kind = ParamSoftEnum
+					if index < len(pk.DynamicEnums) {
+						param.EnumName = pk.DynamicEnums[index].Type
+						param.EnumValues = append([]string(nil), pk.DynamicEnums[index].Values...)
+					}
+				case p.Type&protocol.CommandArgEnum != 0:
+					param.Kind = ParamEnum
+					param.EnumName, param.EnumValues = enum(index)
+				case p.Type&protocol.CommandArgSuffixed != 0:
+					param.Kind = ParamInt
+					if index < len(pk.Suffixes) {
+						param.Suffix = pk.Suffixes[index]
+					}
+				default:
+					param.Kind = paramKindByArgType[uint32(index)]
+				}
+				params = append(params, param)
+			}
+			spec.Overloads = append(spec.Overloads, params)
+		}
+		tree.commands[name] = spec
+	}
+	return tree
+}
+
+// CommandTreeHolder keeps the latest command tree of the session.
+type CommandTreeHolder struct {
+	mu   sync.RWMutex
+	tree *CommandTree
+}
+
+// NewCommandTreeHolder constructs an empty holder.
+func NewCommandTreeHolder() *CommandTreeHolder {
+	return &CommandTreeHolder{}
+}
+
+// HandlePacket replaces the tree when an AvailableCommands packet arrives.
+func (h *CommandTreeHolder) HandlePacket(pk packet.Packet) {
+	p, ok := pk.(*packet.AvailableCommands)
+	if !ok {
+		return
+	}
+	tree := BuildCommandTree(p)
+	h.mu.Lock()
+	h.tree = tree
+	h.mu.Unlock()
+}
+
+// Tree returns the current tree, or nil before the server sent one.
+func (h *CommandTreeHolder) Tree() *CommandTree {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.tree
+}
+
+// Reset drops the tree.
+func (h *CommandTreeHolder) Reset() {
+	h.mu.Lock()
+	h.tree = nil
+	h.mu.Unlock()
+}
@@ -0,0 +1,379 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// commandToken is one argument of a command line with its byte offset.
+type commandToken struct {
+	text   string
+	offset int
+}
+
+// tokenizeCommand splits a command line on spaces while keeping quoted
+// strings, selector arguments and JSON values together.
+func tokenizeCommand(line string) []commandToken {
+	var (
+		tokens []commandToken
+		start  = -1
+		depth  int
+		quoted bool
+	)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if start < 0 {
+			if c == ' ' {
+				continue
+			}
+			start = i
+		}
+		switch {
+		case quoted:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				quoted = false
+			}
+		case c == '"':
+			quoted = true
+		case c == '[' || c == '{':
+			depth++
+		case (c == ']' || c == '}') && depth > 0:
+			depth--
+		case c == ' ' && depth == 0:
+			tokens = append(tokens, commandToken{text: line[start:i], offset: start})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, commandToken{text: line[start:], offset: start})
+	}
+	return tokens
+}
+
+// CommandValidation reports the outcome of ValidateCommand.
+type CommandValidation struct {
+	Valid   bool
+	Command string
+	// Overload is the index of the matching overload, or of the overload
+	// that parsed furthest when Valid is false.
+	Overload int
+	// ErrorOffset is the byte offset in the input where parsing failed.
+	ErrorOffset int
+	Message     string
+	Expected    []string
+}
+
+// CommandCompletion is a candidate returned by CompleteCommand.
+type CommandCompletion struct {
+	Text string
+	// Detail names the parameter or command the candidate belongs to.
+	Detail string
+	// ReplaceFrom is the byte offset the candidate replaces to the cursor.
+	ReplaceFrom int
+}
+
+// overloadWalk is the result of matching tokens against one overload.
+type overloadWalk struct {
+	consumed int
+	complete bool
+	// next is the parameter that would take the token after the consumed
+	// ones; it is nil once every parameter is satisfied.
+	next    *CommandParam
+	message string
+}
+
+func walkOverload(params []CommandParam, tokens []commandToken) overloadWalk {
+	pos := 0
+	for i := range params {
+		p := &params[i]
+		if pos >= len(tokens) {
+			if p.Optional {
+				return overloadWalk{consumed: pos, complete: true, next: p}
+			}
+			return overloadWalk{consumed: pos, next: p, message: fmt.Sprintf("missing %s", describeParam(*p))}
+		}
+		switch p.Kind {
+		case ParamMessage, ParamCommand:
+			// Consumes the rest of the line.
+			return overloadWalk{consumed: len(tokens), complete: true}
+		case ParamPosition, ParamBlockPosition:
+			for axis := 0; axis < 3; axis++ {
+				if pos >= len(tokens) {
+					return overloadWalk{consumed: pos, next: p, message: fmt.Sprintf("incomplete %s", describeParam(*p))}
+				}
+				if !isCoordinate(tokens[pos].text) {
+					return overloadWalk{consumed: pos, next: p, message: fmt.Sprintf("%q is not a coordinate", tokens[pos].text)}
+				}
+				pos++
+			}
+			continue
+		}
+		if msg := matchParam(*p, tokens[pos].text); msg != "" {
+			return overloadWalk{consumed: pos, next: p, message: msg}
+		}
+		pos++
+	}
+	if pos < len(tokens) {
+		return overloadWalk{consumed: pos, message: fmt.Sprintf("unexpected %q", tokens[pos].text)}
+	}
+	return overloadWalk{consumed: pos, complete: true}
+}
+
+// matchParam returns an empty string when token is acceptable for p.
+func matchParam(p CommandParam, token string) string {
+	bad := func() string {
+		return fmt.Sprintf("%q is not a valid %s", token, describeParam(p))
+	}
+	switch p.Kind {
+	case ParamInt:
+		if p.Suffix != "" {
+			token = strings.TrimSuffix(strings.ToLower(token), strings.ToLower(p.Suffix))
+		}
+		if _, err := strconv.ParseInt(token, 10, 32); err != nil {
+			return bad()
+		}
+	case ParamWildcardInt:
+		if _, err := strconv.ParseInt(token, 10, 32); err != nil && token != "*" {
+			return bad()
+		}
+	case ParamFloat, ParamValue:
+		if _, err := strconv.ParseFloat(token, 64); err != nil {
+			return bad()
+		}
+	case ParamIntRange:
+		if !isIntRange(token) {
+			return bad()
+		}
+	case ParamOperator:
+		switch token {
+		case "=", "+=", "-=", "*=", "/=", "%=", "<", ">", "><":
+		default:
+			return bad()
+		}
+	case ParamCompareOperator:
+		switch token {
+		case "<", "<=", "=", ">=", ">":
+		default:
+			return bad()
+		}
+	case ParamTarget, ParamWildcardTarget:
+		if token == "*" && p.Kind == ParamWildcardTarget {
+			return ""
+		}
+		if strings.HasPrefix(token, "@") && !isSelector(token) {
+			return bad()
+		}
+	case ParamJSON, ParamRawText:
+		if !strings.HasPrefix(token, "{") && !strings.HasPrefix(token, "[") {
+			return bad()
+		}
+	case ParamEnum:
+		for _, v := range p.EnumValues {
+			if strings.EqualFold(v, token) {
+				return ""
+			}
+		}
+		return bad()
+	}
+	return ""
+}
+
+func describeParam(p CommandParam) string {
+	kind := p.Kind.String()
+	if p.EnumName != "" {
+		kind = p.EnumName
+	}
+	if p.Name == "" {
+		return kind
+	}
+	return fmt.Sprintf("%s: %s", p.Name, kind)
+}
+
+func isCoordinate(token string) bool {
+	if token == "" {
+		return false
+	}
+	if token[0] == '~' || token[0] == '^' {
+		token = token[1:]
+		if token == "" {
+			return true
+		}
+	}
+	_, err := strconv.ParseFloat(token, 64)
+	return err == nil
+}
+
+func isIntRange(token string) bool {
+	if lo, hi, ok := strings.Cut(token, ".."); ok {
+		if lo == "" && hi == "" {
+			return false
+		}
+		for _, part := range []string{lo, hi} {
+			if part == "" {
+				continue
+			}
+			if _, err := strconv.Atoi(part); err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	_, err := strconv.Atoi(strings.TrimPrefix(token, "!"))
+	return err == nil
+}
+
+func isSelector(token string) bool {
+	base, args, hasArgs := strings.Cut(token, "[")
+	switch base {
+	case "@a", "@e", "@p", "@r", "@s", "@initiator":
+	default:
+		return false
+	}
+	return !hasArgs || strings.HasSuffix(args, "]")
+}
+
+// ValidateCommand checks line against the tree.
+func ValidateCommand(tree *CommandTree, line string) CommandValidation {
+	trimmed := strings.TrimLeft(line, " ")
+	offset := len(line) - len(trimmed)
+	trimmed = strings.TrimPrefix(trimmed, "/")
+	if trimmed != line[offset:] {
+		offset++
+	}
+	tokens := tokenizeCommand(trimmed)
+	for i := range tokens {
+		tokens[i].offset += offset
+	}
+	if len(tokens) == 0 {
+		return CommandValidation{Overload: -1, ErrorOffset: offset, Message: "empty command"}
+	}
+	spec, ok := tree.Lookup(tokens[0].text)
+	if !ok {
+		return CommandValidation{
+			Overload:    -1,
+			ErrorOffset: tokens[0].offset,
+			Message:     fmt.Sprintf("unknown command %q", tokens[0].text),
+		}
+	}
+	if len(spec.Overloads) == 0 {
+		return CommandValidation{Valid: len(tokens) == 1, Command: spec.Name, Overload: -1}
+	}
+
+	args := tokens[1:]
+	best := CommandValidation{Command: spec.Name, Overload: -1}
+	bestConsumed := -1
+	for i, overload := range spec.Overloads {
+		walk := walkOverload(overload, args)
+		if walk.complete {
+			return CommandValidation{Valid: true, Command: spec.Name, Overload: i}
+		}
+		if walk.consumed > bestConsumed {
+			bestConsumed = walk.consumed
+			best.Overload = i
+			best.Message = walk.message
+			best.Expected = nil
+			if walk.consumed < len(args) {
+				best.ErrorOffset = args[walk.consumed].offset
+			} else {
+				best.ErrorOffset = len(line)
+			}
+		}
+		if walk.consumed == bestConsumed && walk.next != nil {
+			best.Expected = appendUnique(best.Expected, describeParam(*walk.next))
+		}
+	}
+	return best
+}
+
+// CompleteCommand returns candidates for the token under cursor. A cursor
+// outside line means the end of line. Players are offered for target parameters
+// next to the selector bases.
+func CompleteCommand(tree *CommandTree, line string, cursor int, players []string) []CommandCompletion {
+	if cursor < 0 || cursor > len(line) {
+		cursor = len(line)
+	}
+	head := line[:cursor]
+	slash := 0
+	if trimmed := strings.TrimLeft(head, " "); strings.HasPrefix(trimmed, "/") {
+		slash = len(head) - len(trimmed) + 1
+	}
+	tokens := tokenizeCommand(head[slash:])
+	for i := range tokens {
+		tokens[i].offset += slash
+	}
+	partial := commandToken{offset: cursor}
+	if len(tokens) > 0 && !strings.HasSuffix(head, " ") {
+		partial = tokens[len(tokens)-1]
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	var out []CommandCompletion
+	seen := make(map[string]bool)
+	add := func(text, detail string) {
+		if seen[text] || !strings.HasPrefix(strings.ToLower(text), strings.ToLower(partial.text)) {
+			return
+		}
+		seen[text] = true
+		out = append(out, CommandCompletion{Text: text, Detail: detail, ReplaceFrom: partial.offset})
+	}
+
+	if len(tokens) == 0 {
+		for _, name := range tree.names(partial.text) {
+			detail := ""
+			if spec, ok := tree.Lookup(name); ok {
+				detail = spec.Description
+			}
+			add(name, detail)
+		}
+		return out
+	}
+	spec, ok := tree.Lookup(tokens[0].text)
+	if !ok {
+		return nil
+	}
+	for _, overload := range spec.Overloads {
+		walk := walkOverload(overload, tokens[1:])
+		if walk.consumed != len(tokens)-1 || walk.next == nil {
+			continue
+		}
+		if walk.message != "" && !strings.HasPrefix(walk.message, "missing") && !strings.HasPrefix(walk.message, "incomplete") {
+			continue
+		}
+		p := *walk.next
+		detail := describeParam(p)
+		switch p.Kind {
+		case ParamEnum, ParamSoftEnum:
+			for _, v := range p.EnumValues {
+				add(v, detail)
+			}
+		case ParamTarget, ParamWildcardTarget:
+			for _, sel := range []string{"@a", "@e", "@p", "@r", "@s"} {
+				add(sel, detail)
+			}
+			for _, name := range players {
+				if strings.ContainsAny(name, " @") {
+					name = `"` + name + `"`
+				}
+				add(name, detail)
+			}
+		case ParamPosition, ParamBlockPosition:
+			add("~", detail)
+			add("^", detail)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Text < out[j].Text })
+	return out
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testCommandTree() *CommandTree {
+	specs := []*CommandSpec{
+		{Name: "give", Description: "Gives an item to a player.", Overloads: [][]CommandParam{{
+			{Name: "player", Kind: ParamTarget},
+			{Name: "itemName", Kind: ParamString},
+			{Name: "amount", Kind: ParamInt, Optional: true},
+		}}},
+		{Name: "gamemode", Description: "Sets a player's game mode.", Overloads: [][]CommandParam{{
+			{Name: "gameMode", Kind: ParamEnum, EnumName: "GameMode", EnumValues: []string{"survival", "creative", "adventure"}},
+			{Name: "player", Kind: ParamTarget, Optional: true},
+		}}},
+		{Name: "tp", Description: "Teleports entities.", Overloads: [][]CommandParam{
+			{{Name: "destination", Kind: ParamPosition}},
+			{{Name: "victim", Kind: ParamTarget}, {Name: "destination", Kind: ParamPosition}},
+		}},
+		{Name: "say", Description: "Sends a message in the chat.", Overloads: [][]CommandParam{{
+			{Name: "message", Kind: ParamMessage},
+		}}},
+	}
+	tree := &CommandTree{commands: make(map[string]*CommandSpec), aliases: map[string]string{"teleport": "tp"}}
+	for _, spec := range specs {
+		tree.commands[spec.Name] = spec
+	}
+	return tree
+}
+
+func TestValidateCommand(t *testing.T) {
+	tree := testCommandTree()
+	tests := []struct {
+		line string
+		want CommandValidation
+	}{
+		{line: "give @a apple", want: CommandValidation{Valid: true, Command: "give"}},
+		{line: " /give @a[name=\"a b\"] apple 5", want: CommandValidation{Valid: true, Command: "give"}},
+		{line: "teleport ~ ~1 ~", want: CommandValidation{Valid: true, Command: "tp"}},
+		{line: "tp @p 1 2 3", want: CommandValidation{Valid: true, Command: "tp", Overload: 1}},
+		{line: "say hello there world", want: CommandValidation{Valid: true, Command: "say"}},
+		{line: "gamemode CREATIVE Steve", want: CommandValidation{Valid: true, Command: "gamemode"}},
+		{line: "", want: CommandValidation{Overload: -1, Message: "empty command"}},
+		{line: "fly @s", want: CommandValidation{Overload: -1, Message: `unknown command "fly"`}},
+		{line: "give @a apple five", want: CommandValidation{
+			Command: "give", ErrorOffset: 14, Message: `"five" is not a valid amount: int`, Expected: []string{"amount: int"},
+		}},
+		{line: "give", want: CommandValidation{
+			Command: "give", ErrorOffset: 4, Message: "missing player: target", Expected: []string{"player: target"},
+		}},
+		{line: "gamemode flying", want: CommandValidation{
+			Command: "gamemode", ErrorOffset: 9, Message: `"flying" is not a valid gameMode: GameMode`, Expected: []string{"gameMode: GameMode"},
+		}},
+		{line: "give @a apple 1 extra", want: CommandValidation{
+			Command: "give", ErrorOffset: 16, Message: `unexpected "extra"`,
+		}},
+	}
+	for _, tt := range tests {
+		got := ValidateCommand(tree, tt.line)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ValidateCommand(%q) = %+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestCompleteCommand(t *testing.T) {
+	tree := testCommandTree()
+	players := []string{"Steve", "Alex Two"}
+	tests := []struct {
+		line   string
+		cursor int
+		want   []string
+		from   int
+	}{
+		{line: "ga", cursor: -1, want: []string{"gamemode"}},
+		{line: "/t", cursor: -1, want: []string{"teleport", "tp"}, from: 1},
+		// Zero is a real position: the empty line before the command.
+		{line: "give", cursor: 0, want: []string{"gamemode", "give", "say", "teleport", "tp"}},
+		{line: "give @a apple", cursor: 2, want: []string{"give"}},
+		{line: "gamemode c", cursor: -1, want: []string{"creative"}, from: 9},
+		{line: "gamemode ", cursor: 99, want: []string{"adventure", "creative", "survival"}, from: 9},
+		{line: "gamemode creative ", cursor: -1, want: []string{`"Alex Two"`, "@a", "@e", "@p", "@r", "@s", "Steve"}, from: 18},
+		{line: "gamemode creative S", cursor: -1, want: []string{"Steve"}, from: 18},
+		{line: "tp @p ", cursor: -1, want: []string{"^", "~"}, from: 6},
+		{line: "give @a apple ", cursor: -1, want: nil, from: 14},
+		{line: "fly ", cursor: -1, want: nil},
+	}
+	for _, tt := range tests {
+		got := CompleteCommand(tree, tt.line, tt.cursor, players)
+		var texts []string
+		for _, c := range got {
+			texts = append(texts, c.Text)
+			if c.ReplaceFrom != tt.from {
+				t.Errorf("CompleteCommand(%q, %d): %q replaces from %d, want %d", tt.line, tt.cursor, c.Text, c.ReplaceFrom, tt.from)
+			}
+		}
+		if !reflect.DeepEqual(texts, tt.want) {
+			t.Errorf("CompleteCommand(%q, %d) = %q, want %q", tt.line, tt.cursor, texts, tt.want)
+		}
+	}
+}
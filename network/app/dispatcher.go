@@ -0,0 +1,230 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCommandQueueFull is returned by fail-fast acquisitions when the lane
+// already holds QueueSize pending commands.
+var ErrCommandQueueFull = errors.New("command queue is full")
+
+// CommandLane selects the priority of an outbound command. Lower lanes are
+// always drained first.
+type CommandLane int
+
+const (
+	LaneAdmin CommandLane = iota
+	LaneAutomation
+	LaneCosmetic
+
+	laneCount
+)
+
+// DispatcherConfig controls command pacing.
+type DispatcherConfig struct {
+	// PerTick is the number of commands released every Tick.
+	PerTick int
+	Tick    time.Duration
+	// QueueSize bounds the pending commands of each lane.
+	QueueSize int
+}
+
+// DefaultDispatcherConfig keeps the bot below the rental server's anti-spam
+// threshold under sustained load.
+var DefaultDispatcherConfig = DispatcherConfig{
+	PerTick:   4,
+	Tick:      50 * time.Millisecond,
+	QueueSize: 512,
+}
+
+// LaneStats describes one priority lane.
+type LaneStats struct {
+	Depth      int
+	MaxDepth   int
+	Dispatched uint64
+	Rejected   uint64
+	Cancelled  uint64
+	// AverageWait is the mean queueing delay of dispatched commands.
+	AverageWait time.Duration
+}
+
+// DispatcherStats is a snapshot of the dispatcher counters.
+type DispatcherStats struct {
+	Config DispatcherConfig
+	Lanes  [laneCount]LaneStats
+}
+
+type dispatchTicket struct {
+	ready    chan struct{}
+	enqueued time.Time
+}
+
+// CommandDispatcher paces outbound commands across priority lanes so bursts
+// from several clients do not trip the server's anti-spam.
+type CommandDispatcher struct {
+	mu        sync.Mutex
+	cfg       DispatcherConfig
+	lanes     [laneCount][]*dispatchTicket
+	stats     [laneCount]LaneStats
+	totalWait [laneCount]time.Duration
+	space     chan struct{}
+	running   bool
+}
+
+// NewCommandDispatcher constructs a dispatcher using cfg.
+func NewCommandDispatcher(cfg DispatcherConfig) *CommandDispatcher {
+	d := &CommandDispatcher{space: make(chan struct{})}
+	d.cfg = normalizeDispatcherConfig(cfg)
+	return d
+}
+
+// Acquire waits until the dispatcher releases a slot on lane. When failFast
+// is set and the lane is saturated it returns ErrCommandQueueFull instead of
+// waiting for room.
+func (d *CommandDispatcher) Acquire(ctx context.Context, lane CommandLane, failFast bool) error {
+	if lane < 0 || lane >= laneCount {
+		lane = LaneAutomation
+	}
+	ticket := &dispatchTicket{ready: make(chan struct{})}
+	for {
+		d.mu.Lock()
+		if len(d.lanes[lane]) < d.cfg.QueueSize {
+			break
+		}
+		if failFast {
+			d.stats[lane].Rejected++
+			d.mu.Unlock()
+			return ErrCommandQueueFull
+		}
+		space := d.space
+		d.mu.Unlock()
+		select {
+		case <-space:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	ticket.enqueued = time.Now()
+	d.lanes[lane] = append(d.lanes[lane], ticket)
+	if depth := len(d.lanes[lane]); depth > d.stats[lane].MaxDepth {
+		d.stats[lane].MaxDepth = depth
+	}
+	if !d.running {
+		d.running = true
+		go d.run()
+	}
+	d.mu.Unlock()
+
+	select {
+	case <-ticket.ready:
+		return nil
+	case <-ctx.Done():
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		select {
+		case <-ticket.ready:
+			// Released concurrently; the slot is consumed either way.
+		default:
+			d.remove(lane, ticket)
+			d.stats[lane].Cancelled++
+		}
+		return ctx.Err()
+	}
+}
+
+// Configure replaces the pacing configuration. Zero fields keep their
+// current value.
+func (d *CommandDispatcher) Configure(cfg DispatcherConfig) DispatcherConfig {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if cfg.PerTick > 0 {
+		d.cfg.PerTick = cfg.PerTick
+	}
+	if cfg.Tick > 0 {
+		d.cfg.Tick = cfg.Tick
+	}
+	if cfg.QueueSize > 0 {
+		d.cfg.QueueSize = cfg.QueueSize
+	}
+	d.signalSpace()
+	return d.cfg
+}
+
+// Stats returns a snapshot of queue depths and counters.
+func (d *CommandDispatcher) Stats() DispatcherStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := DispatcherStats{Config: d.cfg, Lanes: d.stats}
+	for lane := range out.Lanes {
+		out.Lanes[lane].Depth = len(d.lanes[lane])
+		if n := out.Lanes[lane].Dispatched; n > 0 {
+			out.Lanes[lane].AverageWait = d.totalWait[lane] / time.Duration(n)
+		}
+	}
+	return out
+}
+
+func (d *CommandDispatcher) run() {
+	for {
+		d.mu.Lock()
+		released := 0
+		now := time.Now()
+		for lane := CommandLane(0); lane < laneCount && released < d.cfg.PerTick; lane++ {
+			for len(d.lanes[lane]) > 0 && released < d.cfg.PerTick {
+				ticket := d.lanes[lane][0]
+				d.lanes[lane][0] = nil
+				d.lanes[lane] = d.lanes[lane][1:]
+				close(ticket.ready)
+				d.stats[lane].Dispatched++
+				d.totalWait[lane] += now.Sub(ticket.enqueued)
+				released++
+			}
+		}
+		if released > 0 {
+			d.signalSpace()
+		}
+		if released == 0 {
+			// Nothing was sent during the last tick, so the next command
+			// may go out immediately.
+			d.running = false
+			d.mu.Unlock()
+			return
+		}
+		tick := d.cfg.Tick
+		d.mu.Unlock()
+		time.Sleep(tick)
+	}
+}
+
+func (d *CommandDispatcher) remove(lane CommandLane, ticket *dispatchTicket) {
+	queue := d.lanes[lane]
+	for i, t := range queue {
+		if t == ticket {
+			d.lanes[lane] = append(queue[:i], queue[i+1:]...)
+			d.signalSpace()
+			return
+		}
+	}
+}
+
+// signalSpace wakes callers waiting for room in a lane. Callers hold d.mu.
+func (d *CommandDispatcher) signalSpace() {
+	close(d.space)
+	d.space = make(chan struct{})
+}
+
+func normalizeDispatcherConfig(cfg DispatcherConfig) DispatcherConfig {
+	if cfg.PerTick <= 0 {
+		cfg.PerTick = DefaultDispatcherConfig.PerTick
+	}
+	if cfg.Tick <= 0 {
+		cfg.Tick = DefaultDispatcherConfig.Tick
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = DefaultDispatcherConfig.QueueSize
+	}
+	return cfg
+}
@@ -0,0 +1,294 @@
+package app
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+)
+
+// EntityPacketIDs lists the packets an EntityTracker consumes.
+var EntityPacketIDs = []uint32{
+	packet.IDAddActor,
+	packet.IDAddItemActor,
+	packet.IDRemoveActor,
+	packet.IDMoveActorDelta,
+	packet.IDMoveActorAbsolute,
+	packet.IDSetActorData,
+	packet.IDChangeDimension,
+}
+
+// ItemEntityType is the entity type reported for dropped items.
+const ItemEntityType = "minecraft:item"
+
+// TrackedEntity is a snapshot of a non-player entity known to the bot.
+type TrackedEntity struct {
+	UniqueID  int64
+	RuntimeID uint64
+	Type      string
+	Position  [3]float32
+	// Rotation holds pitch, yaw and head yaw in degrees.
+	Rotation  [3]float32
+	Metadata  map[uint32]any
+	SpawnedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EntityEventType enumerates tracker notifications.
+type EntityEventType int
+
+const (
+	EntitySpawned EntityEventType = iota
+	EntityDespawned
+	EntityMoved
+	EntityUpdated
+)
+
+// EntityEvent describes a change applied to the tracker.
+type EntityEvent struct {
+	Type      EntityEventType
+	Entity    TrackedEntity
+	Timestamp time.Time
+}
+
+// EntityFilter selects entities by type and distance.
+// An empty Types list and a zero Radius match everything.
+type EntityFilter struct {
+	Types  []string
+	Center [3]float32
+	Radius float32
+}
+
+// Match reports whether entity satisfies the filter.
+func (f EntityFilter) Match(entity TrackedEntity) bool {
+	if len(f.Types) > 0 {
+		matched := false
+		for _, t := range f.Types {
+			if NormalizeEntityType(t) == entity.Type {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.Radius > 0 {
+		var sum float64
+		for i := 0; i < 3; i++ {
+			d := float64(entity.Position[i] - f.Center[i])
+			sum += d * d
+		}
+		if math.Sqrt(sum) > float64(f.Radius) {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeEntityType adds the minecraft namespace to bare identifiers.
+func NormalizeEntityType(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t))
+	if t != "" && !strings.Contains(t, ":") {
+		t = "minecraft:" + t
+	}
+	return t
+}
+
+// EntityTracker keeps a registry of entities built from actor packets.
+// Players are left to the UQ holder and are not tracked here.
+type EntityTracker struct {
+	mu        sync.RWMutex
+	byRuntime map[uint64]*TrackedEntity
+	byUnique  map[int64]uint64
+
+	events *Broadcast[EntityEvent]
+}
+
+// NewEntityTracker constructs an empty tracker.
+func NewEntityTracker() *EntityTracker {
+	return &EntityTracker{
+		byRuntime: make(map[uint64]*TrackedEntity),
+		byUnique:  make(map[int64]uint64),
+		events:    NewBroadcast[EntityEvent](),
+	}
+}
+
+// HandlePacket applies an actor packet to the registry.
+func (t *EntityTracker) HandlePacket(pk packet.Packet) {
+	now := time.Now()
+	switch p := pk.(type) {
+	case *packet.AddActor:
+		t.spawn(&TrackedEntity{
+			UniqueID:  p.EntityUniqueID,
+			RuntimeID: p.EntityRuntimeID,
+			Type:      NormalizeEntityType(p.EntityType),
+			Position:  p.Position,
+			Rotation:  [3]float32{p.Pitch, p.Yaw, p.HeadYaw},
+			Metadata:  copyMetadata(p.EntityMetadata),
+			SpawnedAt: now,
+			UpdatedAt: now,
+		})
+	case *packet.AddItemActor:
+		t.spawn(&TrackedEntity{
+			UniqueID:  p.EntityUniqueID,
+			RuntimeID: p.EntityRuntimeID,
+			Type:      ItemEntityType,
+			Position:  p.Position,
+			Metadata:  copyMetadata(p.EntityMetadata),
+			SpawnedAt: now,
+			UpdatedAt: now,
+		})
+	case *packet.RemoveActor:
+		t.mu.Lock()
+		runtimeID, ok := t.byUnique[p.EntityUniqueID]
+		var removed *TrackedEntity
+		if ok {
+			removed = t.byRuntime[runtimeID]
+			delete(t.byRuntime, runtimeID)
+			delete(t.byUnique, p.EntityUniqueID)
+		}
+		t.mu.Unlock()
+		if removed != nil {
+			removed.UpdatedAt = now
+			t.publish(EntityDespawned, removed, now)
+		}
+	case *packet.MoveActorDelta:
+		t.update(p.EntityRuntimeID, EntityMoved, now, func(e *TrackedEntity) {
+			if p.Flags&packet.MoveActorDeltaFlagHasX != 0 {
+				e.Position[0] = p.Position[0]
+			}
+			if p.Flags&packet.MoveActorDeltaFlagHasY != 0 {
+				e.Position[1] = p.Position[1]
+			}
+			if p.Flags&packet.MoveActorDeltaFlagHasZ != 0 {
+				e.Position[2] = p.Position[2]
+			}
+			if p.Flags&packet.MoveActorDeltaFlagHasRotX != 0 {
+				e.Rotation[0] = p.Rotation[0]
+			}
+			if p.Flags&packet.MoveActorDeltaFlagHasRotY != 0 {
+				e.Rotation[1] = p.Rotation[1]
+			}
+			if p.Flags&packet.MoveActorDeltaFlagHasRotZ != 0 {
+				e.Rotation[2] = p.Rotation[2]
+			}
+		})
+	case *packet.MoveActorAbsolute:
+		t.update(p.EntityRuntimeID, EntityMoved, now, func(e *TrackedEntity) {
+			e.Position = p.Position
+			e.Rotation = p.Rotation
+		})
+	case *packet.SetActorData:
+		t.update(p.EntityRuntimeID, EntityUpdated, now, func(e *TrackedEntity) {
+			if e.Metadata == nil {
+				e.Metadata = make(map[uint32]any, len(p.EntityMetadata))
+			}
+			for k, v := range p.EntityMetadata {
+				e.Metadata[k] = v
+			}
+		})
+	case *packet.ChangeDimension:
+		// The client drops every entity when it switches dimension.
+		t.Reset()
+	}
+}
+
+// Get returns the entity with the provided runtime ID.
+func (t *EntityTracker) Get(runtimeID uint64) (TrackedEntity, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	e, ok := t.byRuntime[runtimeID]
+	if !ok {
+		return TrackedEntity{}, false
+	}
+	return e.clone(), true
+}
+
+// GetByUniqueID returns the entity with the provided unique ID.
+func (t *EntityTracker) GetByUniqueID(uniqueID int64) (TrackedEntity, bool) {
+	t.mu.RLock()
+	runtimeID, ok := t.byUnique[uniqueID]
+	t.mu.RUnlock()
+	if !ok {
+		return TrackedEntity{}, false
+	}
+	return t.Get(runtimeID)
+}
+
+// Query returns a copy of every entity matching filter.
+func (t *EntityTracker) Query(filter EntityFilter) []TrackedEntity {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	out := make([]TrackedEntity, 0)
+	for _, e := range t.byRuntime {
+		if filter.Match(*e) {
+			out = append(out, e.clone())
+		}
+	}
+	return out
+}
+
+// Events subscribes to spawn, despawn, move and update notifications.
+func (t *EntityTracker) Events(buffer int) (<-chan EntityEvent, func()) {
+	return t.events.Subscribe(buffer)
+}
+
+// Reset forgets all tracked entities without notifying subscribers.
+func (t *EntityTracker) Reset() {
+	t.mu.Lock()
+	t.byRuntime = make(map[uint64]*TrackedEntity)
+	t.byUnique = make(map[int64]uint64)
+	t.mu.Unlock()
+}
+
+func (t *EntityTracker) spawn(e *TrackedEntity) {
+	t.mu.Lock()
+	if old, ok := t.byRuntime[e.RuntimeID]; ok {
+		delete(t.byUnique, old.UniqueID)
+	}
+	t.byRuntime[e.RuntimeID] = e
+	t.byUnique[e.UniqueID] = e.RuntimeID
+	t.mu.Unlock()
+	t.publish(EntitySpawned, e, e.SpawnedAt)
+}
+
+func (t *EntityTracker) update(runtimeID uint64, kind EntityEventType, now time.Time, fn func(*TrackedEntity)) {
+	t.mu.Lock()
+	e, ok := t.byRuntime[runtimeID]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+	fn(e)
+	e.UpdatedAt = now
+	snapshot := e.clone()
+	t.mu.Unlock()
+	t.events.Publish(EntityEvent{Type: kind, Entity: snapshot, Timestamp: now})
+}
+
+func (t *EntityTracker) publish(kind EntityEventType, e *TrackedEntity, at time.Time) {
+	t.mu.RLock()
+	snapshot := e.clone()
+	t.mu.RUnlock()
+	t.events.Publish(EntityEvent{Type: kind, Entity: snapshot, Timestamp: at})
+}
+
+func (e *TrackedEntity) clone() TrackedEntity {
+	out := *e
+	out.Metadata = copyMetadata(e.Metadata)
+	return out
+}
+
+func copyMetadata(in map[uint32]any) map[uint32]any {
+	if in == nil {
+		return nil
+	}
+	out := make(map[uint32]any, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,222 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/FunShuttler/uqholder"
+)
+
+var (
+	// ErrRetrieverExists is returned when a retriever ID is still waiting
+	// for input.
+	ErrRetrieverExists = errors.New("retriever id already registered")
+	// ErrRetrieverUnknown is returned for retriever IDs that were never
+	// registered, were already collected or have expired.
+	ErrRetrieverUnknown = errors.New("retriever id not found")
+	// ErrInputTimeout is returned when the player did not type anything in
+	// time.
+	ErrInputTimeout = errors.New("timed out waiting for player input")
+	// ErrInputCancelled is returned for interceptions cancelled by a client.
+	ErrInputCancelled = errors.New("player input interception cancelled")
+)
+
+const (
+	// inputRetention keeps a finished interception around so a client that
+	// starts waiting late still gets the result.
+	inputRetention = time.Minute
+	// claimHistory bounds the remembered decisions about recent packets.
+	claimHistory = 256
+)
+
+// CapturedInput is a chat message taken by an interception.
+type CapturedInput struct {
+	RetrieverID string
+	PlayerUUID  string
+	PlayerName  string
+	Message     string
+	At          time.Time
+}
+
+type pendingInput struct {
+	id       string
+	uuid     string
+	name     string
+	suppress bool
+	seq      uint64
+
+	done   chan struct{}
+	result CapturedInput
+	err    error
+	timer  *time.Timer
+}
+
+// InputInterceptor captures the next chat message of chosen players. Every
+// consumer of Text packets calls Claim with the packet it received; the first
+// call decides whether an interception takes the message, and later calls
+// for the same packet get the same answer, so suppression does not depend on
+// the order in which packet listeners run.
+type InputInterceptor struct {
+	mu      sync.Mutex
+	seq     uint64
+	inputs  map[string]*pendingInput
+	decided map[*packet.Text]bool
+	order   []*packet.Text
+}
+
+// NewInputInterceptor constructs an interceptor with nothing registered.
+func NewInputInterceptor() *InputInterceptor {
+	return &InputInterceptor{
+		inputs:  make(map[string]*pendingInput),
+		decided: make(map[*packet.Text]bool),
+	}
+}
+
+// Register waits for the next chat message of the named player under id.
+// When suppress is set the message is hidden from other chat consumers. An
+// id may be reused once its previous interception has finished; a result
+// that was never collected is dropped.
+func (i *InputInterceptor) Register(id, playerUUID, playerName string, timeout time.Duration, suppress bool) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if old, ok := i.inputs[id]; ok {
+		select {
+		case <-old.done:
+		default:
+			return ErrRetrieverExists
+		}
+	}
+	i.seq++
+	p := &pendingInput{
+		id:       id,
+		uuid:     playerUUID,
+		name:     playerName,
+		suppress: suppress,
+		seq:      i.seq,
+		done:     make(chan struct{}),
+	}
+	p.timer = time.AfterFunc(timeout, func() { i.finish(p, ErrInputTimeout) })
+	i.inputs[id] = p
+	return nil
+}
+
+// Cancel ends the interception under id if it is still waiting. It reports
+// whether id is known; cancelling a finished interception does nothing.
+func (i *InputInterceptor) Cancel(id string) bool {
+	i.mu.Lock()
+	p, ok := i.inputs[id]
+	i.mu.Unlock()
+	if ok {
+		i.finish(p, ErrInputCancelled)
+	}
+	return ok
+}
+
+// Wait blocks until the interception under id finishes and returns what it
+// captured. The result is handed out once.
+func (i *InputInterceptor) Wait(ctx context.Context, id string) (CapturedInput, error) {
+	i.mu.Lock()
+	p, ok := i.inputs[id]
+	i.mu.Unlock()
+	if !ok {
+		return CapturedInput{}, ErrRetrieverUnknown
+	}
+	select {
+	case <-ctx.Done():
+		return CapturedInput{}, ctx.Err()
+	case <-p.done:
+	}
+	i.mu.Lock()
+	if i.inputs[id] == p {
+		delete(i.inputs, id)
+	}
+	i.mu.Unlock()
+	return p.result, p.err
+}
+
+// Claim offers a Text packet to the pending interceptions and reports
+// whether it must be hidden from the caller.
+func (i *InputInterceptor) Claim(text *packet.Text) bool {
+	if text == nil || text.TextType != packet.TextTypeChat {
+		return false
+	}
+	i.mu.Lock()
+	if suppressed, ok := i.decided[text]; ok {
+		i.mu.Unlock()
+		return suppressed
+	}
+	name := uqholder.ToPlainName(text.SourceName)
+	var match *pendingInput
+	for _, p := range i.inputs {
+		if p.result.At.IsZero() && p.err == nil && strings.EqualFold(p.name, name) {
+			// The oldest interception of a player is served first.
+			if match == nil || p.seq < match.seq {
+				match = p
+			}
+		}
+	}
+	suppressed := match != nil && match.suppress
+	i.decided[text] = suppressed
+	i.order = append(i.order, text)
+	if len(i.order) > claimHistory {
+		delete(i.decided, i.order[0])
+		i.order = i.order[1:]
+	}
+	if match != nil {
+		match.result = CapturedInput{
+			RetrieverID: match.id,
+			PlayerUUID:  match.uuid,
+			PlayerName:  match.name,
+			Message:     text.Message,
+			At:          time.Now(),
+		}
+	}
+	i.mu.Unlock()
+	if match != nil {
+		i.finish(match, nil)
+	}
+	return suppressed
+}
+
+// Reset fails every pending interception, for example on disconnect.
+func (i *InputInterceptor) Reset() {
+	i.mu.Lock()
+	pending := make([]*pendingInput, 0, len(i.inputs))
+	for _, p := range i.inputs {
+		pending = append(pending, p)
+	}
+	i.decided = make(map[*packet.Text]bool)
+	i.order = nil
+	i.mu.Unlock()
+	for _, p := range pending {
+		i.finish(p, ErrNotConnected)
+	}
+}
+
+// finish completes p once, with err unless a message was captured, and
+// schedules its removal if nobody collects it.
+func (i *InputInterceptor) finish(p *pendingInput, err error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	select {
+	case <-p.done:
+		return
+	default:
+	}
+	if p.result.At.IsZero() {
+		p.err = err
+	}
+	p.timer.Stop()
+	close(p.done)
+	time.AfterFunc(inputRetention, func() {
+		i.mu.Lock()
+		defer i.mu.Unlock()
+		if i.inputs[p.id] == p {
+			delete(i.inputs, p.id)
+		}
+	})
+}
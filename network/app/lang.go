@@ -0,0 +1,152 @@
+package app
+
+import (
+	"strconv"
+	"strings"
+)
+
+// commandLang holds the en_US strings for the command output keys the bot
+// commonly receives. Unknown keys are rendered with their parameters appended.
+var commandLang = map[string]string{
+	"commands.generic.unknown":                      "Unknown command: %s. Please check that the command exists and that you have permission to use it.",
+	"commands.generic.syntax":                       "Syntax error: Unexpected \"%2$s\": at \"%1$s>>%2$s<<%3$s\"",
+	"commands.generic.usage":                        "Usage: %s",
+	"commands.generic.exception":                    "An unknown error occurred while attempting to perform this command",
+	"commands.generic.permission":                   "You do not have permission to use this slash command.",
+	"commands.generic.noTargetMatch":                "No targets matched selector",
+	"commands.generic.tooManyTargets":               "Too many targets matched selector",
+	"commands.generic.player.notFound":              "That player cannot be found",
+	"commands.generic.outOfWorld":                   "Cannot place block outside of the world",
+	"commands.generic.boolean.invalid":              "'%s' is not true or false",
+	"commands.generic.num.invalid":                  "'%s' is not a valid number",
+	"commands.generic.num.tooSmall":                 "The number you have entered (%d) is too small, it must be at least %d",
+	"commands.generic.num.tooBig":                   "The number you have entered (%d) is too big, it must be at most %d",
+	"commands.give.success":                         "Gave %s * %d to %s",
+	"commands.tp.success":                           "Teleported %s to %s",
+	"commands.tp.success.coordinates":               "Teleported %s to %s, %s, %s",
+	"commands.kill.successful":                      "Killed %s",
+	"commands.clear.success":                        "Cleared the inventory of %s, removing %d items",
+	"commands.effect.success":                       "Gave %1$s * %2$d to %3$s for %4$d seconds",
+	"commands.xp.success":                           "Gave %s experience to %s",
+	"commands.summon.success":                       "Object successfully summoned",
+	"commands.setblock.success":                     "Block placed",
+	"commands.setblock.noChange":                    "The block couldn't be placed",
+	"commands.fill.success":                         "%d blocks filled",
+	"commands.fill.outOfWorld":                      "Cannot place blocks outside of the world",
+	"commands.fill.tooManyBlocks":                   "Too many blocks in the specified area (%d > %d)",
+	"commands.clone.success":                        "%d blocks cloned",
+	"commands.gamemode.success.self":                "Set own game mode to %s",
+	"commands.gamemode.success.other":               "Set %s's game mode to %s",
+	"commands.gamerule.success":                     "Game rule %s has been updated to %s",
+	"commands.difficulty.success":                   "Set game difficulty to %s",
+	"commands.time.set":                             "Set the time to %d",
+	"commands.time.added":                           "Added %d to the time",
+	"commands.time.query":                           "Time is %d",
+	"commands.weather.clear":                        "Changing to clear weather",
+	"commands.weather.rain":                         "Changing to rainy weather",
+	"commands.weather.thunder":                      "Changing to rain and thunder",
+	"commands.weather.query.clear":                  "Weather state is: clear",
+	"commands.weather.query.rain":                   "Weather state is: rain",
+	"commands.weather.query.thunder":                "Weather state is: thunder",
+	"commands.setworldspawn.success":                "Set the world spawn point to (%s, %s, %s)",
+	"commands.spawnpoint.success.single":            "Set %s's spawn point to (%s, %s, %s)",
+	"commands.tag.add.success.single":               "Added tag '%1$s' to %2$s",
+	"commands.tag.add.success.multiple":             "Added tag '%1$s' to %2$d entities",
+	"commands.tag.remove.success.single":            "Removed tag '%1$s' from %2$s",
+	"commands.tag.remove.success.multiple":          "Removed tag '%1$s' from %2$d entities",
+	"commands.tag.list.single.success":              "%1$s has %2$d tags: %3$s",
+	"commands.tag.list.single.empty":                "%s has no tags",
+	"commands.testfor.success":                      "Found %s",
+	"commands.querytarget.success":                  "Target data: %s",
+	"commands.scoreboard.players.set.success":       "Set score of %s for player %s to %d",
+	"commands.scoreboard.players.add.success":       "Added %s to [%s] for %s (now %s)",
+	"commands.scoreboard.objectives.add.success":    "Added new objective '%s' successfully",
+	"commands.scoreboard.objectives.remove.success": "Removed objective '%s' successfully",
+	"commands.scoreboard.objectives.list.count":     "Showing %d objective(s):",
+	"commands.scoreboard.objectives.list.entry":     "- %s: displays as '%s' and is type '%s'",
+	"commands.scoreboard.objectives.list.empty":     "There are no objectives",
+	"commands.scoreboard.objectiveNotFound":         "No objective was found by the name '%s'",
+	"commands.scoreboard.players.list.count":        "Showing %d tracked players on the scoreboard:",
+	"commands.scoreboard.players.list.empty":        "There are no tracked players on the scoreboard",
+	"commands.scoreboard.players.list.player.count": "Showing %d tracked objective(s) for %s:",
+	"commands.scoreboard.players.list.player.entry": "- %2$s: %1$d (%3$s)",
+	"commands.scoreboard.players.list.player.empty": "Player %s has no scores recorded",
+	"commands.title.success":                        "Title command successfully executed",
+	"commands.op.success":                           "Opped: %s",
+	"commands.deop.success":                         "De-opped: %s",
+	"commands.kick.success":                         "Kicked %s from the game",
+}
+
+// RenderLangKey formats a Bedrock language key with its parameters. It
+// understands %s, %d, positional %n$s / %n$d and %%. Parameters that are
+// themselves %-prefixed keys are translated first.
+func RenderLangKey(key string, params []string) string {
+	args := make([]string, len(params))
+	for i, p := range params {
+		args[i] = translateParam(p)
+	}
+	format, ok := commandLang[strings.TrimPrefix(key, "%")]
+	if !ok {
+		if len(args) == 0 {
+			return key
+		}
+		return key + " " + strings.Join(args, ", ")
+	}
+	return formatLang(format, args)
+}
+
+func translateParam(p string) string {
+	if !strings.HasPrefix(p, "%") {
+		return p
+	}
+	if format, ok := commandLang[p[1:]]; ok {
+		return format
+	}
+	return p
+}
+
+func formatLang(format string, args []string) string {
+	var b strings.Builder
+	next := 0
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i+1 >= len(format) {
+			b.WriteByte(c)
+			continue
+		}
+		if format[i+1] == '%' {
+			b.WriteByte('%')
+			i++
+			continue
+		}
+		// Positional form: %<n>$s or %<n>$d.
+		j := i + 1
+		for j < len(format) && format[j] >= '0' && format[j] <= '9' {
+			j++
+		}
+		index := -1
+		end := i + 1
+		switch {
+		case j > i+1 && j+1 < len(format) && format[j] == '$' && isLangVerb(format[j+1]):
+			n, _ := strconv.Atoi(format[i+1 : j])
+			index, end = n-1, j+1
+		case j == i+1 && isLangVerb(format[i+1]):
+			index = next
+			next++
+		default:
+			b.WriteByte(c)
+			continue
+		}
+		if index >= 0 && index < len(args) {
+			b.WriteString(args[index])
+		} else {
+			b.WriteString(format[i : end+1])
+		}
+		i = end
+	}
+	return b.String()
+}
+
+func isLangVerb(c byte) bool {
+	return c == 's' || c == 'd'
+}
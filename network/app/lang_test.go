@@ -0,0 +1,50 @@
+package app
+
+import "testing"
+
+func TestFormatLang(t *testing.T) {
+	tests := []struct {
+		format string
+		args   []string
+		want   string
+	}{
+		{format: "Killed %s", args: []string{"Steve"}, want: "Killed Steve"},
+		{format: "Gave %s * %d to %s", args: []string{"apple", "5", "Alex"}, want: "Gave apple * 5 to Alex"},
+		{format: "at %1$s>>%2$s<<%3$s, %2$s again", args: []string{"a", "b", "c"}, want: "at a>>b<<c, b again"},
+		{format: "%2$d then %1$s", args: []string{"x", "7"}, want: "7 then x"},
+		{format: "100%% done: %s", args: []string{"ok"}, want: "100% done: ok"},
+		{format: "missing %s and %s", args: []string{"one"}, want: "missing one and %s"},
+		{format: "missing %3$s", args: []string{"one"}, want: "missing %3$s"},
+		{format: "literal %x and %", args: nil, want: "literal %x and %"},
+		{format: "no verbs", args: []string{"unused"}, want: "no verbs"},
+	}
+	for _, tt := range tests {
+		if got := formatLang(tt.format, tt.args); got != tt.want {
+			t.Errorf("formatLang(%q, %q) = %q, want %q", tt.format, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestRenderLangKey(t *testing.T) {
+	tests := []struct {
+		key    string
+		params []string
+		want   string
+	}{
+		{key: "commands.kill.successful", params: []string{"Steve"}, want: "Killed Steve"},
+		{key: "%commands.kill.successful", params: []string{"Steve"}, want: "Killed Steve"},
+		{key: "commands.generic.num.tooBig", params: []string{"300", "255"}, want: "The number you have entered (300) is too big, it must be at most 255"},
+		{key: "commands.generic.syntax", params: []string{"give ", "x", " 1"}, want: `Syntax error: Unexpected "x": at "give >>x<< 1"`},
+		// Parameters that are keys themselves are translated.
+		{key: "commands.generic.usage", params: []string{"%commands.generic.noTargetMatch"}, want: "Usage: No targets matched selector"},
+		{key: "commands.generic.usage", params: []string{"%not.a.key"}, want: "Usage: %not.a.key"},
+		// Keys outside the table fall back to the key and its parameters.
+		{key: "commands.unknown.key", want: "commands.unknown.key"},
+		{key: "commands.unknown.key", params: []string{"a", "%commands.generic.noTargetMatch"}, want: "commands.unknown.key a, No targets matched selector"},
+	}
+	for _, tt := range tests {
+		if got := RenderLangKey(tt.key, tt.params); got != tt.want {
+			t.Errorf("RenderLangKey(%q, %q) = %q, want %q", tt.key, tt.params, got, tt.want)
+		}
+	}
+}
@@ -9,3 +9,12 @@ type Message struct {
 	Error     string
 	Timestamp time.Time
 }
+
+// PlayerJoin reports a player announced online by a PlayerList packet. The
+// players already online are announced when a session starts.
+type PlayerJoin struct {
+	UUID           string
+	Name           string
+	EntityUniqueID int64
+	Timestamp      time.Time
+}
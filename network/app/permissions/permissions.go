@@ -0,0 +1,326 @@
+// Package permissions keeps named permission roles and the rules that assign
+// them to players in a JSON file so they survive restarts.
+package permissions
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/tempest-core/network/app/jsonfile"
+)
+
+var (
+	// ErrRoleNotFound is returned for unknown role names.
+	ErrRoleNotFound = errors.New("permission role not found")
+	// ErrAssignmentNotFound is returned when removing an assignment that
+	// does not exist.
+	ErrAssignmentNotFound = errors.New("role assignment not found")
+	// ErrRoleInUse is returned when deleting a role that is still assigned.
+	ErrRoleInUse = errors.New("permission role is still assigned")
+	// ErrInvalid is wrapped for malformed roles and assignments.
+	ErrInvalid = errors.New("invalid permission role")
+)
+
+// Abilities is the full set of permission abilities a role grants.
+type Abilities struct {
+	Build            bool `json:"build"`
+	Mine             bool `json:"mine"`
+	DoorsAndSwitches bool `json:"doors_and_switches"`
+	OpenContainers   bool `json:"open_containers"`
+	AttackPlayers    bool `json:"attack_players"`
+	AttackMobs       bool `json:"attack_mobs"`
+	OperatorCommands bool `json:"operator_commands"`
+	Teleport         bool `json:"teleport"`
+}
+
+// Role is a named ability set with optional operator status and tags.
+type Role struct {
+	Name      string    `json:"name"`
+	Abilities Abilities `json:"abilities"`
+	// Op grants (true) or revokes (false) operator status; nil leaves it.
+	Op        *bool     `json:"op,omitempty"`
+	Tags      []string  `json:"tags,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// MatchKind selects how an assignment matches players.
+type MatchKind string
+
+const (
+	MatchUUID MatchKind = "uuid"
+	// MatchName matches player names against a case-insensitive glob.
+	MatchName    MatchKind = "name"
+	MatchDefault MatchKind = "default"
+)
+
+// Assignment gives a role to the players it matches. A UUID match wins over
+// name patterns, which are tried in the order they were added, and the
+// default applies to everyone else.
+type Assignment struct {
+	Kind MatchKind `json:"kind"`
+	// Pattern is a UUID, a name glob, or empty for the default.
+	Pattern   string    `json:"pattern,omitempty"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Store owns the roles and assignments.
+type Store struct {
+	path string
+
+	mu          sync.Mutex
+	roles       map[string]Role
+	assignments []Assignment
+}
+
+// Open loads the store from path. An empty path keeps everything in memory
+// only.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, roles: make(map[string]Role)}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// PutRole creates role or replaces the role of the same name.
+func (s *Store) PutRole(role Role) (Role, error) {
+	role.Name = strings.TrimSpace(role.Name)
+	if role.Name == "" {
+		return Role{}, fmt.Errorf("%w: name required", ErrInvalid)
+	}
+	tags := make([]string, 0, len(role.Tags))
+	for i, tag := range role.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return Role{}, fmt.Errorf("%w: tag %d is empty", ErrInvalid, i)
+		}
+		tags = append(tags, tag)
+	}
+	role.Tags = tags
+	role.UpdatedAt = time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, existed := s.roles[role.Name]
+	s.roles[role.Name] = role
+	if err := s.saveLocked(); err != nil {
+		if existed {
+			s.roles[role.Name] = prev
+		} else {
+			delete(s.roles, role.Name)
+		}
+		return Role{}, err
+	}
+	return role, nil
+}
+
+// Role returns the role called name.
+func (s *Store) Role(name string) (Role, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	role, ok := s.roles[name]
+	if !ok {
+		return Role{}, ErrRoleNotFound
+	}
+	return role, nil
+}
+
+// Roles returns every role ordered by name.
+func (s *Store) Roles() []Role {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Role, 0, len(s.roles))
+	for _, role := range s.roles {
+		out = append(out, role)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// DeleteRole removes a role that no assignment refers to.
+func (s *Store) DeleteRole(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	role, ok := s.roles[name]
+	if !ok {
+		return ErrRoleNotFound
+	}
+	for _, a := range s.assignments {
+		if a.Role == name {
+			return ErrRoleInUse
+		}
+	}
+	delete(s.roles, name)
+	if err := s.saveLocked(); err != nil {
+		s.roles[name] = role
+		return err
+	}
+	return nil
+}
+
+// Assign adds an assignment, replacing one of the same kind and pattern.
+func (s *Store) Assign(a Assignment) (Assignment, error) {
+	a.Pattern = strings.TrimSpace(a.Pattern)
+	switch a.Kind {
+	case MatchUUID:
+		if a.Pattern == "" {
+			return Assignment{}, fmt.Errorf("%w: uuid required", ErrInvalid)
+		}
+		a.Pattern = strings.ToLower(a.Pattern)
+	case MatchName:
+		if a.Pattern == "" {
+			return Assignment{}, fmt.Errorf("%w: name pattern required", ErrInvalid)
+		}
+		if _, err := path.Match(strings.ToLower(a.Pattern), ""); err != nil {
+			return Assignment{}, fmt.Errorf("%w: name pattern %q: %v", ErrInvalid, a.Pattern, err)
+		}
+	case MatchDefault:
+		a.Pattern = ""
+	default:
+		return Assignment{}, fmt.Errorf("%w: unknown match kind %q", ErrInvalid, a.Kind)
+	}
+	a.CreatedAt = time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.roles[a.Role]; !ok {
+		return Assignment{}, ErrRoleNotFound
+	}
+	prev := s.assignments
+	next := make([]Assignment, 0, len(prev)+1)
+	replaced := false
+	for _, existing := range prev {
+		if existing.Kind == a.Kind && strings.EqualFold(existing.Pattern, a.Pattern) {
+			// Keep the position so name patterns retain their precedence.
+			a.CreatedAt = existing.CreatedAt
+			next = append(next, a)
+			replaced = true
+			continue
+		}
+		next = append(next, existing)
+	}
+	if !replaced {
+		next = append(next, a)
+	}
+	s.assignments = next
+	if err := s.saveLocked(); err != nil {
+		s.assignments = prev
+		return Assignment{}, err
+	}
+	return a, nil
+}
+
+// Unassign removes the assignment of kind and pattern.
+func (s *Store) Unassign(kind MatchKind, pattern string) error {
+	pattern = strings.TrimSpace(pattern)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev := s.assignments
+	next := make([]Assignment, 0, len(prev))
+	for _, a := range prev {
+		if a.Kind == kind && strings.EqualFold(a.Pattern, pattern) {
+			continue
+		}
+		next = append(next, a)
+	}
+	if len(next) == len(prev) {
+		return ErrAssignmentNotFound
+	}
+	s.assignments = next
+	if err := s.saveLocked(); err != nil {
+		s.assignments = prev
+		return err
+	}
+	return nil
+}
+
+// Assignments returns every assignment in the order they were added.
+func (s *Store) Assignments() []Assignment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Assignment(nil), s.assignments...)
+}
+
+// Resolve returns the role of the player with uuid and name, and the
+// assignment that selected it.
+func (s *Store) Resolve(uuid, name string) (Role, Assignment, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	uuid = strings.ToLower(uuid)
+	name = strings.ToLower(name)
+	var byName, byDefault *Assignment
+	for i := range s.assignments {
+		a := &s.assignments[i]
+		switch a.Kind {
+		case MatchUUID:
+			if a.Pattern == uuid {
+				return s.roles[a.Role], *a, true
+			}
+		case MatchName:
+			if byName == nil && name != "" {
+				if ok, _ := path.Match(strings.ToLower(a.Pattern), name); ok {
+					byName = a
+				}
+			}
+		case MatchDefault:
+			byDefault = a
+		}
+	}
+	switch {
+	case byName != nil:
+		return s.roles[byName.Role], *byName, true
+	case byDefault != nil:
+		return s.roles[byDefault.Role], *byDefault, true
+	default:
+		return Role{}, Assignment{}, false
+	}
+}
+
+type storeFile struct {
+	Roles       []Role       `json:"roles"`
+	Assignments []Assignment `json:"assignments"`
+}
+
+func (s *Store) load() error {
+	if s.path == "" {
+		return nil
+	}
+	var file storeFile
+	if err := jsonfile.Read(s.path, &file); err != nil {
+		return fmt.Errorf("permissions: %w", err)
+	}
+	for _, role := range file.Roles {
+		s.roles[role.Name] = role
+	}
+	for _, a := range file.Assignments {
+		if _, ok := s.roles[a.Role]; !ok {
+			return fmt.Errorf("permissions: assignment %s %q: %w", a.Kind, a.Pattern, ErrRoleNotFound)
+		}
+		s.assignments = append(s.assignments, a)
+	}
+	return nil
+}
+
+func (s *Store) saveLocked() error {
+	if s.path == "" {
+		return nil
+	}
+	file := storeFile{Roles: make([]Role, 0, len(s.roles)), Assignments: s.assignments}
+	for _, role := range s.roles {
+		file.Roles = append(file.Roles, role)
+	}
+	sort.Slice(file.Roles, func(i, j int) bool { return file.Roles[i].Name < file.Roles[j].Name })
+	if file.Assignments == nil {
+		file.Assignments = []Assignment{}
+	}
+	if err := jsonfile.Write(s.path, file); err != nil {
+		return fmt.Errorf("permissions: %w", err)
+	}
+	return nil
+}
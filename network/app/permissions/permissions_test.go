@@ -0,0 +1,125 @@
+package permissions
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	s, err := Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"guest", "builder", "staff", "owner"} {
+		if _, err := s.PutRole(Role{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, a := range []Assignment{
+		{Kind: MatchDefault, Role: "guest"},
+		{Kind: MatchName, Pattern: "build*", Role: "builder"},
+		{Kind: MatchName, Pattern: "*er", Role: "staff"},
+		{Kind: MatchUUID, Pattern: "AAAA-1", Role: "owner"},
+	} {
+		if _, err := s.Assign(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name   string
+		uuid   string
+		player string
+		want   string
+	}{
+		{name: "uuid wins over name", uuid: "aaaa-1", player: "Builder", want: "owner"},
+		{name: "uuid is case-insensitive", uuid: "AAAA-1", want: "owner"},
+		{name: "first name pattern added wins", uuid: "b", player: "BuildMaster", want: "builder"},
+		{name: "later name pattern", uuid: "b", player: "Player", want: "staff"},
+		{name: "default", uuid: "b", player: "Steve", want: "guest"},
+		{name: "default without name", uuid: "b", want: "guest"},
+	}
+	for _, tt := range tests {
+		role, _, ok := s.Resolve(tt.uuid, tt.player)
+		if !ok || role.Name != tt.want {
+			t.Errorf("%s: Resolve(%q, %q) = %q, %v; want %q", tt.name, tt.uuid, tt.player, role.Name, ok, tt.want)
+		}
+	}
+
+	// Replacing a name pattern keeps its place in the order.
+	if _, err := s.Assign(Assignment{Kind: MatchName, Pattern: "BUILD*", Role: "guest"}); err != nil {
+		t.Fatal(err)
+	}
+	if role, _, _ := s.Resolve("b", "BuildMaster"); role.Name != "guest" {
+		t.Errorf("replaced pattern resolved to %q, want guest", role.Name)
+	}
+	if err := s.Unassign(MatchDefault, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := s.Resolve("b", "Steve"); ok {
+		t.Error("player resolved without a matching assignment")
+	}
+}
+
+func TestSaveFailureRollsBack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "permissions.json")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PutRole(Role{Name: "guest", Tags: []string{"a"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PutRole(Role{Name: "spare"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Assign(Assignment{Kind: MatchDefault, Role: "guest"}); err != nil {
+		t.Fatal(err)
+	}
+	// A directory in place of the temporary file makes every save fail.
+	if err := os.Mkdir(path+".tmp", 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.PutRole(Role{Name: "guest", Tags: []string{"b"}}); err == nil {
+		t.Error("PutRole replacing a role succeeded without saving")
+	}
+	if _, err := s.PutRole(Role{Name: "new"}); err == nil {
+		t.Error("PutRole adding a role succeeded without saving")
+	}
+	if _, err := s.Assign(Assignment{Kind: MatchName, Pattern: "x*", Role: "guest"}); err == nil {
+		t.Error("Assign succeeded without saving")
+	}
+	if err := s.Unassign(MatchDefault, ""); err == nil {
+		t.Error("Unassign succeeded without saving")
+	}
+	if err := s.DeleteRole("spare"); err == nil {
+		t.Error("DeleteRole succeeded without saving")
+	}
+
+	if role, err := s.Role("guest"); err != nil || len(role.Tags) != 1 || role.Tags[0] != "a" {
+		t.Errorf("guest role = %+v, %v; want the saved tags", role, err)
+	}
+	if _, err := s.Role("spare"); err != nil {
+		t.Errorf("unsaved deletion removed the role: %v", err)
+	}
+	if _, err := s.Role("new"); !errors.Is(err, ErrRoleNotFound) {
+		t.Errorf("unsaved role error = %v, want ErrRoleNotFound", err)
+	}
+	if got := s.Assignments(); len(got) != 1 || got[0].Kind != MatchDefault {
+		t.Errorf("assignments = %+v, want only the saved default", got)
+	}
+	if err := s.DeleteRole("guest"); !errors.Is(err, ErrRoleInUse) {
+		t.Errorf("DeleteRole error = %v, want ErrRoleInUse", err)
+	}
+
+	// The file on disk still holds the last saved state.
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role, _, ok := reopened.Resolve("u", "Steve"); !ok || role.Name != "guest" || len(role.Tags) != 1 {
+		t.Errorf("reopened store resolves %+v, %v", role, ok)
+	}
+}
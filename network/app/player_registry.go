@@ -13,15 +13,21 @@ var (
 	ErrPlayerUUIDUnknown = errors.New("player uuid unavailable")
 )
 
-// PlayerRegistry keeps a bind of UUID strings to player readers.
+// PlayerRegistry keeps a bind of UUID strings to player readers, and the
+// XUIDs announced by PlayerList packets, which the readers do not expose.
+// The PlayerList sent while entering the server is read before anything
+// listens, so players already online at connect have no XUID until they
+// rejoin.
 type PlayerRegistry struct {
 	store *sync_wrapper.SyncKVMap[string, uqdefines.PlayerUQReader]
+	xuids *sync_wrapper.SyncKVMap[string, string]
 }
 
 // NewPlayerRegistry constructs an empty registry.
 func NewPlayerRegistry() *PlayerRegistry {
 	return &PlayerRegistry{
 		store: sync_wrapper.NewSyncKVMap[string, uqdefines.PlayerUQReader](),
+		xuids: sync_wrapper.NewSyncKVMap[string, string](),
 	}
 }
 
@@ -62,6 +68,23 @@ func (r *PlayerRegistry) Delete(uuidStr string) {
 	r.store.Delete(uuidStr)
 }
 
+// SetXUID records the XUID of the player with uuidStr.
+func (r *PlayerRegistry) SetXUID(uuidStr, xuid string) {
+	if uuidStr == "" || xuid == "" {
+		return
+	}
+	r.xuids.Set(uuidStr, xuid)
+}
+
+// XUID returns the XUID recorded for uuidStr. It is kept after Delete so
+// offline events can still report it.
+func (r *PlayerRegistry) XUID(uuidStr string) (string, bool) {
+	if uuidStr == "" {
+		return "", false
+	}
+	return r.xuids.Get(uuidStr)
+}
+
 // Iterate walks over all stored players until fn returns false.
 func (r *PlayerRegistry) Iterate(fn func(uuid string, player uqdefines.PlayerUQReader) bool) {
 	r.store.Iter(func(k string, v uqdefines.PlayerUQReader) (cont bool) {
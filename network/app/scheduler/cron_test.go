@@ -0,0 +1,161 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	tests := []struct {
+		name string
+		expr string
+		loc  *time.Location
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "every quarter hour",
+			expr: "*/15 * * * *",
+			from: time.Date(2024, 5, 1, 10, 7, 30, 0, time.UTC),
+			want: time.Date(2024, 5, 1, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "strictly after",
+			expr: "15 10 * * *",
+			from: time.Date(2024, 5, 1, 10, 15, 0, 0, time.UTC),
+			want: time.Date(2024, 5, 2, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "year end",
+			expr: "@daily",
+			from: time.Date(2024, 12, 31, 23, 59, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "31st skips short months",
+			expr: "0 0 31 * *",
+			from: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "30th skips february",
+			expr: "0 6 30 * *",
+			from: time.Date(2023, 1, 30, 7, 0, 0, 0, time.UTC),
+			want: time.Date(2023, 3, 30, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "feb 29 in a leap year",
+			expr: "0 0 29 2 *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "feb 29 waits for the next leap year",
+			expr: "0 0 29 feb *",
+			from: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 9 * * 7",
+			from: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 5, 5, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "restricted day fields match either",
+			expr: "0 0 1 * mon",
+			from: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "stepped day of month must match weekday too",
+			expr: "0 0 */10 * mon",
+			from: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "evaluated in location",
+			expr: "0 9 * * *",
+			loc:  newYork,
+			from: time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "time skipped by dst does not fire",
+			expr: "30 2 * * *",
+			loc:  newYork,
+			from: time.Date(2024, 3, 9, 3, 0, 0, 0, newYork),
+			want: time.Date(2024, 3, 11, 2, 30, 0, 0, newYork),
+		},
+		{
+			name: "hour after the gap still fires",
+			expr: "30 3 * * *",
+			loc:  newYork,
+			from: time.Date(2024, 3, 10, 0, 0, 0, 0, newYork),
+			want: time.Date(2024, 3, 10, 3, 30, 0, 0, newYork),
+		},
+		{
+			name: "time repeated by dst fires once",
+			expr: "30 1 * * *",
+			loc:  newYork,
+			// 01:30 EDT, the first of the two 01:30s that night.
+			from: time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC),
+			want: time.Date(2024, 11, 4, 1, 30, 0, 0, newYork),
+		},
+		{
+			name: "never fires",
+			expr: "0 0 30 2 *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		s, err := ParseCron(tt.expr, tt.loc)
+		if err != nil {
+			t.Errorf("%s: ParseCron(%q): %v", tt.name, tt.expr, err)
+			continue
+		}
+		if got := s.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("%s: Next(%v) = %v, want %v", tt.name, tt.from, got, tt.want)
+		}
+	}
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"@reboot",
+		"* * * foo *",
+	} {
+		if _, err := ParseCron(expr, nil); !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("ParseCron(%q) error = %v, want ErrInvalidSchedule", expr, err)
+		}
+	}
+}
+
+func TestEvery(t *testing.T) {
+	if _, err := Every(500 * time.Millisecond); !errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("Every(500ms) error = %v, want ErrInvalidSchedule", err)
+	}
+	s, err := Every(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2024, 5, 1, 10, 7, 30, 0, time.UTC)
+	if got, want := s.Next(from), from.Add(time.Minute); !got.Equal(want) {
+		t.Errorf("Next(%v) = %v, want %v", from, got, want)
+	}
+}
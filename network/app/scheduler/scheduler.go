@@ -0,0 +1,386 @@
+// Package scheduler runs command jobs on cron or interval schedules and keeps
+// them in a JSON file so they survive restarts.
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/tempest-core/network/app/jsonfile"
+)
+
+// ErrJobNotFound is returned for unknown job IDs.
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrInvalidJob is wrapped by Create for malformed jobs.
+var ErrInvalidJob = errors.New("invalid job")
+
+// Command is one command of a job. Mode names the send path ("WS", "WO",
+// "PLAYER" or "AI") and is interpreted by the Runner.
+type Command struct {
+	Cmd       string `json:"cmd"`
+	Mode      string `json:"mode,omitempty"`
+	RuntimeID string `json:"runtime_id,omitempty"`
+}
+
+// Status is the outcome of one run.
+type Status string
+
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	// StatusSkipped marks runs that did not send anything, for example
+	// because the bot was disconnected.
+	StatusSkipped Status = "skipped"
+)
+
+// Result records one run of a job. Succeeded and Failed count commands the
+// Runner could or could not send; whether the server accepted them is not
+// known.
+type Result struct {
+	Status    Status        `json:"status"`
+	StartedAt time.Time     `json:"started_at"`
+	Duration  time.Duration `json:"duration"`
+	Message   string        `json:"message,omitempty"`
+	Succeeded int           `json:"succeeded"`
+	Failed    int           `json:"failed"`
+	Skipped   int           `json:"skipped"`
+}
+
+// Job is a scheduled command or command batch. Exactly one of Cron and
+// Interval is set.
+type Job struct {
+	ID       string        `json:"id"`
+	Name     string        `json:"name"`
+	Cron     string        `json:"cron,omitempty"`
+	Interval time.Duration `json:"interval,omitempty"`
+	// Timezone is an IANA name used to evaluate Cron; empty means UTC.
+	Timezone    string    `json:"timezone,omitempty"`
+	Commands    []Command `json:"commands"`
+	StopOnError bool      `json:"stop_on_error,omitempty"`
+	// Role is the command policy role the commands are checked against.
+	Role       string    `json:"role,omitempty"`
+	Paused     bool      `json:"paused,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	NextRun    time.Time `json:"next_run"`
+	Runs       uint64    `json:"runs"`
+	LastResult *Result   `json:"last_result,omitempty"`
+}
+
+// Runner executes a job and reports the result. It is called from the
+// scheduler goroutine pool and must honour ctx.
+type Runner func(ctx context.Context, job Job) Result
+
+type entry struct {
+	job      Job
+	schedule Schedule
+	running  bool
+}
+
+// Scheduler owns the job set and fires due jobs.
+type Scheduler struct {
+	path string
+	run  Runner
+
+	mu   sync.Mutex
+	jobs map[string]*entry
+
+	wake   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New loads jobs from path and starts the scheduling loop. An empty path
+// keeps jobs in memory only.
+func New(path string, run Runner) (*Scheduler, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Scheduler{
+		path:   path,
+		run:    run,
+		jobs:   make(map[string]*entry),
+		wake:   make(chan struct{}, 1),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	if err := s.load(); err != nil {
+		cancel()
+		return nil, err
+	}
+	s.wg.Add(1)
+	go s.loop()
+	return s, nil
+}
+
+// Close stops the loop and waits for running jobs to return.
+func (s *Scheduler) Close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// Create validates job, assigns its ID and first run time and stores it.
+func (s *Scheduler) Create(job Job) (Job, error) {
+	job.Name = strings.TrimSpace(job.Name)
+	if len(job.Commands) == 0 {
+		return Job{}, fmt.Errorf("%w: commands required", ErrInvalidJob)
+	}
+	for i, cmd := range job.Commands {
+		if strings.TrimSpace(cmd.Cmd) == "" {
+			return Job{}, fmt.Errorf("%w: command %d is empty", ErrInvalidJob, i)
+		}
+	}
+	schedule, err := scheduleFor(job)
+	if err != nil {
+		return Job{}, err
+	}
+	id, err := newID()
+	if err != nil {
+		return Job{}, err
+	}
+	now := time.Now()
+	job.ID = id
+	job.CreatedAt = now
+	job.NextRun = schedule.Next(now)
+	if job.NextRun.IsZero() {
+		return Job{}, fmt.Errorf("%w: schedule never fires", ErrInvalidSchedule)
+	}
+	job.Runs = 0
+	job.LastResult = nil
+
+	s.mu.Lock()
+	s.jobs[id] = &entry{job: job, schedule: schedule}
+	err = s.saveLocked()
+	if err != nil {
+		delete(s.jobs, id)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return Job{}, err
+	}
+	s.poke()
+	return job, nil
+}
+
+// List returns every job ordered by creation time.
+func (s *Scheduler) List() []Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Job, 0, len(s.jobs))
+	for _, e := range s.jobs {
+		out = append(out, e.job)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+// Get returns the job with id.
+func (s *Scheduler) Get(id string) (Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.jobs[id]
+	if !ok {
+		return Job{}, ErrJobNotFound
+	}
+	return e.job, nil
+}
+
+// SetPaused pauses or resumes a job. Resuming schedules the next run from
+// now rather than catching up on missed ones.
+func (s *Scheduler) SetPaused(id string, paused bool) (Job, error) {
+	s.mu.Lock()
+	e, ok := s.jobs[id]
+	if !ok {
+		s.mu.Unlock()
+		return Job{}, ErrJobNotFound
+	}
+	prev := e.job
+	if e.job.Paused && !paused {
+		e.job.NextRun = e.schedule.Next(time.Now())
+	}
+	e.job.Paused = paused
+	err := s.saveLocked()
+	if err != nil {
+		e.job = prev
+	}
+	job := e.job
+	s.mu.Unlock()
+	if err != nil {
+		return Job{}, err
+	}
+	s.poke()
+	return job, nil
+}
+
+// Delete removes a job. A run in progress finishes but is not recorded.
+func (s *Scheduler) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.jobs[id]
+	if !ok {
+		return ErrJobNotFound
+	}
+	delete(s.jobs, id)
+	if err := s.saveLocked(); err != nil {
+		s.jobs[id] = e
+		return err
+	}
+	return nil
+}
+
+func (s *Scheduler) poke() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Scheduler) loop() {
+	defer s.wg.Done()
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+	for {
+		wait := s.fireDue(time.Now())
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.wake:
+		case <-timer.C:
+		}
+	}
+}
+
+// fireDue starts every due job and returns how long to sleep until the next
+// one. A job still running when it comes due again skips that activation.
+func (s *Scheduler) fireDue(now time.Time) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wait := time.Hour
+	for _, e := range s.jobs {
+		if e.job.Paused || e.job.NextRun.IsZero() {
+			continue
+		}
+		if !e.job.NextRun.After(now) {
+			e.job.NextRun = e.schedule.Next(now)
+			if !e.running {
+				e.running = true
+				s.wg.Add(1)
+				go s.execute(e.job)
+			}
+		}
+		if !e.job.NextRun.IsZero() {
+			if d := e.job.NextRun.Sub(now); d < wait {
+				wait = d
+			}
+		}
+	}
+	return wait
+}
+
+func (s *Scheduler) execute(job Job) {
+	defer s.wg.Done()
+	started := time.Now()
+	result := s.run(s.ctx, job)
+	if result.StartedAt.IsZero() {
+		result.StartedAt = started
+	}
+	if result.Duration == 0 {
+		result.Duration = time.Since(started)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.jobs[job.ID]
+	if !ok {
+		return
+	}
+	e.running = false
+	e.job.Runs++
+	e.job.LastResult = &result
+	// A failed save is retried with the next change; the in-memory state
+	// stays authoritative.
+	_ = s.saveLocked()
+}
+
+func scheduleFor(job Job) (Schedule, error) {
+	switch {
+	case job.Cron != "" && job.Interval != 0:
+		return nil, fmt.Errorf("%w: set either cron or interval", ErrInvalidJob)
+	case job.Cron != "":
+		loc := time.UTC
+		if job.Timezone != "" {
+			var err error
+			if loc, err = time.LoadLocation(job.Timezone); err != nil {
+				return nil, fmt.Errorf("%w: timezone %q: %v", ErrInvalidSchedule, job.Timezone, err)
+			}
+		}
+		return ParseCron(job.Cron, loc)
+	case job.Interval != 0:
+		return Every(job.Interval)
+	default:
+		return nil, fmt.Errorf("%w: cron or interval required", ErrInvalidJob)
+	}
+}
+
+func newID() (string, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+type jobFile struct {
+	Jobs []Job `json:"jobs"`
+}
+
+func (s *Scheduler) load() error {
+	if s.path == "" {
+		return nil
+	}
+	var file jobFile
+	if err := jsonfile.Read(s.path, &file); err != nil {
+		return fmt.Errorf("scheduler: %w", err)
+	}
+	now := time.Now()
+	for _, job := range file.Jobs {
+		schedule, err := scheduleFor(job)
+		if err != nil {
+			return fmt.Errorf("scheduler: job %s: %w", job.ID, err)
+		}
+		// Runs missed while tempestd was down are not replayed.
+		if job.NextRun.Before(now) {
+			job.NextRun = schedule.Next(now)
+		}
+		s.jobs[job.ID] = &entry{job: job, schedule: schedule}
+	}
+	return nil
+}
+
+func (s *Scheduler) saveLocked() error {
+	if s.path == "" {
+		return nil
+	}
+	file := jobFile{Jobs: make([]Job, 0, len(s.jobs))}
+	for _, e := range s.jobs {
+		file.Jobs = append(file.Jobs, e.job)
+	}
+	sort.Slice(file.Jobs, func(i, j int) bool { return file.Jobs[i].CreatedAt.Before(file.Jobs[j].CreatedAt) })
+	if err := jsonfile.Write(s.path, file); err != nil {
+		return fmt.Errorf("scheduler: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package app
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+)
+
+// ScoreboardPacketIDs lists the packets a ScoreboardCache consumes.
+var ScoreboardPacketIDs = []uint32{
+	packet.IDSetScore,
+	packet.IDSetDisplayObjective,
+	packet.IDRemoveObjective,
+}
+
+// ScoreIdentity says what a score entry belongs to.
+type ScoreIdentity byte
+
+const (
+	ScoreIdentityUnknown    ScoreIdentity = 0
+	ScoreIdentityPlayer     ScoreIdentity = protocol.ScoreboardIdentityPlayer
+	ScoreIdentityEntity     ScoreIdentity = protocol.ScoreboardIdentityEntity
+	ScoreIdentityFakePlayer ScoreIdentity = protocol.ScoreboardIdentityFakePlayer
+)
+
+// DisplayedObjective is an objective the server shows in a display slot.
+type DisplayedObjective struct {
+	Name        string
+	DisplayName string
+	Criteria    string
+	Slots       []string
+}
+
+// ScoreEntry is one cached score. Name is set for fake players; players and
+// entities are identified by EntityUniqueID.
+type ScoreEntry struct {
+	Objective      string
+	Identity       ScoreIdentity
+	EntityUniqueID int64
+	Name           string
+	Score          int32
+}
+
+// ScoreboardCache mirrors the scores the server streams to the bot. The
+// server only sends SetScore for objectives shown in a display slot, so the
+// cache covers displayed objectives and nothing else.
+type ScoreboardCache struct {
+	mu         sync.RWMutex
+	slots      map[string]string
+	objectives map[string]DisplayedObjective
+	// entries is keyed by entry ID; removals only carry the ID.
+	entries map[int64]ScoreEntry
+}
+
+// NewScoreboardCache constructs an empty cache.
+func NewScoreboardCache() *ScoreboardCache {
+	c := &ScoreboardCache{}
+	c.Reset()
+	return c
+}
+
+// Reset clears the cache for a new session.
+func (c *ScoreboardCache) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.slots = make(map[string]string)
+	c.objectives = make(map[string]DisplayedObjective)
+	c.entries = make(map[int64]ScoreEntry)
+}
+
+// HandlePacket updates the cache from scoreboard packets.
+func (c *ScoreboardCache) HandlePacket(pk packet.Packet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch p := pk.(type) {
+	case *packet.SetDisplayObjective:
+		previous := c.slots[p.DisplaySlot]
+		if p.ObjectiveName == "" {
+			delete(c.slots, p.DisplaySlot)
+		} else {
+			c.slots[p.DisplaySlot] = p.ObjectiveName
+			c.objectives[p.ObjectiveName] = DisplayedObjective{
+				Name:        p.ObjectiveName,
+				DisplayName: p.DisplayName,
+				Criteria:    p.CriteriaName,
+			}
+		}
+		if previous != "" && previous != p.ObjectiveName && !c.displayedLocked(previous) {
+			c.dropObjectiveLocked(previous)
+		}
+	case *packet.RemoveObjective:
+		for slot, name := range c.slots {
+			if name == p.ObjectiveName {
+				delete(c.slots, slot)
+			}
+		}
+		c.dropObjectiveLocked(p.ObjectiveName)
+	case *packet.SetScore:
+		for _, e := range p.Entries {
+			if p.ActionType == packet.ScoreboardActionRemove {
+				delete(c.entries, e.EntryID)
+				continue
+			}
+			c.entries[e.EntryID] = ScoreEntry{
+				Objective:      e.ObjectiveName,
+				Identity:       ScoreIdentity(e.IdentityType),
+				EntityUniqueID: e.EntityUniqueID,
+				Name:           e.DisplayName,
+				Score:          e.Score,
+			}
+		}
+	}
+}
+
+func (c *ScoreboardCache) displayedLocked(objective string) bool {
+	for _, name := range c.slots {
+		if name == objective {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ScoreboardCache) dropObjectiveLocked(objective string) {
+	delete(c.objectives, objective)
+	for id, e := range c.entries {
+		if e.Objective == objective {
+			delete(c.entries, id)
+		}
+	}
+}
+
+// Objectives returns the displayed objectives with their slots.
+func (c *ScoreboardCache) Objectives() []DisplayedObjective {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make([]DisplayedObjective, 0, len(c.objectives))
+	for _, o := range c.objectives {
+		for slot, name := range c.slots {
+			if name == o.Name {
+				o.Slots = append(o.Slots, slot)
+			}
+		}
+		sort.Strings(o.Slots)
+		out = append(out, o)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Scores returns the cached entries of objective. ok is false when the
+// objective is not displayed and therefore not mirrored.
+func (c *ScoreboardCache) Scores(objective string) (entries []ScoreEntry, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.displayedLocked(objective) {
+		return nil, false
+	}
+	for _, e := range c.entries {
+		if e.Objective == objective {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, true
+}
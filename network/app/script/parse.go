@@ -0,0 +1,278 @@
+// Package script implements the small command script language run by
+// RunScript. A script is a list of lines; every line that is not a statement
+// below is a command, optionally prefixed with "/":
+//
+//	# comment
+//	set name = value          assign a variable
+//	capture name = <command>  run a command and store its rendered output
+//	if <command>              run the body when the command succeeds
+//	if not <command>          run the body when the command fails
+//	else
+//	end
+//	for name in players       repeat the body for each online player
+//	wait <ticks>              pause for a number of game ticks
+//
+// "${name}" is replaced by the variable's value in commands, conditions and
+// assignments; "$$" stands for a literal "$". "${success}" holds the success
+// count of the last command. Loop variables and captured output come from
+// the game, so they are inserted as quoted strings ("Steve") that cannot add
+// arguments or further commands; captured output has its line breaks
+// replaced by spaces first.
+package script
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+)
+
+// ErrSyntax is wrapped by Parse for malformed scripts.
+var ErrSyntax = errors.New("script syntax error")
+
+const (
+	// MaxLines bounds the size of a script.
+	MaxLines = 4096
+	// MaxWaitTicks bounds a single wait statement to one hour.
+	MaxWaitTicks = 72000
+)
+
+type nodeKind int
+
+const (
+	nodeCommand nodeKind = iota
+	nodeSet
+	nodeCapture
+	nodeIf
+	nodeFor
+	nodeWait
+)
+
+type node struct {
+	kind nodeKind
+	line int
+	// name is the variable of set, capture and for.
+	name string
+	// text is the command, condition or assigned value, not yet expanded.
+	text   string
+	negate bool
+	ticks  int
+	body   []node
+	alt    []node
+}
+
+// Script is a parsed script, safe to run any number of times.
+type Script struct {
+	nodes []node
+}
+
+// frame is an open if or for block during parsing.
+type frame struct {
+	n      node
+	inElse bool
+}
+
+// Parse checks src and builds a Script. Block structure, variable names and
+// wait durations are validated here; undefined variables are only reported
+// when a line using them runs.
+func Parse(src string) (*Script, error) {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	if len(lines) > MaxLines {
+		return nil, fmt.Errorf("%w: %d lines exceed limit %d", ErrSyntax, len(lines), MaxLines)
+	}
+	var (
+		root  []node
+		stack []*frame
+	)
+	appendNode := func(n node) {
+		if len(stack) == 0 {
+			root = append(root, n)
+			return
+		}
+		top := stack[len(stack)-1]
+		if top.inElse {
+			top.n.alt = append(top.n.alt, n)
+		} else {
+			top.n.body = append(top.n.body, n)
+		}
+	}
+	for i, raw := range lines {
+		lineNo := i + 1
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		keyword, rest := splitKeyword(line)
+		switch keyword {
+		case "end":
+			if rest != "" {
+				return nil, syntaxError(lineNo, "end takes no arguments")
+			}
+			if len(stack) == 0 {
+				return nil, syntaxError(lineNo, "end without if or for")
+			}
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			appendNode(top.n)
+			continue
+		case "else":
+			if rest != "" {
+				return nil, syntaxError(lineNo, "else takes no arguments")
+			}
+			if len(stack) == 0 || stack[len(stack)-1].n.kind != nodeIf || stack[len(stack)-1].inElse {
+				return nil, syntaxError(lineNo, "else without if")
+			}
+			stack[len(stack)-1].inElse = true
+			continue
+		}
+		n, opens, err := parseLine(lineNo, keyword, rest, line)
+		if err != nil {
+			return nil, err
+		}
+		if opens {
+			stack = append(stack, &frame{n: n})
+			continue
+		}
+		appendNode(n)
+	}
+	if len(stack) > 0 {
+		return nil, syntaxError(stack[len(stack)-1].n.line, "block is never closed with end")
+	}
+	return &Script{nodes: root}, nil
+}
+
+// parseLine parses a line that is not end or else. opens reports whether the
+// line starts a block.
+func parseLine(lineNo int, keyword, rest, line string) (n node, opens bool, err error) {
+	n.line = lineNo
+	switch keyword {
+	case "set", "capture":
+		name, value, ok := strings.Cut(rest, "=")
+		name = strings.TrimSpace(name)
+		value = strings.TrimSpace(value)
+		if !ok || !validName(name) {
+			return n, false, syntaxError(lineNo, "expected %s <name> = <value>", keyword)
+		}
+		n.kind, n.name, n.text = nodeSet, name, value
+		if keyword == "capture" {
+			n.kind, n.text = nodeCapture, stripSlash(value)
+			if n.text == "" {
+				return n, false, syntaxError(lineNo, "capture needs a command")
+			}
+		}
+		return n, false, nil
+	case "if":
+		n.kind = nodeIf
+		if next, cond := splitKeyword(rest); next == "not" {
+			n.negate, rest = true, cond
+		}
+		n.text = stripSlash(rest)
+		if n.text == "" {
+			return n, false, syntaxError(lineNo, "if needs a command")
+		}
+		return n, true, nil
+	case "for":
+		fields := strings.Fields(rest)
+		if len(fields) != 3 || fields[1] != "in" || !validName(fields[0]) {
+			return n, false, syntaxError(lineNo, "expected for <name> in players")
+		}
+		if fields[2] != "players" {
+			return n, false, syntaxError(lineNo, "cannot loop over %q, only players", fields[2])
+		}
+		n.kind, n.name = nodeFor, fields[0]
+		return n, true, nil
+	case "wait":
+		ticks, err := strconv.Atoi(rest)
+		if err != nil || ticks < 0 || ticks > MaxWaitTicks {
+			return n, false, syntaxError(lineNo, "wait needs a tick count between 0 and %d", MaxWaitTicks)
+		}
+		n.kind, n.ticks = nodeWait, ticks
+		return n, false, nil
+	default:
+		n.kind, n.text = nodeCommand, stripSlash(line)
+		if n.text == "" {
+			return n, false, syntaxError(lineNo, "empty command")
+		}
+		return n, false, nil
+	}
+}
+
+func splitKeyword(line string) (keyword, rest string) {
+	keyword, rest, _ = strings.Cut(line, " ")
+	return keyword, strings.TrimSpace(rest)
+}
+
+func stripSlash(cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cmd), "/"))
+}
+
+func validName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func syntaxError(line int, format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", ErrSyntax, line, fmt.Sprintf(format, args...))
+}
+
+// variable is the value of a script variable.
+type variable struct {
+	value string
+	// quote marks values from the game, such as player names and captured
+	// output, which expand inserts with cmdbuild.Quote.
+	quote bool
+}
+
+// expand substitutes ${name} references from vars.
+func expand(text string, vars map[string]variable) (string, error) {
+	if !strings.Contains(text, "$") {
+		return text, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c != '$' || i+1 >= len(text) {
+			b.WriteByte(c)
+			continue
+		}
+		switch text[i+1] {
+		case '$':
+			b.WriteByte('$')
+			i++
+		case '{':
+			end := strings.IndexByte(text[i+2:], '}')
+			if end < 0 {
+				return "", errors.New("unterminated ${")
+			}
+			name := text[i+2 : i+2+end]
+			v, ok := vars[name]
+			if !ok {
+				return "", fmt.Errorf("undefined variable %q", name)
+			}
+			value := v.value
+			if v.quote {
+				var err error
+				if value, err = cmdbuild.Quote(value); err != nil {
+					return "", fmt.Errorf("variable %q: %v", name, err)
+				}
+			}
+			b.WriteString(value)
+			i += end + 2
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,85 @@
+package script
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr string
+	}{
+		{name: "commands and comments", src: "# hi\n/say a\n\nsay b\r\n"},
+		{name: "blocks", src: "for p in players\nif not testfor ${p}\nsay x\nelse\nsay y\nend\nend"},
+		{name: "set and capture", src: "set a = 1\ncapture out = /list"},
+		{name: "wait", src: "wait 20"},
+		{name: "end without block", src: "end", wantErr: "line 1: end without if or for"},
+		{name: "else without if", src: "for p in players\nelse\nend", wantErr: "line 2: else without if"},
+		{name: "double else", src: "if testfor @a\nelse\nelse\nend", wantErr: "line 3: else without if"},
+		{name: "unclosed", src: "say a\nif testfor @a\nsay b", wantErr: "line 2: block is never closed"},
+		{name: "bad name", src: "set 1a = x", wantErr: "line 1: expected set"},
+		{name: "set without equals", src: "set a", wantErr: "line 1: expected set"},
+		{name: "empty capture", src: "capture a = /", wantErr: "capture needs a command"},
+		{name: "empty if", src: "if not\nend", wantErr: "if needs a command"},
+		{name: "for over other", src: "for e in entities\nend", wantErr: `cannot loop over "entities"`},
+		{name: "for syntax", src: "for p players\nend", wantErr: "expected for <name> in players"},
+		{name: "wait too long", src: "wait 72001", wantErr: "wait needs a tick count"},
+		{name: "wait negative", src: "wait -1", wantErr: "wait needs a tick count"},
+		{name: "empty command", src: "/", wantErr: "empty command"},
+		{name: "too many lines", src: strings.Repeat("say a\n", MaxLines), wantErr: "exceed limit"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.src)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrSyntax) || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: error = %v, want syntax error containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	vars := map[string]variable{
+		"n":      {value: "3"},
+		"sel":    {value: "@a[tag=x]"},
+		"player": {value: "Steve", quote: true},
+		"evil":   {value: `x" @a[tag=y`, quote: true},
+		"spaced": {value: "two words", quote: true},
+		"broken": {value: "a\nop @s", quote: true},
+	}
+	tests := []struct {
+		text    string
+		want    string
+		wantErr string
+	}{
+		{text: "say plain", want: "say plain"},
+		{text: "give ${sel} apple ${n}", want: "give @a[tag=x] apple 3"},
+		{text: "cost $$5 and $x", want: "cost $5 and $x"},
+		{text: "trailing $", want: "trailing $"},
+		{text: "tp ${player} 0 0 0", want: `tp "Steve" 0 0 0`},
+		{text: "tag ${evil} add z", want: `tag "x\" @a[tag=y" add z`},
+		{text: "say ${spaced}", want: `say "two words"`},
+		{text: "say ${broken}", wantErr: `variable "broken"`},
+		{text: "say ${missing}", wantErr: `undefined variable "missing"`},
+		{text: "say ${n", wantErr: "unterminated ${"},
+	}
+	for _, tt := range tests {
+		got, err := expand(tt.text, vars)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expand(%q) error = %v, want %q", tt.text, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("expand(%q) = %q, %v; want %q", tt.text, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrRuntime is wrapped by Run when a line cannot be executed, for example
+// because it references an undefined variable.
+var ErrRuntime = errors.New("script runtime error")
+
+const (
+	// DefaultMaxCommands bounds the commands one run may send.
+	DefaultMaxCommands = 10000
+	// TickDuration is the length of one game tick used by wait.
+	TickDuration = 50 * time.Millisecond
+)
+
+// Result is the outcome of one command as reported by the Env.
+type Result struct {
+	SuccessCount uint32
+	Output       string
+}
+
+// Env connects a script to the game.
+type Env interface {
+	// Command sends cmd and waits for its output. An error means the
+	// command could not be sent or answered; it counts as a failed command
+	// unless ctx is done.
+	Command(ctx context.Context, cmd string) (Result, error)
+	// Players returns the names of the online players.
+	Players(ctx context.Context) ([]string, error)
+}
+
+// EventKind says what a progress event describes.
+type EventKind int
+
+const (
+	EventCommand EventKind = iota
+	EventSet
+	EventWait
+	EventLoop
+)
+
+// Event reports progress on one executed line.
+type Event struct {
+	Line int
+	Kind EventKind
+	// Text is the expanded command, the assigned value, or for loops the
+	// current player.
+	Text         string
+	Success      bool
+	SuccessCount uint32
+	Output       string
+	Err          string
+	Duration     time.Duration
+}
+
+// Options tunes a run.
+type Options struct {
+	// StopOnError ends the run at the first failed command. Failed if
+	// conditions do not count.
+	StopOnError bool
+	// MaxCommands bounds the commands sent; zero means DefaultMaxCommands.
+	MaxCommands int
+}
+
+// Summary totals a finished run.
+type Summary struct {
+	Commands int
+	Failed   int
+	// Stopped is set when StopOnError ended the run early.
+	Stopped bool
+}
+
+type runner struct {
+	env     Env
+	opts    Options
+	vars    map[string]variable
+	emit    func(Event) error
+	summary Summary
+}
+
+// errStopped unwinds the interpreter after a failure with StopOnError.
+var errStopped = errors.New("stopped")
+
+// Run executes the script, calling emit after every line that does
+// something. vars seeds the variables and is not modified. A non-nil error
+// from emit ends the run with that error.
+func (s *Script) Run(ctx context.Context, env Env, vars map[string]string, opts Options, emit func(Event) error) (Summary, error) {
+	if opts.MaxCommands <= 0 {
+		opts.MaxCommands = DefaultMaxCommands
+	}
+	r := &runner{env: env, opts: opts, vars: make(map[string]variable, len(vars)+1), emit: emit}
+	for k, v := range vars {
+		r.vars[k] = variable{value: v}
+	}
+	r.vars["success"] = variable{value: "0"}
+	err := r.block(ctx, s.nodes)
+	if errors.Is(err, errStopped) {
+		r.summary.Stopped = true
+		err = nil
+	}
+	return r.summary, err
+}
+
+func (r *runner) block(ctx context.Context, nodes []node) error {
+	for _, n := range nodes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := r.exec(ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *runner) exec(ctx context.Context, n node) error {
+	switch n.kind {
+	case nodeCommand:
+		ok, err := r.command(ctx, n, "")
+		if err != nil {
+			return err
+		}
+		if !ok && r.opts.StopOnError {
+			return errStopped
+		}
+		return nil
+	case nodeCapture:
+		ok, err := r.command(ctx, n, n.name)
+		if err != nil {
+			return err
+		}
+		if !ok && r.opts.StopOnError {
+			return errStopped
+		}
+		return nil
+	case nodeSet:
+		value, err := r.expand(n)
+		if err != nil {
+			return err
+		}
+		r.vars[n.name] = variable{value: value}
+		return r.emit(Event{Line: n.line, Kind: EventSet, Text: n.name + " = " + value, Success: true})
+	case nodeIf:
+		ok, err := r.command(ctx, n, "")
+		if err != nil {
+			return err
+		}
+		if ok != n.negate {
+			return r.block(ctx, n.body)
+		}
+		return r.block(ctx, n.alt)
+	case nodeFor:
+		players, err := r.env.Players(ctx)
+		if err != nil {
+			return fmt.Errorf("%w: line %d: list players: %v", ErrRuntime, n.line, err)
+		}
+		for _, name := range players {
+			r.vars[n.name] = variable{value: name, quote: true}
+			if err := r.emit(Event{Line: n.line, Kind: EventLoop, Text: name, Success: true}); err != nil {
+				return err
+			}
+			if err := r.block(ctx, n.body); err != nil {
+				return err
+			}
+		}
+		return nil
+	case nodeWait:
+		started := time.Now()
+		timer := time.NewTimer(time.Duration(n.ticks) * TickDuration)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+		return r.emit(Event{Line: n.line, Kind: EventWait, Text: strconv.Itoa(n.ticks), Success: true, Duration: time.Since(started)})
+	default:
+		return fmt.Errorf("%w: line %d: unknown statement", ErrRuntime, n.line)
+	}
+}
+
+// command runs the command of n, records its success count and, when capture
+// is set, stores its output there. It reports whether the command succeeded.
+func (r *runner) command(ctx context.Context, n node, capture string) (bool, error) {
+	cmd, err := r.expand(n)
+	if err != nil {
+		return false, err
+	}
+	if r.summary.Commands >= r.opts.MaxCommands {
+		return false, fmt.Errorf("%w: line %d: command limit %d reached", ErrRuntime, n.line, r.opts.MaxCommands)
+	}
+	r.summary.Commands++
+	started := time.Now()
+	result, err := r.env.Command(ctx, cmd)
+	if err != nil && ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	ev := Event{
+		Line:         n.line,
+		Kind:         EventCommand,
+		Text:         cmd,
+		Success:      err == nil && result.SuccessCount > 0,
+		SuccessCount: result.SuccessCount,
+		Output:       result.Output,
+		Duration:     time.Since(started),
+	}
+	if err != nil {
+		ev.Err = err.Error()
+	}
+	r.vars["success"] = variable{value: strconv.FormatUint(uint64(result.SuccessCount), 10)}
+	if capture != "" {
+		r.vars[capture] = variable{value: singleLine(result.Output), quote: true}
+	}
+	// if conditions are expected to fail sometimes.
+	if !ev.Success && n.kind != nodeIf {
+		r.summary.Failed++
+	}
+	if err := r.emit(ev); err != nil {
+		return false, err
+	}
+	return ev.Success, nil
+}
+
+// singleLine replaces the line breaks and other control characters of
+// command output with spaces so it can be quoted into a command.
+func singleLine(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+func (r *runner) expand(n node) (string, error) {
+	text, err := expand(n.text, r.vars)
+	if err != nil {
+		return "", fmt.Errorf("%w: line %d: %v", ErrRuntime, n.line, err)
+	}
+	return text, nil
+}
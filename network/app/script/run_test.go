@@ -0,0 +1,78 @@
+package script
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fakeEnv records commands and answers them from outputs; commands starting
+// with "fail" report no successes.
+type fakeEnv struct {
+	players []string
+	outputs map[string]string
+	sent    []string
+}
+
+func (e *fakeEnv) Command(ctx context.Context, cmd string) (Result, error) {
+	e.sent = append(e.sent, cmd)
+	if strings.HasPrefix(cmd, "fail") {
+		return Result{}, nil
+	}
+	return Result{SuccessCount: 1, Output: e.outputs[cmd]}, nil
+}
+
+func (e *fakeEnv) Players(ctx context.Context) ([]string, error) {
+	return e.players, nil
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		vars    map[string]string
+		players []string
+		outputs map[string]string
+		want    []string
+	}{
+		{
+			name:    "loop variables are quoted",
+			src:     "for p in players\ntag ${p} add seen\nend",
+			players: []string{"Steve", `Alex" @a[tag=x`},
+			want:    []string{`tag "Steve" add seen`, `tag "Alex\" @a[tag=x" add seen`},
+		},
+		{
+			name:    "captured output is quoted on one line",
+			src:     "capture out = list\nsay ${out}",
+			outputs: map[string]string{"list": "2 online:\nSteve, Alex"},
+			want:    []string{"list", `say "2 online: Steve, Alex"`},
+		},
+		{
+			name: "seeded and set variables are not quoted",
+			src:  "set target = ${who}[tag=vip]\ngive ${target} apple",
+			vars: map[string]string{"who": "@a"},
+			want: []string{"give @a[tag=vip] apple"},
+		},
+		{
+			name: "if and else",
+			src:  "if fail\nsay yes\nelse\nsay no ${success}\nend",
+			want: []string{"fail", "say no 0"},
+		},
+	}
+	for _, tt := range tests {
+		parsed, err := Parse(tt.src)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		env := &fakeEnv{players: tt.players, outputs: tt.outputs}
+		if _, err := parsed.Run(context.Background(), env, tt.vars, Options{}, func(Event) error { return nil }); err != nil {
+			t.Errorf("%s: run: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(env.sent, tt.want) {
+			t.Errorf("%s: sent %q, want %q", tt.name, env.sent, tt.want)
+		}
+	}
+}
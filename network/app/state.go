@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/Yeah114/FunShuttler/game_control/game_interface"
 	"github.com/Yeah114/FunShuttler/game_control/resources_control"
 	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
+	"github.com/Yeah114/tempest-core/network/app/cmdpolicy"
 )
 
 var (
@@ -46,16 +48,39 @@ type FatalderState struct {
 
 	messageBus    *Broadcast[Message]
 	disconnectBus *Broadcast[error]
+	joinBus       *Broadcast[PlayerJoin]
 
-	players *PlayerRegistry
+	players     *PlayerRegistry
+	entities    *EntityTracker
+	chunks      *ChunkCache
+	commandTree *CommandTreeHolder
+	scoreboard  *ScoreboardCache
+	worldSpawn  *WorldSpawn
+	inputs      *InputInterceptor
+
+	dispatcher *CommandDispatcher
+	policy     *cmdpolicy.Engine
+
+	dataDir string
 }
 
 // NewFatalderState creates a ready state container.
 func NewFatalderState() *FatalderState {
+	// The default policy is static and always compiles.
+	policy, _ := cmdpolicy.NewEngine(cmdpolicy.DefaultPolicy)
 	return &FatalderState{
 		messageBus:    NewBroadcast[Message](),
 		disconnectBus: NewBroadcast[error](),
+		joinBus:       NewBroadcast[PlayerJoin](),
 		players:       NewPlayerRegistry(),
+		entities:      NewEntityTracker(),
+		chunks:        NewChunkCache(),
+		commandTree:   NewCommandTreeHolder(),
+		scoreboard:    NewScoreboardCache(),
+		worldSpawn:    NewWorldSpawn(),
+		inputs:        NewInputInterceptor(),
+		dispatcher:    NewCommandDispatcher(DefaultDispatcherConfig),
+		policy:        policy,
 	}
 }
 
@@ -113,6 +138,18 @@ func (s *FatalderState) Connect(ctx context.Context, opts ConnectOptions) error
 	s.packetIDName = idName
 	s.mu.Unlock()
 
+	s.entities.Reset()
+	s.chunks.Reset()
+	s.commandTree.Reset()
+	s.scoreboard.Reset()
+	s.worldSpawn.Reset()
+	s.inputs.Reset()
+	if err := s.startTrackers(gameIface); err != nil {
+		_ = s.Disconnect()
+		return err
+	}
+	s.announceOnlinePlayers()
+
 	s.publishMessage(Message{
 		Type:      "status",
 		Message:   "connected",
@@ -142,6 +179,12 @@ func (s *FatalderState) Disconnect() error {
 	s.disconnectBus = NewBroadcast[error]()
 	s.players = NewPlayerRegistry()
 	s.mu.Unlock()
+	s.entities.Reset()
+	s.chunks.Reset()
+	s.commandTree.Reset()
+	s.scoreboard.Reset()
+	s.worldSpawn.Reset()
+	s.inputs.Reset()
 
 	if disconnectBus != nil {
 		disconnectBus.Publish(context.Canceled)
@@ -178,6 +221,12 @@ func (s *FatalderState) watchDisconnect(ctx context.Context) {
 	s.disconnectBus = NewBroadcast[error]()
 	s.players = NewPlayerRegistry()
 	s.mu.Unlock()
+	s.entities.Reset()
+	s.chunks.Reset()
+	s.commandTree.Reset()
+	s.scoreboard.Reset()
+	s.worldSpawn.Reset()
+	s.inputs.Reset()
 
 	if ctrl != nil {
 		_ = ctrl.LeaveRentalServer()
@@ -196,6 +245,117 @@ func (s *FatalderState) watchDisconnect(ctx context.Context) {
 	})
 }
 
+// startTrackers registers the packet listeners that keep client-side caches
+// such as the entity registry and chunk cache up to date for the new session.
+func (s *FatalderState) startTrackers(gameIface *game_interface.GameInterface) error {
+	listener := gameIface.PacketListener()
+	if listener == nil {
+		return errors.New("packet listener unavailable")
+	}
+	entities := s.entities
+	if _, err := listener.ListenPacket(EntityPacketIDs, func(pk packet.Packet, connErr error) {
+		if connErr != nil || pk == nil {
+			return
+		}
+		entities.HandlePacket(pk)
+	}); err != nil {
+		return fmt.Errorf("listen entity packets: %w", err)
+	}
+	chunks := s.chunks
+	if _, err := listener.ListenPacket(ChunkPacketIDs, func(pk packet.Packet, connErr error) {
+		if connErr != nil || pk == nil {
+			return
+		}
+		chunks.HandlePacket(pk)
+	}); err != nil {
+		return fmt.Errorf("listen chunk packets: %w", err)
+	}
+	commandTree := s.commandTree
+	if _, err := listener.ListenPacket([]uint32{packet.IDAvailableCommands}, func(pk packet.Packet, connErr error) {
+		if connErr != nil || pk == nil {
+			return
+		}
+		commandTree.HandlePacket(pk)
+	}); err != nil {
+		return fmt.Errorf("listen command packets: %w", err)
+	}
+	scoreboard := s.scoreboard
+	if _, err := listener.ListenPacket(ScoreboardPacketIDs, func(pk packet.Packet, connErr error) {
+		if connErr != nil || pk == nil {
+			return
+		}
+		scoreboard.HandlePacket(pk)
+	}); err != nil {
+		return fmt.Errorf("listen scoreboard packets: %w", err)
+	}
+	worldSpawn := s.worldSpawn
+	if _, err := listener.ListenPacket([]uint32{packet.IDSetSpawnPosition}, func(pk packet.Packet, connErr error) {
+		if connErr != nil || pk == nil {
+			return
+		}
+		worldSpawn.HandlePacket(pk)
+	}); err != nil {
+		return fmt.Errorf("listen spawn packets: %w", err)
+	}
+	// Interceptions must see chat even when no chat stream is open.
+	inputs := s.inputs
+	if _, err := listener.ListenPacket([]uint32{packet.IDText}, func(pk packet.Packet, connErr error) {
+		if connErr != nil || pk == nil {
+			return
+		}
+		if text, ok := pk.(*packet.Text); ok {
+			inputs.Claim(text)
+		}
+	}); err != nil {
+		return fmt.Errorf("listen text packets: %w", err)
+	}
+	joinBus := s.joinBus
+	if _, err := listener.ListenPacket([]uint32{packet.IDPlayerList}, func(pk packet.Packet, connErr error) {
+		playerList, ok := pk.(*packet.PlayerList)
+		if connErr != nil || !ok || playerList.ActionType != packet.PlayerListActionAdd {
+			return
+		}
+		now := time.Now()
+		players := s.Players()
+		for _, entry := range playerList.Entries {
+			players.SetXUID(entry.UUID.String(), entry.XUID)
+			joinBus.Publish(PlayerJoin{
+				UUID:           entry.UUID.String(),
+				Name:           entry.Username,
+				EntityUniqueID: entry.EntityUniqueID,
+				Timestamp:      now,
+			})
+		}
+	}); err != nil {
+		return fmt.Errorf("listen player list packets: %w", err)
+	}
+	return nil
+}
+
+// announceOnlinePlayers publishes a PlayerJoin for every player the UQHolder
+// lists online. The PlayerList naming them arrived while entering the server,
+// before startTrackers was listening.
+func (s *FatalderState) announceOnlinePlayers() {
+	players, err := s.SnapshotPlayers()
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	for _, player := range players {
+		if player == nil {
+			continue
+		}
+		uuidStr, ok := player.GetUUIDString()
+		if !ok || uuidStr == "" {
+			continue
+		}
+		join := PlayerJoin{UUID: uuidStr, Timestamp: now}
+		join.Name, _ = player.GetUsername()
+		join.EntityUniqueID, _ = player.GetEntityUniqueID()
+		s.joinBus.Publish(join)
+	}
+}
+
 // WithGameInterface executes fn while holding a read lock on the active game interface.
 func (s *FatalderState) WithGameInterface(fn func(*game_interface.GameInterface) error) error {
 	s.mu.RLock()
@@ -206,6 +366,62 @@ func (s *FatalderState) WithGameInterface(fn func(*game_interface.GameInterface)
 	return fn(s.gameIface)
 }
 
+// Commands returns the command sender of the active session without holding
+// the state lock, so long-running command sequences do not block Disconnect.
+// The sender must not be kept beyond the call that requested it.
+func (s *FatalderState) Commands() (*game_interface.Commands, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.gameIface == nil {
+		return nil, ErrNotConnected
+	}
+	return s.gameIface.Commands(), nil
+}
+
+// PacedCommands waits for the dispatcher to release a slot on lane and then
+// returns the command sender. Every outbound command should go through it.
+func (s *FatalderState) PacedCommands(ctx context.Context, lane CommandLane, failFast bool) (*game_interface.Commands, error) {
+	// Fail before queueing so disconnected callers do not consume slots.
+	if _, err := s.Commands(); err != nil {
+		return nil, err
+	}
+	if err := s.dispatcher.Acquire(ctx, lane, failFast); err != nil {
+		return nil, err
+	}
+	return s.Commands()
+}
+
+// Dispatcher returns the outbound command dispatcher.
+func (s *FatalderState) Dispatcher() *CommandDispatcher {
+	return s.dispatcher
+}
+
+// CommandTree returns the command tree announced by the server, or nil
+// before the first AvailableCommands packet of the session.
+func (s *FatalderState) CommandTree() *CommandTree {
+	return s.commandTree.Tree()
+}
+
+// CommandPolicy returns the policy applied to client-submitted commands.
+func (s *FatalderState) CommandPolicy() *cmdpolicy.Engine {
+	return s.policy
+}
+
+// SetDataDir sets the directory used for persistent service data. It must be
+// called before the services are constructed.
+func (s *FatalderState) SetDataDir(dir string) {
+	s.dataDir = dir
+}
+
+// DataPath returns the path of name inside the data directory, or an empty
+// string when persistence is disabled.
+func (s *FatalderState) DataPath(name string) string {
+	if s.dataDir == "" {
+		return ""
+	}
+	return filepath.Join(s.dataDir, name)
+}
+
 // WithResources executes fn with the resources guard.
 func (s *FatalderState) WithResources(fn func(*resources_control.Resources) error) error {
 	s.mu.RLock()
@@ -247,6 +463,31 @@ func (s *FatalderState) Players() *PlayerRegistry {
 	return s.players
 }
 
+// Entities returns the entity tracker. It is always non-nil.
+func (s *FatalderState) Entities() *EntityTracker {
+	return s.entities
+}
+
+// Scoreboard returns the cache of displayed scoreboard objectives.
+func (s *FatalderState) Scoreboard() *ScoreboardCache {
+	return s.scoreboard
+}
+
+// WorldSpawn returns the tracker of the world spawn point.
+func (s *FatalderState) WorldSpawn() *WorldSpawn {
+	return s.worldSpawn
+}
+
+// Inputs returns the interceptor of players' next chat messages.
+func (s *FatalderState) Inputs() *InputInterceptor {
+	return s.inputs
+}
+
+// Chunks returns the local chunk cache. It is always non-nil.
+func (s *FatalderState) Chunks() *ChunkCache {
+	return s.chunks
+}
+
 // Messages channel for general updates.
 func (s *FatalderState) Messages(buffer int) (<-chan Message, func()) {
 	return s.messageBus.Subscribe(buffer)
@@ -259,6 +500,11 @@ func (s *FatalderState) DisconnectEvents(buffer int) (<-chan error, func()) {
 	return s.disconnectBus.Subscribe(buffer)
 }
 
+// PlayerJoins yields the players announced online, across sessions.
+func (s *FatalderState) PlayerJoins(buffer int) (<-chan PlayerJoin, func()) {
+	return s.joinBus.Subscribe(buffer)
+}
+
 // publishMessage is a helper to push to the broadcast.
 func (s *FatalderState) publishMessage(msg Message) {
 	if s.messageBus == nil {
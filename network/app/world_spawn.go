@@ -0,0 +1,58 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+)
+
+// WorldSpawn remembers the world spawn point the server last announced. The
+// server sends it on join and whenever setworldspawn runs; nothing else in
+// the client-side holders keeps it.
+type WorldSpawn struct {
+	mu        sync.RWMutex
+	position  protocol.BlockPos
+	dimension int32
+	known     bool
+}
+
+// NewWorldSpawn constructs an empty tracker.
+func NewWorldSpawn() *WorldSpawn {
+	return &WorldSpawn{}
+}
+
+// Reset forgets the spawn point for a new session.
+func (w *WorldSpawn) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.position = protocol.BlockPos{}
+	w.dimension = 0
+	w.known = false
+}
+
+// HandlePacket records world spawn updates. Player spawn updates are ignored.
+func (w *WorldSpawn) HandlePacket(pk packet.Packet) {
+	p, ok := pk.(*packet.SetSpawnPosition)
+	if !ok || p.SpawnType != packet.SpawnTypeWorld {
+		return
+	}
+	w.Set(p.Position, p.Dimension)
+}
+
+// Set stores a spawn point confirmed by other means, such as a successful
+// setworldspawn command.
+func (w *WorldSpawn) Set(position protocol.BlockPos, dimension int32) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.position = position
+	w.dimension = dimension
+	w.known = true
+}
+
+// Get returns the last known spawn point. ok is false until one is seen.
+func (w *WorldSpawn) Get() (position protocol.BlockPos, dimension int32, ok bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.position, w.dimension, w.known
+}
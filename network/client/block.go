@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+
+	blockpb "github.com/Yeah114/tempest-core/network_api/block"
+	"google.golang.org/grpc"
+)
+
+type BlockClient struct {
+	rpc         blockpb.BlockServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newBlockClient(rpc blockpb.BlockServiceClient, callOptions []grpc.CallOption) *BlockClient {
+	return &BlockClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *BlockClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("block")
+	}
+	return nil
+}
+
+func (c *BlockClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+// GetBlock returns the cached block at x, y, z. The block is nil when the
+// containing sub-chunk has not been received by the bot.
+func (c *BlockClient) GetBlock(ctx context.Context, x, y, z int32, layer uint32, opts ...grpc.CallOption) (*blockpb.BlockInfo, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &blockpb.GetBlockRequest{
+		Position: &blockpb.BlockPos{X: x, Y: y, Z: z},
+		Layer:    layer,
+	}
+	resp, err := c.rpc.GetBlock(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetCached() {
+		return nil, nil
+	}
+	return resp.GetBlock(), nil
+}
+
+func (c *BlockClient) GetArea(ctx context.Context, box *blockpb.BlockBox, layer uint32, opts ...grpc.CallOption) (*blockpb.GetAreaResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	return c.rpc.GetArea(ctx, &blockpb.GetAreaRequest{Box: box, Layer: layer}, c.callOpts(opts)...)
+}
+
+func (c *BlockClient) ListenBlockChanges(ctx context.Context, req *blockpb.ListenBlockChangesRequest, opts ...grpc.CallOption) (blockpb.BlockService_ListenBlockChangesClient, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		req = &blockpb.ListenBlockChangesRequest{}
+	}
+	return c.rpc.ListenBlockChanges(ctx, req, c.callOpts(opts)...)
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	chatcommandpb "github.com/Yeah114/tempest-core/network_api/chatcommand"
+	"google.golang.org/grpc"
+)
+
+type ChatCommandClient struct {
+	rpc         chatcommandpb.ChatCommandServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newChatCommandClient(rpc chatcommandpb.ChatCommandServiceClient, callOptions []grpc.CallOption) *ChatCommandClient {
+	return &ChatCommandClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *ChatCommandClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("chatcommand")
+	}
+	return nil
+}
+
+func (c *ChatCommandClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+// ServeChatCommands registers commands for the lifetime of the returned stream.
+func (c *ChatCommandClient) ServeChatCommands(ctx context.Context, req *chatcommandpb.ServeChatCommandsRequest, opts ...grpc.CallOption) (chatcommandpb.ChatCommandService_ServeChatCommandsClient, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		req = &chatcommandpb.ServeChatCommandsRequest{}
+	}
+	return c.rpc.ServeChatCommands(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *ChatCommandClient) ListChatCommands(ctx context.Context, prefix string, opts ...grpc.CallOption) ([]*chatcommandpb.RegisteredChatCommand, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &chatcommandpb.ListChatCommandsRequest{Prefix: strings.TrimSpace(prefix)}
+	resp, err := c.rpc.ListChatCommands(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetCommands(), nil
+}
+
+func (c *ChatCommandClient) SetChatRole(ctx context.Context, role string, memberUUIDs, memberNames []string, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	req := &chatcommandpb.SetChatRoleRequest{
+		Role:        strings.TrimSpace(role),
+		MemberUuids: append([]string(nil), memberUUIDs...),
+		MemberNames: append([]string(nil), memberNames...),
+	}
+	resp, err := c.rpc.SetChatRole(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
@@ -6,11 +6,19 @@ import (
 	"fmt"
 	"strings"
 
+	blockpb "github.com/Yeah114/tempest-core/network_api/block"
+	chatcommandpb "github.com/Yeah114/tempest-core/network_api/chatcommand"
 	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	entitypb "github.com/Yeah114/tempest-core/network_api/entity"
 	listenerpb "github.com/Yeah114/tempest-core/network_api/listener"
+	permissionpb "github.com/Yeah114/tempest-core/network_api/permission"
 	playerkitpb "github.com/Yeah114/tempest-core/network_api/playerkit"
 	reversalerpb "github.com/Yeah114/tempest-core/network_api/reversaler"
+	schedulerpb "github.com/Yeah114/tempest-core/network_api/scheduler"
+	scoreboardpb "github.com/Yeah114/tempest-core/network_api/scoreboard"
+	tagpb "github.com/Yeah114/tempest-core/network_api/tag"
 	utilspb "github.com/Yeah114/tempest-core/network_api/utils"
+	worldpb "github.com/Yeah114/tempest-core/network_api/world"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -54,11 +62,19 @@ type Client struct {
 	conn        *grpc.ClientConn
 	callOptions []grpc.CallOption
 
-	Command    *CommandClient
-	Listener   *ListenerClient
-	PlayerKit  *PlayerKitClient
-	Reversaler *ReversalerClient
-	Utils      *UtilsClient
+	Block       *BlockClient
+	ChatCommand *ChatCommandClient
+	Command     *CommandClient
+	Entity      *EntityClient
+	Listener    *ListenerClient
+	Permission  *PermissionClient
+	PlayerKit   *PlayerKitClient
+	Reversaler  *ReversalerClient
+	Scheduler   *SchedulerClient
+	Scoreboard  *ScoreboardClient
+	Tag         *TagClient
+	Utils       *UtilsClient
+	World       *WorldClient
 }
 
 // Dial connects to a tempest-core gRPC endpoint and initialises service clients.
@@ -92,11 +108,19 @@ func Dial(ctx context.Context, target string, opts ...Option) (*Client, error) {
 		conn:        conn,
 		callOptions: callOpts,
 	}
+	c.Block = newBlockClient(blockpb.NewBlockServiceClient(conn), callOpts)
+	c.ChatCommand = newChatCommandClient(chatcommandpb.NewChatCommandServiceClient(conn), callOpts)
 	c.Command = newCommandClient(commandpb.NewCommandServiceClient(conn), callOpts)
+	c.Entity = newEntityClient(entitypb.NewEntityServiceClient(conn), callOpts)
 	c.Listener = newListenerClient(listenerpb.NewListenerServiceClient(conn), callOpts)
+	c.Permission = newPermissionClient(permissionpb.NewPermissionServiceClient(conn), callOpts)
 	c.PlayerKit = newPlayerKitClient(playerkitpb.NewPlayerKitServiceClient(conn), callOpts)
 	c.Reversaler = newReversalerClient(reversalerpb.NewFateReversalerServiceClient(conn), callOpts)
+	c.Scheduler = newSchedulerClient(schedulerpb.NewSchedulerServiceClient(conn), callOpts)
+	c.Scoreboard = newScoreboardClient(scoreboardpb.NewScoreboardServiceClient(conn), callOpts)
+	c.Tag = newTagClient(tagpb.NewTagServiceClient(conn), callOpts)
 	c.Utils = newUtilsClient(utilspb.NewUtilsServiceClient(conn), callOpts)
+	c.World = newWorldClient(worldpb.NewWorldServiceClient(conn), callOpts)
 
 	return c, nil
 }
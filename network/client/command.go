@@ -11,6 +11,7 @@ import (
 type CommandClient struct {
 	rpc         commandpb.CommandServiceClient
 	callOptions []grpc.CallOption
+	pacing      *commandpb.CommandPacing
 }
 
 func newCommandClient(rpc commandpb.CommandServiceClient, callOptions []grpc.CallOption) *CommandClient {
@@ -20,11 +21,22 @@ func newCommandClient(rpc commandpb.CommandServiceClient, callOptions []grpc.Cal
 	}
 }
 
+// WithPacing returns a copy of the client whose single-command calls are
+// queued with the provided priority and saturation behaviour.
+func (c *CommandClient) WithPacing(priority commandpb.CommandPriority, failFast bool) *CommandClient {
+	if c == nil {
+		return nil
+	}
+	out := *c
+	out.pacing = &commandpb.CommandPacing{Priority: priority, FailFast: failFast}
+	return &out
+}
+
 func (c *CommandClient) SendWOCommand(ctx context.Context, cmd string, opts ...grpc.CallOption) error {
 	if c == nil || c.rpc == nil {
 		return clientUnavailable("command")
 	}
-	req := &commandpb.SendWOCommandRequest{Cmd: strings.TrimSpace(cmd)}
+	req := &commandpb.SendWOCommandRequest{Cmd: strings.TrimSpace(cmd), Pacing: c.pacing}
 	resp, err := c.rpc.SendWOCommand(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 	if err != nil {
 		return err
@@ -37,7 +49,7 @@ func (c *CommandClient) SendWSCommand(ctx context.Context, cmd string, opts ...g
 	if c == nil || c.rpc == nil {
 		return clientUnavailable("command")
 	}
-	req := &commandpb.SendWSCommandRequest{Cmd: strings.TrimSpace(cmd)}
+	req := &commandpb.SendWSCommandRequest{Cmd: strings.TrimSpace(cmd), Pacing: c.pacing}
 	resp, err := c.rpc.SendWSCommand(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 	if err != nil {
 		return err
@@ -50,7 +62,7 @@ func (c *CommandClient) SendPlayerCommand(ctx context.Context, cmd string, opts
 	if c == nil || c.rpc == nil {
 		return clientUnavailable("command")
 	}
-	req := &commandpb.SendPlayerCommandRequest{Cmd: strings.TrimSpace(cmd)}
+	req := &commandpb.SendPlayerCommandRequest{Cmd: strings.TrimSpace(cmd), Pacing: c.pacing}
 	resp, err := c.rpc.SendPlayerCommand(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 	if err != nil {
 		return err
@@ -66,6 +78,7 @@ func (c *CommandClient) SendAICommand(ctx context.Context, runtimeID, cmd string
 	req := &commandpb.SendAICommandRequest{
 		RuntimeId: strings.TrimSpace(runtimeID),
 		Cmd:       strings.TrimSpace(cmd),
+		Pacing:    c.pacing,
 	}
 	resp, err := c.rpc.SendAICommand(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 	if err != nil {
@@ -79,24 +92,48 @@ func (c *CommandClient) SendWSCommandWithResponse(ctx context.Context, cmd strin
 	if c == nil || c.rpc == nil {
 		return "", clientUnavailable("command")
 	}
-	req := &commandpb.SendWSCommandWithResponseRequest{Cmd: strings.TrimSpace(cmd)}
+	req := &commandpb.SendWSCommandWithResponseRequest{Cmd: strings.TrimSpace(cmd), Pacing: c.pacing}
 	resp, err := c.rpc.SendWSCommandWithResponse(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 	if err != nil {
 		return "", err
 	}
-	return generalPayload(resp)
+	return commandOutputPayload(resp)
+}
+
+func (c *CommandClient) SendWSCommandWithOutput(ctx context.Context, cmd string, opts ...grpc.CallOption) (*commandpb.CommandOutput, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.SendWSCommandWithResponseRequest{Cmd: strings.TrimSpace(cmd), Pacing: c.pacing}
+	resp, err := c.rpc.SendWSCommandWithResponse(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return commandOutput(resp)
 }
 
 func (c *CommandClient) SendPlayerCommandWithResponse(ctx context.Context, cmd string, opts ...grpc.CallOption) (string, error) {
 	if c == nil || c.rpc == nil {
 		return "", clientUnavailable("command")
 	}
-	req := &commandpb.SendPlayerCommandWithResponseRequest{Cmd: strings.TrimSpace(cmd)}
+	req := &commandpb.SendPlayerCommandWithResponseRequest{Cmd: strings.TrimSpace(cmd), Pacing: c.pacing}
 	resp, err := c.rpc.SendPlayerCommandWithResponse(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 	if err != nil {
 		return "", err
 	}
-	return generalPayload(resp)
+	return commandOutputPayload(resp)
+}
+
+func (c *CommandClient) SendPlayerCommandWithOutput(ctx context.Context, cmd string, opts ...grpc.CallOption) (*commandpb.CommandOutput, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.SendPlayerCommandWithResponseRequest{Cmd: strings.TrimSpace(cmd), Pacing: c.pacing}
+	resp, err := c.rpc.SendPlayerCommandWithResponse(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return commandOutput(resp)
 }
 
 func (c *CommandClient) SendAICommandWithResponse(ctx context.Context, runtimeID, cmd string, opts ...grpc.CallOption) (string, error) {
@@ -106,10 +143,191 @@ func (c *CommandClient) SendAICommandWithResponse(ctx context.Context, runtimeID
 	req := &commandpb.SendAICommandWithResponseRequest{
 		RuntimeId: strings.TrimSpace(runtimeID),
 		Cmd:       strings.TrimSpace(cmd),
+		Pacing:    c.pacing,
 	}
 	resp, err := c.rpc.SendAICommandWithResponse(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 	if err != nil {
 		return "", err
 	}
-	return generalPayload(resp)
+	return commandOutputPayload(resp)
+}
+
+func (c *CommandClient) SendAICommandWithOutput(ctx context.Context, runtimeID, cmd string, opts ...grpc.CallOption) (*commandpb.CommandOutput, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.SendAICommandWithResponseRequest{
+		RuntimeId: strings.TrimSpace(runtimeID),
+		Cmd:       strings.TrimSpace(cmd),
+		Pacing:    c.pacing,
+	}
+	resp, err := c.rpc.SendAICommandWithResponse(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return commandOutput(resp)
+}
+
+func (c *CommandClient) SendCommandBatch(ctx context.Context, req *commandpb.SendCommandBatchRequest, opts ...grpc.CallOption) (*commandpb.SendCommandBatchResponse, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	return c.rpc.SendCommandBatch(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+}
+
+func (c *CommandClient) StreamCommandBatch(ctx context.Context, req *commandpb.SendCommandBatchRequest, opts ...grpc.CallOption) (commandpb.CommandService_StreamCommandBatchClient, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	return c.rpc.StreamCommandBatch(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+}
+
+// commandOutputPayload returns the legacy JSON payload of resp.
+func commandOutputPayload(resp *commandpb.CommandOutputResponse) (string, error) {
+	if resp == nil {
+		return "", errNilResponse
+	}
+	if resp.GetStatus() != commandpb.CommandOutputResponse_SUCCESS {
+		return "", responseError(resp.GetErrorMsg())
+	}
+	return resp.GetPayload(), nil
+}
+
+func commandOutput(resp *commandpb.CommandOutputResponse) (*commandpb.CommandOutput, error) {
+	if resp == nil {
+		return nil, errNilResponse
+	}
+	if resp.GetStatus() != commandpb.CommandOutputResponse_SUCCESS {
+		return nil, responseError(resp.GetErrorMsg())
+	}
+	return resp.GetOutput(), nil
+}
+
+func (c *CommandClient) GetCommandQueueStats(ctx context.Context, opts ...grpc.CallOption) (*commandpb.GetCommandQueueStatsResponse, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	return c.rpc.GetCommandQueueStats(ctx, &commandpb.GetCommandQueueStatsRequest{}, mergeCallOptions(c.callOptions, opts)...)
+}
+
+func (c *CommandClient) ConfigureCommandPacing(ctx context.Context, cfg *commandpb.CommandPacingConfig, opts ...grpc.CallOption) (*commandpb.CommandPacingConfig, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.ConfigureCommandPacingRequest{Config: cfg}
+	return c.rpc.ConfigureCommandPacing(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+}
+
+func (c *CommandClient) BuildCommandFragment(ctx context.Context, req *commandpb.BuildCommandFragmentRequest, opts ...grpc.CallOption) (string, error) {
+	if c == nil || c.rpc == nil {
+		return "", clientUnavailable("command")
+	}
+	resp, err := c.rpc.BuildCommandFragment(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return "", err
+	}
+	return resp.GetFragment(), nil
+}
+
+func (c *CommandClient) SendCommandAs(ctx context.Context, req *commandpb.SendCommandAsRequest, opts ...grpc.CallOption) error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("command")
+	}
+	if req != nil && req.Pacing == nil && c.pacing != nil {
+		req.Pacing = c.pacing
+	}
+	resp, err := c.rpc.SendCommandAs(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
+
+func (c *CommandClient) SendCommandAsWithOutput(ctx context.Context, req *commandpb.SendCommandAsRequest, opts ...grpc.CallOption) (*commandpb.CommandOutput, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	if req != nil && req.Pacing == nil && c.pacing != nil {
+		req.Pacing = c.pacing
+	}
+	resp, err := c.rpc.SendCommandAsWithResponse(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return commandOutput(resp)
+}
+
+func (c *CommandClient) GetCommandPolicy(ctx context.Context, opts ...grpc.CallOption) (*commandpb.CommandPolicy, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	return c.rpc.GetCommandPolicy(ctx, &commandpb.GetCommandPolicyRequest{}, mergeCallOptions(c.callOptions, opts)...)
+}
+
+func (c *CommandClient) SetCommandPolicy(ctx context.Context, policy *commandpb.CommandPolicy, opts ...grpc.CallOption) (*commandpb.CommandPolicy, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.SetCommandPolicyRequest{Policy: policy}
+	return c.rpc.SetCommandPolicy(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+}
+
+func (c *CommandClient) CheckCommand(ctx context.Context, cmd string, opts ...grpc.CallOption) (*commandpb.CheckCommandResponse, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.CheckCommandRequest{Cmd: strings.TrimSpace(cmd)}
+	return c.rpc.CheckCommand(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+}
+
+// GetCommandTree returns the commands announced by the server. An empty name
+// returns every command.
+func (c *CommandClient) GetCommandTree(ctx context.Context, name string, opts ...grpc.CallOption) ([]*commandpb.CommandSpec, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.GetCommandTreeRequest{Name: strings.TrimSpace(name)}
+	resp, err := c.rpc.GetCommandTree(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetCommands(), nil
+}
+
+// ValidateCommand checks cmd against the command tree without sending it.
+// Offsets in the response refer to cmd as passed.
+func (c *CommandClient) ValidateCommand(ctx context.Context, cmd string, opts ...grpc.CallOption) (*commandpb.ValidateCommandResponse, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.ValidateCommandRequest{Cmd: cmd}
+	return c.rpc.ValidateCommand(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+}
+
+// CompleteCommand returns completion candidates for cmd at cursor. A negative
+// cursor completes at the end of cmd and a zero limit uses the server default.
+func (c *CommandClient) CompleteCommand(ctx context.Context, cmd string, cursor, limit int, opts ...grpc.CallOption) ([]*commandpb.CommandCompletion, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	req := &commandpb.CompleteCommandRequest{Cmd: cmd, Limit: int32(limit)}
+	if cursor >= 0 {
+		at := int32(cursor)
+		req.Cursor = &at
+	}
+	resp, err := c.rpc.CompleteCommand(ctx, req, mergeCallOptions(c.callOptions, opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetCompletions(), nil
+}
+
+// RunScript starts a command script on the server. The stream yields one
+// event per executed line and ends with a DONE event carrying the totals.
+func (c *CommandClient) RunScript(ctx context.Context, req *commandpb.RunScriptRequest, opts ...grpc.CallOption) (commandpb.CommandService_RunScriptClient, error) {
+	if c == nil || c.rpc == nil {
+		return nil, clientUnavailable("command")
+	}
+	return c.rpc.RunScript(ctx, req, mergeCallOptions(c.callOptions, opts)...)
 }
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	entitypb "github.com/Yeah114/tempest-core/network_api/entity"
+	"google.golang.org/grpc"
+)
+
+type EntityClient struct {
+	rpc         entitypb.EntityServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newEntityClient(rpc entitypb.EntityServiceClient, callOptions []grpc.CallOption) *EntityClient {
+	return &EntityClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *EntityClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("entity")
+	}
+	return nil
+}
+
+func (c *EntityClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+func (c *EntityClient) QueryEntities(ctx context.Context, filter *entitypb.EntityFilter, limit uint32, opts ...grpc.CallOption) ([]*entitypb.Entity, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &entitypb.QueryEntitiesRequest{Filter: filter, Limit: limit}
+	resp, err := c.rpc.QueryEntities(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetEntities(), nil
+}
+
+func (c *EntityClient) GetEntityByRuntimeID(ctx context.Context, runtimeID uint64, opts ...grpc.CallOption) (*entitypb.Entity, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &entitypb.GetEntityRequest{Id: &entitypb.GetEntityRequest_RuntimeId{RuntimeId: runtimeID}}
+	return c.rpc.GetEntity(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *EntityClient) GetEntityByUniqueID(ctx context.Context, uniqueID int64, opts ...grpc.CallOption) (*entitypb.Entity, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &entitypb.GetEntityRequest{Id: &entitypb.GetEntityRequest_UniqueId{UniqueId: uniqueID}}
+	return c.rpc.GetEntity(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *EntityClient) ListenEntities(ctx context.Context, req *entitypb.ListenEntitiesRequest, opts ...grpc.CallOption) (entitypb.EntityService_ListenEntitiesClient, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		req = &entitypb.ListenEntitiesRequest{}
+	}
+	return c.rpc.ListenEntities(ctx, req, c.callOpts(opts)...)
+}
+
+// QueryTargets runs querytarget for a raw selector such as "@e[type=zombie]".
+// limit caps the number of records; zero returns every match.
+func (c *EntityClient) QueryTargets(ctx context.Context, selector string, limit uint32, opts ...grpc.CallOption) ([]*entitypb.TargetRecord, error) {
+	req := &entitypb.QueryTargetsRequest{
+		Target: &entitypb.QueryTargetsRequest_Selector{Selector: strings.TrimSpace(selector)},
+		Limit:  limit,
+	}
+	return c.queryTargets(ctx, req, opts)
+}
+
+// QueryTargetsSpec runs querytarget for a typed selector. Selectors without
+// a count are fetched in pages of pageSize; zero uses the server default.
+func (c *EntityClient) QueryTargetsSpec(ctx context.Context, spec *commandpb.SelectorSpec, pageSize, limit uint32, opts ...grpc.CallOption) ([]*entitypb.TargetRecord, error) {
+	req := &entitypb.QueryTargetsRequest{
+		Target:   &entitypb.QueryTargetsRequest_Spec{Spec: spec},
+		PageSize: pageSize,
+		Limit:    limit,
+	}
+	return c.queryTargets(ctx, req, opts)
+}
+
+func (c *EntityClient) queryTargets(ctx context.Context, req *entitypb.QueryTargetsRequest, opts []grpc.CallOption) ([]*entitypb.TargetRecord, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	resp, err := c.rpc.QueryTargets(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTargets(), nil
+}
+
+// TestForTargets runs testfor for a raw selector and returns the matched
+// names.
+func (c *EntityClient) TestForTargets(ctx context.Context, selector string, opts ...grpc.CallOption) ([]string, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &entitypb.TestForTargetsRequest{
+		Target: &entitypb.TestForTargetsRequest_Selector{Selector: strings.TrimSpace(selector)},
+	}
+	resp, err := c.rpc.TestForTargets(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetNames(), nil
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	permissionpb "github.com/Yeah114/tempest-core/network_api/permission"
+	"google.golang.org/grpc"
+)
+
+type PermissionClient struct {
+	rpc         permissionpb.PermissionServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newPermissionClient(rpc permissionpb.PermissionServiceClient, callOptions []grpc.CallOption) *PermissionClient {
+	return &PermissionClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *PermissionClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("permission")
+	}
+	return nil
+}
+
+func (c *PermissionClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+// PutRole creates role or replaces the role of the same name.
+func (c *PermissionClient) PutRole(ctx context.Context, role *permissionpb.PermissionRole, opts ...grpc.CallOption) (*permissionpb.PermissionRole, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	return c.rpc.PutRole(ctx, &permissionpb.PutRoleRequest{Role: role}, c.callOpts(opts)...)
+}
+
+func (c *PermissionClient) GetRole(ctx context.Context, name string, opts ...grpc.CallOption) (*permissionpb.PermissionRole, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &permissionpb.GetRoleRequest{Name: strings.TrimSpace(name)}
+	return c.rpc.GetRole(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *PermissionClient) ListRoles(ctx context.Context, opts ...grpc.CallOption) ([]*permissionpb.PermissionRole, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	resp, err := c.rpc.ListRoles(ctx, &permissionpb.ListRolesRequest{}, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRoles(), nil
+}
+
+func (c *PermissionClient) DeleteRole(ctx context.Context, name string, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	req := &permissionpb.DeleteRoleRequest{Name: strings.TrimSpace(name)}
+	resp, err := c.rpc.DeleteRole(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
+
+// AssignRoleToUUID gives role to the player with uuid.
+func (c *PermissionClient) AssignRoleToUUID(ctx context.Context, uuid, role string, opts ...grpc.CallOption) (*permissionpb.RoleAssignment, error) {
+	return c.assign(ctx, permissionpb.RoleAssignment_UUID, uuid, role, opts)
+}
+
+// AssignRoleToNames gives role to players whose name matches pattern, a
+// case-insensitive glob using * and ?.
+func (c *PermissionClient) AssignRoleToNames(ctx context.Context, pattern, role string, opts ...grpc.CallOption) (*permissionpb.RoleAssignment, error) {
+	return c.assign(ctx, permissionpb.RoleAssignment_NAME, pattern, role, opts)
+}
+
+// SetDefaultRole gives role to players no other assignment matches.
+func (c *PermissionClient) SetDefaultRole(ctx context.Context, role string, opts ...grpc.CallOption) (*permissionpb.RoleAssignment, error) {
+	return c.assign(ctx, permissionpb.RoleAssignment_DEFAULT, "", role, opts)
+}
+
+func (c *PermissionClient) assign(ctx context.Context, kind permissionpb.RoleAssignment_Kind, pattern, role string, opts []grpc.CallOption) (*permissionpb.RoleAssignment, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &permissionpb.AssignRoleRequest{Assignment: &permissionpb.RoleAssignment{
+		Kind:    kind,
+		Pattern: strings.TrimSpace(pattern),
+		Role:    strings.TrimSpace(role),
+	}}
+	return c.rpc.AssignRole(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *PermissionClient) UnassignRole(ctx context.Context, kind permissionpb.RoleAssignment_Kind, pattern string, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	req := &permissionpb.UnassignRoleRequest{Kind: kind, Pattern: strings.TrimSpace(pattern)}
+	resp, err := c.rpc.UnassignRole(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
+
+func (c *PermissionClient) ListAssignments(ctx context.Context, opts ...grpc.CallOption) ([]*permissionpb.RoleAssignment, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	resp, err := c.rpc.ListAssignments(ctx, &permissionpb.ListAssignmentsRequest{}, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetAssignments(), nil
+}
+
+// ApplyRole applies the role assigned to an online player right away.
+func (c *PermissionClient) ApplyRole(ctx context.Context, uuid string, opts ...grpc.CallOption) (*permissionpb.RoleApplication, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &permissionpb.ApplyRoleRequest{UuidStr: strings.TrimSpace(uuid)}
+	return c.rpc.ApplyRole(ctx, req, c.callOpts(opts)...)
+}
+
+// GetRoleDrift reports players whose abilities differ from their role;
+// without UUIDs every online player is checked.
+func (c *PermissionClient) GetRoleDrift(ctx context.Context, uuids []string, opts ...grpc.CallOption) (*permissionpb.GetRoleDriftResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &permissionpb.GetRoleDriftRequest{UuidStrs: uuids}
+	return c.rpc.GetRoleDrift(ctx, req, c.callOpts(opts)...)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
 	playerkitpb "github.com/Yeah114/tempest-core/network_api/playerkit"
 	"google.golang.org/grpc"
@@ -501,13 +502,24 @@ func (c *PlayerKitClient) SendPlayerActionBar(ctx context.Context, uuid, actionB
 	return err
 }
 
+// InterceptPlayerJustNextInput captures the player's next chat message under
+// retrieverID with the default timeout. Collect it with WaitPlayerInput.
 func (c *PlayerKitClient) InterceptPlayerJustNextInput(ctx context.Context, uuid, retrieverID string, opts ...grpc.CallOption) error {
+	return c.InterceptPlayerNextInput(ctx, uuid, retrieverID, 0, false, opts...)
+}
+
+// InterceptPlayerNextInput is InterceptPlayerJustNextInput with a timeout and
+// the option to hide the captured message from chat listeners. A zero
+// timeout uses the server default.
+func (c *PlayerKitClient) InterceptPlayerNextInput(ctx context.Context, uuid, retrieverID string, timeout time.Duration, suppress bool, opts ...grpc.CallOption) error {
 	if err := c.ready(); err != nil {
 		return err
 	}
 	req := &playerkitpb.InterceptPlayerJustNextInputRequest{
 		UuidStr:     strings.TrimSpace(uuid),
 		RetrieverId: strings.TrimSpace(retrieverID),
+		TimeoutMs:   uint32(timeout.Milliseconds()),
+		Suppress:    suppress,
 	}
 	resp, err := c.rpc.InterceptPlayerJustNextInput(ctx, req, c.callOpts(opts)...)
 	if err != nil {
@@ -516,3 +528,67 @@ func (c *PlayerKitClient) InterceptPlayerJustNextInput(ctx context.Context, uuid
 	_, err = generalPayload(resp)
 	return err
 }
+
+// WaitPlayerInput blocks until the interception under retrieverID finishes.
+func (c *PlayerKitClient) WaitPlayerInput(ctx context.Context, retrieverID string, opts ...grpc.CallOption) (*playerkitpb.PlayerInput, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &playerkitpb.WaitPlayerInputRequest{RetrieverId: strings.TrimSpace(retrieverID)}
+	return c.rpc.WaitPlayerInput(ctx, req, c.callOpts(opts)...)
+}
+
+// PlayerPrompt asks a player a question and waits for a valid answer. The
+// response status tells answered prompts apart from timeouts and players who
+// ran out of attempts.
+func (c *PlayerKitClient) PlayerPrompt(ctx context.Context, req *playerkitpb.PlayerPromptRequest, opts ...grpc.CallOption) (*playerkitpb.PlayerPromptResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	return c.rpc.PlayerPrompt(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *PlayerKitClient) GetPlayerProfile(ctx context.Context, uuid string, opts ...grpc.CallOption) (*playerkitpb.PlayerProfile, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &playerkitpb.GetPlayerProfileRequest{UuidStr: strings.TrimSpace(uuid)}
+	return c.rpc.GetPlayerProfile(ctx, req, c.callOpts(opts)...)
+}
+
+// GetPlayerProfiles fetches several profiles at once; without UUIDs it
+// returns every online player.
+func (c *PlayerKitClient) GetPlayerProfiles(ctx context.Context, uuids []string, opts ...grpc.CallOption) (*playerkitpb.GetPlayerProfilesResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &playerkitpb.GetPlayerProfilesRequest{UuidStrs: uuids}
+	return c.rpc.GetPlayerProfiles(ctx, req, c.callOpts(opts)...)
+}
+
+// SetPlayerAbilities changes several abilities in one request and waits up
+// to timeout (0 for the server default) for the server to confirm them.
+func (c *PlayerKitClient) SetPlayerAbilities(ctx context.Context, uuid string, changes *playerkitpb.AbilityChanges, timeout time.Duration, opts ...grpc.CallOption) (*playerkitpb.SetPlayerAbilitiesResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &playerkitpb.SetPlayerAbilitiesRequest{
+		UuidStr:   strings.TrimSpace(uuid),
+		Abilities: changes,
+		TimeoutMs: uint32(timeout / time.Millisecond),
+	}
+	return c.rpc.SetPlayerAbilities(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *PlayerKitClient) CancelPlayerInput(ctx context.Context, retrieverID string, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	req := &playerkitpb.CancelPlayerInputRequest{RetrieverId: strings.TrimSpace(retrieverID)}
+	resp, err := c.rpc.CancelPlayerInput(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	schedulerpb "github.com/Yeah114/tempest-core/network_api/scheduler"
+	"google.golang.org/grpc"
+)
+
+type SchedulerClient struct {
+	rpc         schedulerpb.SchedulerServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newSchedulerClient(rpc schedulerpb.SchedulerServiceClient, callOptions []grpc.CallOption) *SchedulerClient {
+	return &SchedulerClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *SchedulerClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("scheduler")
+	}
+	return nil
+}
+
+func (c *SchedulerClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+// CreateJob schedules req. Set either Cron or IntervalMs.
+func (c *SchedulerClient) CreateJob(ctx context.Context, req *schedulerpb.CreateJobRequest, opts ...grpc.CallOption) (*schedulerpb.Job, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	return c.rpc.CreateJob(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *SchedulerClient) ListJobs(ctx context.Context, opts ...grpc.CallOption) ([]*schedulerpb.Job, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	resp, err := c.rpc.ListJobs(ctx, &schedulerpb.ListJobsRequest{}, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetJobs(), nil
+}
+
+func (c *SchedulerClient) GetJob(ctx context.Context, id string, opts ...grpc.CallOption) (*schedulerpb.Job, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &schedulerpb.GetJobRequest{Id: strings.TrimSpace(id)}
+	return c.rpc.GetJob(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *SchedulerClient) PauseJob(ctx context.Context, id string, opts ...grpc.CallOption) (*schedulerpb.Job, error) {
+	return c.setPaused(ctx, id, true, opts)
+}
+
+func (c *SchedulerClient) ResumeJob(ctx context.Context, id string, opts ...grpc.CallOption) (*schedulerpb.Job, error) {
+	return c.setPaused(ctx, id, false, opts)
+}
+
+func (c *SchedulerClient) setPaused(ctx context.Context, id string, paused bool, opts []grpc.CallOption) (*schedulerpb.Job, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &schedulerpb.PauseJobRequest{Id: strings.TrimSpace(id), Paused: paused}
+	return c.rpc.PauseJob(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *SchedulerClient) DeleteJob(ctx context.Context, id string, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	req := &schedulerpb.DeleteJobRequest{Id: strings.TrimSpace(id)}
+	resp, err := c.rpc.DeleteJob(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
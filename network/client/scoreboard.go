@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	scoreboardpb "github.com/Yeah114/tempest-core/network_api/scoreboard"
+	"google.golang.org/grpc"
+)
+
+type ScoreboardClient struct {
+	rpc         scoreboardpb.ScoreboardServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newScoreboardClient(rpc scoreboardpb.ScoreboardServiceClient, callOptions []grpc.CallOption) *ScoreboardClient {
+	return &ScoreboardClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *ScoreboardClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("scoreboard")
+	}
+	return nil
+}
+
+func (c *ScoreboardClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+func (c *ScoreboardClient) ListObjectives(ctx context.Context, opts ...grpc.CallOption) ([]*scoreboardpb.Objective, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	resp, err := c.rpc.ListObjectives(ctx, &scoreboardpb.ListObjectivesRequest{}, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetObjectives(), nil
+}
+
+// GetScore reads the score of target, a player or fake player name. found is
+// false when target has no score in objective. refresh bypasses the cache.
+func (c *ScoreboardClient) GetScore(ctx context.Context, objective, target string, refresh bool, opts ...grpc.CallOption) (value int32, found bool, err error) {
+	if err := c.ready(); err != nil {
+		return 0, false, err
+	}
+	req := &scoreboardpb.GetScoreRequest{Objective: strings.TrimSpace(objective), Target: strings.TrimSpace(target), Refresh: refresh}
+	resp, err := c.rpc.GetScore(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return 0, false, err
+	}
+	return resp.GetValue(), resp.GetFound(), nil
+}
+
+func (c *ScoreboardClient) SetScore(ctx context.Context, objective, target string, value int32, opts ...grpc.CallOption) (int32, error) {
+	if err := c.ready(); err != nil {
+		return 0, err
+	}
+	req := &scoreboardpb.SetScoreRequest{Objective: strings.TrimSpace(objective), Target: strings.TrimSpace(target), Value: value}
+	resp, err := c.rpc.SetScore(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetValue(), nil
+}
+
+// AddScore adds delta, which may be negative, and returns the new score.
+func (c *ScoreboardClient) AddScore(ctx context.Context, objective, target string, delta int32, opts ...grpc.CallOption) (int32, error) {
+	if err := c.ready(); err != nil {
+		return 0, err
+	}
+	req := &scoreboardpb.AddScoreRequest{Objective: strings.TrimSpace(objective), Target: strings.TrimSpace(target), Delta: delta}
+	resp, err := c.rpc.AddScore(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetValue(), nil
+}
+
+func (c *ScoreboardClient) GetObjective(ctx context.Context, objective string, refresh bool, opts ...grpc.CallOption) (*scoreboardpb.GetObjectiveResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &scoreboardpb.GetObjectiveRequest{Objective: strings.TrimSpace(objective), Refresh: refresh}
+	return c.rpc.GetObjective(ctx, req, c.callOpts(opts)...)
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	tagpb "github.com/Yeah114/tempest-core/network_api/tag"
+	"google.golang.org/grpc"
+)
+
+type TagClient struct {
+	rpc         tagpb.TagServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newTagClient(rpc tagpb.TagServiceClient, callOptions []grpc.CallOption) *TagClient {
+	return &TagClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *TagClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("tag")
+	}
+	return nil
+}
+
+func (c *TagClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+func (c *TagClient) ListTags(ctx context.Context, playerUUID string, opts ...grpc.CallOption) ([]string, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &tagpb.ListTagsRequest{PlayerUuid: strings.TrimSpace(playerUUID)}
+	resp, err := c.rpc.ListTags(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTags(), nil
+}
+
+// AddTags adds every tag to every target and reports each pair.
+func (c *TagClient) AddTags(ctx context.Context, targets []*tagpb.TagTarget, tags []string, opts ...grpc.CallOption) (*tagpb.ModifyTagsResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &tagpb.ModifyTagsRequest{Targets: targets, Tags: tags}
+	return c.rpc.AddTags(ctx, req, c.callOpts(opts)...)
+}
+
+// RemoveTags removes every tag from every target and reports each pair.
+func (c *TagClient) RemoveTags(ctx context.Context, targets []*tagpb.TagTarget, tags []string, opts ...grpc.CallOption) (*tagpb.ModifyTagsResponse, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &tagpb.ModifyTagsRequest{Targets: targets, Tags: tags}
+	return c.rpc.RemoveTags(ctx, req, c.callOpts(opts)...)
+}
+
+func (c *TagClient) FindTaggedPlayers(ctx context.Context, tag string, opts ...grpc.CallOption) ([]*tagpb.TaggedPlayer, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	req := &tagpb.FindTaggedPlayersRequest{Tag: strings.TrimSpace(tag)}
+	resp, err := c.rpc.FindTaggedPlayers(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPlayers(), nil
+}
+
+// PlayerTarget addresses an online player by UUID.
+func PlayerTarget(uuid string) *tagpb.TagTarget {
+	return &tagpb.TagTarget{Target: &tagpb.TagTarget_PlayerUuid{PlayerUuid: strings.TrimSpace(uuid)}}
+}
+
+// SelectorTarget addresses whatever a raw selector matches.
+func SelectorTarget(selector string) *tagpb.TagTarget {
+	return &tagpb.TagTarget{Target: &tagpb.TagTarget_Selector{Selector: strings.TrimSpace(selector)}}
+}
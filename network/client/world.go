@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	worldpb "github.com/Yeah114/tempest-core/network_api/world"
+	"google.golang.org/grpc"
+)
+
+type WorldClient struct {
+	rpc         worldpb.WorldServiceClient
+	callOptions []grpc.CallOption
+}
+
+func newWorldClient(rpc worldpb.WorldServiceClient, callOptions []grpc.CallOption) *WorldClient {
+	return &WorldClient{
+		rpc:         rpc,
+		callOptions: append([]grpc.CallOption(nil), callOptions...),
+	}
+}
+
+func (c *WorldClient) ready() error {
+	if c == nil || c.rpc == nil {
+		return clientUnavailable("world")
+	}
+	return nil
+}
+
+func (c *WorldClient) callOpts(opts []grpc.CallOption) []grpc.CallOption {
+	return mergeCallOptions(c.callOptions, opts)
+}
+
+func (c *WorldClient) GetWorldInfo(ctx context.Context, opts ...grpc.CallOption) (*worldpb.WorldInfo, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	return c.rpc.GetWorldInfo(ctx, &worldpb.GetWorldInfoRequest{}, c.callOpts(opts)...)
+}
+
+func (c *WorldClient) GetGameRule(ctx context.Context, name string, opts ...grpc.CallOption) (*worldpb.GameRule, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	return c.rpc.GetGameRule(ctx, &worldpb.GetGameRuleRequest{Name: strings.TrimSpace(name)}, c.callOpts(opts)...)
+}
+
+// SetGameRuleBool sets a boolean game rule such as keepinventory.
+func (c *WorldClient) SetGameRuleBool(ctx context.Context, name string, value bool, opts ...grpc.CallOption) error {
+	rule := &worldpb.GameRule{Name: strings.TrimSpace(name), Value: &worldpb.GameRule_BoolValue{BoolValue: value}}
+	return c.setGameRule(ctx, rule, opts)
+}
+
+// SetGameRuleInt sets an integer game rule such as randomtickspeed.
+func (c *WorldClient) SetGameRuleInt(ctx context.Context, name string, value int32, opts ...grpc.CallOption) error {
+	rule := &worldpb.GameRule{Name: strings.TrimSpace(name), Value: &worldpb.GameRule_IntValue{IntValue: value}}
+	return c.setGameRule(ctx, rule, opts)
+}
+
+func (c *WorldClient) setGameRule(ctx context.Context, rule *worldpb.GameRule, opts []grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	resp, err := c.rpc.SetGameRule(ctx, &worldpb.SetGameRuleRequest{Rule: rule}, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
+
+func (c *WorldClient) SetTime(ctx context.Context, ticks int32, opts ...grpc.CallOption) error {
+	return c.setTime(ctx, &worldpb.SetTimeRequest{Time: &worldpb.SetTimeRequest_Ticks{Ticks: ticks}}, opts)
+}
+
+func (c *WorldClient) AddTime(ctx context.Context, ticks int32, opts ...grpc.CallOption) error {
+	return c.setTime(ctx, &worldpb.SetTimeRequest{Time: &worldpb.SetTimeRequest_Ticks{Ticks: ticks}, Add: true}, opts)
+}
+
+func (c *WorldClient) SetTimePreset(ctx context.Context, preset worldpb.TimePreset, opts ...grpc.CallOption) error {
+	return c.setTime(ctx, &worldpb.SetTimeRequest{Time: &worldpb.SetTimeRequest_Preset{Preset: preset}}, opts)
+}
+
+func (c *WorldClient) setTime(ctx context.Context, req *worldpb.SetTimeRequest, opts []grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	resp, err := c.rpc.SetTime(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
+
+func (c *WorldClient) GetWeather(ctx context.Context, opts ...grpc.CallOption) (worldpb.Weather, error) {
+	if err := c.ready(); err != nil {
+		return 0, err
+	}
+	resp, err := c.rpc.GetWeather(ctx, &worldpb.GetWeatherRequest{}, c.callOpts(opts)...)
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetWeather(), nil
+}
+
+// SetWeather changes the weather. A durationTicks of zero lets the server
+// choose how long it lasts.
+func (c *WorldClient) SetWeather(ctx context.Context, weather worldpb.Weather, durationTicks int32, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	req := &worldpb.SetWeatherRequest{Weather: weather, DurationTicks: durationTicks}
+	resp, err := c.rpc.SetWeather(ctx, req, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
+
+func (c *WorldClient) SetDifficulty(ctx context.Context, difficulty worldpb.Difficulty, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	resp, err := c.rpc.SetDifficulty(ctx, &worldpb.SetDifficultyRequest{Difficulty: difficulty}, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
+
+func (c *WorldClient) GetWorldSpawn(ctx context.Context, opts ...grpc.CallOption) (*worldpb.WorldSpawn, error) {
+	if err := c.ready(); err != nil {
+		return nil, err
+	}
+	return c.rpc.GetWorldSpawn(ctx, &worldpb.GetWorldSpawnRequest{}, c.callOpts(opts)...)
+}
+
+func (c *WorldClient) SetWorldSpawn(ctx context.Context, x, y, z int32, opts ...grpc.CallOption) error {
+	if err := c.ready(); err != nil {
+		return err
+	}
+	resp, err := c.rpc.SetWorldSpawn(ctx, &worldpb.SetWorldSpawnRequest{X: x, Y: y, Z: z}, c.callOpts(opts)...)
+	if err != nil {
+		return err
+	}
+	_, err = generalPayload(resp)
+	return err
+}
@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Yeah114/tempest-core/network/app"
+	blockpb "github.com/Yeah114/tempest-core/network_api/block"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAreaVolume bounds GetArea so a single request cannot walk the whole cache.
+const maxAreaVolume = 1 << 16
+
+// BlockService serves block reads from the local chunk cache.
+type BlockService struct {
+	blockpb.UnimplementedBlockServiceServer
+	state *app.FatalderState
+}
+
+// NewBlockService constructs a block service.
+func NewBlockService(state *app.FatalderState) *BlockService {
+	return &BlockService{state: state}
+}
+
+func (s *BlockService) GetBlock(ctx context.Context, req *blockpb.GetBlockRequest) (*blockpb.GetBlockResponse, error) {
+	if req.GetPosition() == nil {
+		return nil, status.Error(codes.InvalidArgument, "position required")
+	}
+	if req.GetLayer() > 1 {
+		return nil, status.Error(codes.InvalidArgument, "layer must be 0 or 1")
+	}
+	runtimeID, ok := s.state.Chunks().Block(blockPosFromProto(req.GetPosition()), req.GetLayer())
+	if !ok {
+		return &blockpb.GetBlockResponse{}, nil
+	}
+	return &blockpb.GetBlockResponse{
+		Cached: true,
+		Block:  blockInfo(runtimeID),
+	}, nil
+}
+
+func (s *BlockService) GetArea(ctx context.Context, req *blockpb.GetAreaRequest) (*blockpb.GetAreaResponse, error) {
+	if req.GetLayer() > 1 {
+		return nil, status.Error(codes.InvalidArgument, "layer must be 0 or 1")
+	}
+	min, max, err := blockBoxFromProto(req.GetBox())
+	if err != nil {
+		return nil, err
+	}
+	volume := boxVolume(min, max)
+	if volume > maxAreaVolume {
+		return nil, status.Errorf(codes.InvalidArgument, "area of %d blocks exceeds limit %d", volume, maxAreaVolume)
+	}
+
+	out := &blockpb.GetAreaResponse{
+		Box:     &blockpb.BlockBox{Min: blockPosToProto(min), Max: blockPosToProto(max)},
+		Indices: make([]int32, 0, volume),
+	}
+	paletteIndex := make(map[uint32]int32)
+	s.state.Chunks().Area(min, max, req.GetLayer(), func(pos [3]int32, runtimeID uint32, ok bool) {
+		if !ok {
+			out.Indices = append(out.Indices, -1)
+			return
+		}
+		index, seen := paletteIndex[runtimeID]
+		if !seen {
+			index = int32(len(out.Palette))
+			paletteIndex[runtimeID] = index
+			out.Palette = append(out.Palette, blockInfo(runtimeID))
+		}
+		out.Indices = append(out.Indices, index)
+	})
+	return out, nil
+}
+
+func (s *BlockService) ListenBlockChanges(req *blockpb.ListenBlockChangesRequest, stream blockpb.BlockService_ListenBlockChangesServer) error {
+	var (
+		min, max [3]int32
+		bounded  bool
+	)
+	if req.GetBox() != nil {
+		var err error
+		min, max, err = blockBoxFromProto(req.GetBox())
+		if err != nil {
+			return err
+		}
+		bounded = true
+	}
+	var layers map[uint32]struct{}
+	if len(req.GetLayers()) > 0 {
+		layers = make(map[uint32]struct{}, len(req.GetLayers()))
+		for _, layer := range req.GetLayers() {
+			layers[layer] = struct{}{}
+		}
+	}
+
+	changes, cancel := s.state.Chunks().Changes(1024)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if layers != nil {
+				if _, wanted := layers[change.Layer]; !wanted {
+					continue
+				}
+			}
+			if bounded && !insideBox(change.Position, min, max) {
+				continue
+			}
+			evt := &blockpb.BlockChange{
+				Position:    blockPosToProto(change.Position),
+				Layer:       change.Layer,
+				Block:       blockInfo(change.RuntimeID),
+				TimestampMs: change.Timestamp.UnixMilli(),
+			}
+			if change.PreviousKnown {
+				evt.Previous = blockInfo(change.Previous)
+			}
+			if err := stream.Send(evt); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func blockInfo(runtimeID uint32) *blockpb.BlockInfo {
+	state := app.LookupBlock(runtimeID)
+	info := &blockpb.BlockInfo{
+		RuntimeId: runtimeID,
+		Name:      state.Name,
+		Known:     state.Known,
+	}
+	if len(state.States) > 0 {
+		if data, err := json.Marshal(state.States); err == nil {
+			info.States = string(data)
+		}
+	}
+	return info
+}
+
+func blockPosFromProto(pos *blockpb.BlockPos) [3]int32 {
+	return [3]int32{pos.GetX(), pos.GetY(), pos.GetZ()}
+}
+
+func blockPosToProto(pos [3]int32) *blockpb.BlockPos {
+	return &blockpb.BlockPos{X: pos[0], Y: pos[1], Z: pos[2]}
+}
+
+func blockBoxFromProto(box *blockpb.BlockBox) (min, max [3]int32, err error) {
+	if box.GetMin() == nil || box.GetMax() == nil {
+		return min, max, status.Error(codes.InvalidArgument, "box min and max required")
+	}
+	a, b := blockPosFromProto(box.GetMin()), blockPosFromProto(box.GetMax())
+	for i := 0; i < 3; i++ {
+		min[i], max[i] = a[i], b[i]
+		if min[i] > max[i] {
+			min[i], max[i] = max[i], min[i]
+		}
+	}
+	return min, max, nil
+}
+
+// boxVolume counts the blocks in min..max. Each axis is widened before
+// subtracting, and the count saturates above maxAreaVolume so it cannot
+// overflow.
+func boxVolume(min, max [3]int32) int64 {
+	volume := int64(1)
+	for i := 0; i < 3; i++ {
+		volume *= int64(max[i]) - int64(min[i]) + 1
+		if volume > maxAreaVolume {
+			return maxAreaVolume + 1
+		}
+	}
+	return volume
+}
+
+func insideBox(pos, min, max [3]int32) bool {
+	for i := 0; i < 3; i++ {
+		if pos[i] < min[i] || pos[i] > max[i] {
+			return false
+		}
+	}
+	return true
+}
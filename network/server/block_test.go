@@ -0,0 +1,26 @@
+package server
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBoxVolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max [3]int32
+		want     int64
+	}{
+		{name: "single block", min: [3]int32{5, 5, 5}, max: [3]int32{5, 5, 5}, want: 1},
+		{name: "box", min: [3]int32{-1, 0, 0}, max: [3]int32{1, 3, 4}, want: 3 * 4 * 5},
+		{name: "at the limit", max: [3]int32{255, 255, 0}, want: maxAreaVolume},
+		{name: "edge of the coordinate range", min: [3]int32{math.MaxInt32, 0, 0}, max: [3]int32{math.MaxInt32, 0, 0}, want: 1},
+		{name: "full axis", min: [3]int32{math.MinInt32, 0, 0}, max: [3]int32{math.MaxInt32, 0, 0}, want: maxAreaVolume + 1},
+		{name: "every axis full", min: [3]int32{math.MinInt32, math.MinInt32, math.MinInt32}, max: [3]int32{math.MaxInt32, math.MaxInt32, math.MaxInt32}, want: maxAreaVolume + 1},
+	}
+	for _, tt := range tests {
+		if got := boxVolume(tt.min, tt.max); got != tt.want {
+			t.Errorf("%s: boxVolume = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
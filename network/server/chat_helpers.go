@@ -0,0 +1,200 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/FunShuttler/game_control/resources_control"
+	"github.com/Yeah114/FunShuttler/uqholder"
+	"github.com/Yeah114/tempest-core/network/app"
+	listenerpb "github.com/Yeah114/tempest-core/network_api/listener"
+)
+
+// chatFilter holds the server-side conditions a Text packet must satisfy
+// before it is delivered to a chat stream. Empty sets match everything.
+type chatFilter struct {
+	textTypes   map[uint32]struct{}
+	names       map[string]struct{}
+	uuids       map[string]struct{}
+	rawName     string
+	pattern     *regexp.Regexp
+	excludeSelf bool
+
+	botName string
+	botUUID string
+}
+
+func newChatFilter(req *listenerpb.ListenChatRequest) (*chatFilter, error) {
+	filter := &chatFilter{excludeSelf: req.GetExcludeSelf()}
+	if types := req.GetTextTypes(); len(types) > 0 {
+		filter.textTypes = make(map[uint32]struct{}, len(types))
+		for _, t := range types {
+			filter.textTypes[t] = struct{}{}
+		}
+	}
+	for _, name := range req.GetSenderNames() {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if filter.names == nil {
+			filter.names = make(map[string]struct{})
+		}
+		filter.names[name] = struct{}{}
+	}
+	for _, uuidStr := range req.GetSenderUuids() {
+		uuidStr = strings.ToLower(strings.TrimSpace(uuidStr))
+		if uuidStr == "" {
+			continue
+		}
+		if filter.uuids == nil {
+			filter.uuids = make(map[string]struct{})
+		}
+		filter.uuids[uuidStr] = struct{}{}
+	}
+	if expr := req.GetMessageRegex(); expr != "" {
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message_regex: %w", err)
+		}
+		filter.pattern = pattern
+	}
+	return filter, nil
+}
+
+// matchPacket applies the checks that only need the raw packet so that
+// unwanted messages are dropped inside the packet callback.
+func (f *chatFilter) matchPacket(text *fpacket.Text) bool {
+	if f == nil {
+		return true
+	}
+	if f.rawName != "" && text.SourceName != f.rawName {
+		return false
+	}
+	if f.textTypes != nil {
+		if _, ok := f.textTypes[uint32(text.TextType)]; !ok {
+			return false
+		}
+	}
+	if f.names != nil {
+		if _, ok := f.names[strings.ToLower(uqholder.ToPlainName(text.SourceName))]; !ok {
+			return false
+		}
+	}
+	if f.pattern != nil && !f.pattern.MatchString(text.Message) {
+		return false
+	}
+	return true
+}
+
+// matchEvent applies the checks that depend on the resolved sender.
+func (f *chatFilter) matchEvent(chat *listenerpb.Chat) bool {
+	if f == nil {
+		return true
+	}
+	if f.uuids != nil {
+		if _, ok := f.uuids[strings.ToLower(chat.GetSenderUuid())]; !ok {
+			return false
+		}
+	}
+	if f.excludeSelf {
+		if f.botUUID != "" && strings.EqualFold(chat.GetSenderUuid(), f.botUUID) {
+			return false
+		}
+		if f.botName != "" && chat.GetName() == f.botName {
+			return false
+		}
+	}
+	return true
+}
+
+// buildChatEvent converts a Text packet into a typed chat event, resolving the
+// sender through the UQ holder when the source name belongs to an online player.
+func buildChatEvent(state *app.FatalderState, text *fpacket.Text, at time.Time) (*listenerpb.Chat, error) {
+	name := uqholder.ToPlainName(text.SourceName)
+	chat := &listenerpb.Chat{
+		TextType:       uint32(text.TextType),
+		Name:           name,
+		RawName:        text.SourceName,
+		Message:        text.Message,
+		Parameters:     append([]string(nil), text.Parameters...),
+		Words:          splitWords(text.Message),
+		Xuid:           text.XUID,
+		PlatformChatId: text.PlatformChatID,
+		TimestampMs:    at.UnixMilli(),
+	}
+	if name != "" {
+		if player, err := fetchPlayerByName(state, name); err == nil {
+			if uuidStr, ok := player.GetUUIDString(); ok {
+				chat.SenderUuid = uuidStr
+			}
+			if chat.PlatformChatId == "" {
+				if value, ok := player.GetPlatformChatID(); ok {
+					chat.PlatformChatId = value
+				}
+			}
+		}
+	}
+	payload, err := buildChatPayload(text)
+	if err != nil {
+		return nil, err
+	}
+	chat.Payload = string(payload)
+	return chat, nil
+}
+
+func fetchBotIdentity(state *app.FatalderState) (name, uuidStr string) {
+	_ = state.WithResources(func(res *resources_control.Resources) error {
+		holder := res.UQHolder()
+		if holder == nil {
+			return errors.New("uqholder unavailable")
+		}
+		micro := holder.Micro()
+		if micro == nil {
+			return errors.New("micro uqholder unavailable")
+		}
+		basic := micro.GetBotBasicInfo()
+		name = basic.GetBotName()
+		uuidStr = basic.GetBotUUIDStr()
+		return nil
+	})
+	return name, uuidStr
+}
+
+// chatPayload is the legacy FateArk JSON shape still carried in Chat.payload.
+type chatPayload struct {
+	Name          string   `json:"name"`
+	Msg           []string `json:"msg"`
+	Type          byte     `json:"type"`
+	RawMsg        string   `json:"raw_msg"`
+	RawName       string   `json:"raw_name"`
+	RawParameters []string `json:"raw_parameters"`
+}
+
+func buildChatPayload(text *fpacket.Text) ([]byte, error) {
+	payload := chatPayload{
+		Name:          uqholder.ToPlainName(text.SourceName),
+		Msg:           splitWords(text.Message),
+		Type:          text.TextType,
+		RawMsg:        text.Message,
+		RawName:       text.SourceName,
+		RawParameters: append([]string(nil), text.Parameters...),
+	}
+	return json.Marshal(payload)
+}
+
+func splitWords(s string) []string {
+	fields := strings.Fields(s)
+	result := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field != "" {
+			result = append(result, field)
+		}
+	}
+	return result
+}
@@ -0,0 +1,610 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	"github.com/Yeah114/FunShuttler/game_control/resources_control"
+	"github.com/Yeah114/FunShuttler/uqholder"
+	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
+	"github.com/Yeah114/tempest-core/network/app"
+	chatcommandpb "github.com/Yeah114/tempest-core/network_api/chatcommand"
+	responsepb "github.com/Yeah114/tempest-core/network_api/response"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultChatCommandPrefix = "!"
+	chatHelpCommand          = "help"
+)
+
+type chatCommandEntry struct {
+	owner  int64
+	prefix string
+	spec   *chatcommandpb.ChatCommandSpec
+	usage  string
+}
+
+// ChatCommandService parses prefixed chat messages into typed command
+// invocations and delivers them to the client stream that registered them.
+type ChatCommandService struct {
+	chatcommandpb.UnimplementedChatCommandServiceServer
+	state *app.FatalderState
+	// sender runs the testfor and tellraw commands of permission checks
+	// and replies.
+	sender *CommandService
+
+	nextID atomic.Int64
+
+	mu       sync.RWMutex
+	commands map[string]map[string]*chatCommandEntry
+	owners   map[string][]int64
+	roles    map[string]map[string]struct{}
+}
+
+// NewChatCommandService constructs a chat command service.
+func NewChatCommandService(state *app.FatalderState) *ChatCommandService {
+	return &ChatCommandService{
+		state:    state,
+		sender:   NewCommandService(state),
+		commands: make(map[string]map[string]*chatCommandEntry),
+		owners:   make(map[string][]int64),
+		roles:    make(map[string]map[string]struct{}),
+	}
+}
+
+func (s *ChatCommandService) ServeChatCommands(req *chatcommandpb.ServeChatCommandsRequest, stream chatcommandpb.ChatCommandService_ServeChatCommandsServer) error {
+	prefix := strings.TrimSpace(req.GetPrefix())
+	if prefix == "" {
+		prefix = defaultChatCommandPrefix
+	}
+	if strings.ContainsAny(prefix, " \t") {
+		return status.Error(codes.InvalidArgument, "prefix must not contain whitespace")
+	}
+	if len(req.GetCommands()) == 0 {
+		return status.Error(codes.InvalidArgument, "commands required")
+	}
+
+	id := s.nextID.Add(1)
+	if err := s.register(id, prefix, req.GetCommands()); err != nil {
+		return err
+	}
+	defer s.unregister(id, prefix)
+
+	type chatEvent struct {
+		text *fpacket.Text
+		at   time.Time
+		err  error
+	}
+
+	events := make(chan chatEvent, 256)
+	done := make(chan struct{})
+
+	var listener *resources_control.PacketListener
+	var listenerID string
+
+	if err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
+		listener = iface.PacketListener()
+		if listener == nil {
+			return errors.New("packet listener unavailable")
+		}
+		var err error
+		listenerID, err = listener.ListenPacket([]uint32{fpacket.IDText}, func(pk fpacket.Packet, connErr error) {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if connErr != nil {
+				select {
+				case events <- chatEvent{err: connErr}:
+				default:
+				}
+				return
+			}
+			text, ok := pk.(*fpacket.Text)
+			if !ok || text.TextType != fpacket.TextTypeChat || s.state.Inputs().Claim(text) {
+				return
+			}
+			if !strings.HasPrefix(strings.TrimSpace(text.Message), prefix) {
+				return
+			}
+			select {
+			case events <- chatEvent{text: text, at: time.Now()}:
+			default:
+			}
+		})
+		return err
+	}); err != nil {
+		return toStatusError(err)
+	}
+	defer func() {
+		close(done)
+		if listener != nil && listenerID != "" {
+			listener.DestroyListener(listenerID)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case evt := <-events:
+			if evt.err != nil {
+				return evt.err
+			}
+			if evt.text == nil {
+				continue
+			}
+			invocation := s.handleChat(stream.Context(), id, prefix, evt.text)
+			if invocation == nil {
+				continue
+			}
+			invocation.TimestampMs = evt.at.UnixMilli()
+			if err := stream.Send(invocation); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *ChatCommandService) ListChatCommands(ctx context.Context, req *chatcommandpb.ListChatCommandsRequest) (*chatcommandpb.ListChatCommandsResponse, error) {
+	prefix := strings.TrimSpace(req.GetPrefix())
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := &chatcommandpb.ListChatCommandsResponse{}
+	for p, table := range s.commands {
+		if prefix != "" && p != prefix {
+			continue
+		}
+		for _, entry := range uniqueChatEntries(table) {
+			out.Commands = append(out.Commands, &chatcommandpb.RegisteredChatCommand{
+				Prefix: entry.prefix,
+				Spec:   entry.spec,
+				Usage:  entry.usage,
+			})
+		}
+	}
+	sort.Slice(out.Commands, func(i, j int) bool {
+		if out.Commands[i].GetPrefix() != out.Commands[j].GetPrefix() {
+			return out.Commands[i].GetPrefix() < out.Commands[j].GetPrefix()
+		}
+		return out.Commands[i].GetSpec().GetName() < out.Commands[j].GetSpec().GetName()
+	})
+	return out, nil
+}
+
+func (s *ChatCommandService) SetChatRole(ctx context.Context, req *chatcommandpb.SetChatRoleRequest) (*responsepb.GeneralResponse, error) {
+	role := strings.TrimSpace(req.GetRole())
+	if role == "" {
+		return nil, status.Error(codes.InvalidArgument, "role required")
+	}
+	members := make(map[string]struct{})
+	for _, value := range append(append([]string(nil), req.GetMemberUuids()...), req.GetMemberNames()...) {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" {
+			members[value] = struct{}{}
+		}
+	}
+	s.mu.Lock()
+	if len(members) == 0 {
+		delete(s.roles, role)
+	} else {
+		s.roles[role] = members
+	}
+	s.mu.Unlock()
+	return generalSuccess(""), nil
+}
+
+func (s *ChatCommandService) register(owner int64, prefix string, specs []*chatcommandpb.ChatCommandSpec) error {
+	entries := make(map[string]*chatCommandEntry)
+	for _, spec := range specs {
+		if err := validateChatCommandSpec(spec); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		entry := &chatCommandEntry{
+			owner:  owner,
+			prefix: prefix,
+			spec:   spec,
+			usage:  chatCommandUsage(prefix, spec),
+		}
+		for _, key := range chatCommandKeys(spec) {
+			if _, dup := entries[key]; dup {
+				return status.Errorf(codes.InvalidArgument, "duplicate command name %q", key)
+			}
+			entries[key] = entry
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table := s.commands[prefix]
+	for key := range entries {
+		if _, exists := table[key]; exists {
+			return status.Errorf(codes.AlreadyExists, "command %s%s already registered", prefix, key)
+		}
+	}
+	if table == nil {
+		table = make(map[string]*chatCommandEntry, len(entries))
+		s.commands[prefix] = table
+	}
+	for key, entry := range entries {
+		table[key] = entry
+	}
+	s.owners[prefix] = append(s.owners[prefix], owner)
+	return nil
+}
+
+func (s *ChatCommandService) unregister(owner int64, prefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table := s.commands[prefix]
+	for key, entry := range table {
+		if entry.owner == owner {
+			delete(table, key)
+		}
+	}
+	if len(table) == 0 {
+		delete(s.commands, prefix)
+	}
+	owners := s.owners[prefix][:0]
+	for _, id := range s.owners[prefix] {
+		if id != owner {
+			owners = append(owners, id)
+		}
+	}
+	if len(owners) == 0 {
+		delete(s.owners, prefix)
+	} else {
+		s.owners[prefix] = owners
+	}
+}
+
+// handleChat parses a prefixed chat message. It returns an invocation only when
+// the command belongs to owner; help and usage replies are sent from here.
+func (s *ChatCommandService) handleChat(ctx context.Context, owner int64, prefix string, text *fpacket.Text) *chatcommandpb.ChatCommandInvocation {
+	message := strings.TrimSpace(text.Message)
+	tokens, tokErr := tokenizeChatCommand(strings.TrimPrefix(message, prefix))
+	if tokErr == nil && len(tokens) == 0 {
+		return nil
+	}
+	playerName := uqholder.ToPlainName(text.SourceName)
+
+	var name string
+	if len(tokens) > 0 {
+		name = strings.ToLower(tokens[0])
+	}
+	s.mu.RLock()
+	entry := s.commands[prefix][name]
+	helpOwner := len(s.owners[prefix]) > 0 && s.owners[prefix][0] == owner
+	s.mu.RUnlock()
+
+	if entry == nil {
+		if helpOwner && name == chatHelpCommand {
+			s.replyHelp(ctx, prefix, playerName, tokens[1:])
+		}
+		return nil
+	}
+	if entry.owner != owner {
+		return nil
+	}
+	if tokErr != nil {
+		s.reply(ctx, playerName, fmt.Sprintf("§c%s\n§7Usage: %s", tokErr.Error(), entry.usage))
+		return nil
+	}
+
+	player, err := fetchPlayerByName(s.state, playerName)
+	if err != nil {
+		return nil
+	}
+	allowed, err := s.permitted(ctx, player, playerName, entry.spec.GetPermission())
+	if err != nil {
+		s.reply(ctx, playerName, "§cPermission check failed, try again later")
+		return nil
+	}
+	if !allowed {
+		s.reply(ctx, playerName, fmt.Sprintf("§cYou do not have permission to use %s%s", prefix, entry.spec.GetName()))
+		return nil
+	}
+
+	args, err := s.parseChatArguments(entry.spec.GetArguments(), tokens[1:])
+	if err != nil {
+		s.reply(ctx, playerName, fmt.Sprintf("§c%s\n§7Usage: %s", err.Error(), entry.usage))
+		return nil
+	}
+
+	uuidStr, _ := player.GetUUIDString()
+	return &chatcommandpb.ChatCommandInvocation{
+		Prefix:     prefix,
+		Command:    entry.spec.GetName(),
+		Alias:      tokens[0],
+		Arguments:  args,
+		PlayerUuid: uuidStr,
+		PlayerName: playerName,
+		RawMessage: text.Message,
+	}
+}
+
+func (s *ChatCommandService) permitted(ctx context.Context, player uqdefines.PlayerUQReader, name string, perm *chatcommandpb.CommandPermission) (bool, error) {
+	switch perm.GetKind() {
+	case chatcommandpb.CommandPermission_ANYONE:
+		return true, nil
+	case chatcommandpb.CommandPermission_OP:
+		if level, ok := player.GetCommandPermissions(); ok && level >= byte(fpacket.CommandPermissionLevelAdmin) {
+			return true, nil
+		}
+		return abilityEnabled(player, protocol.AbilityOperatorCommands)
+	case chatcommandpb.CommandPermission_TAG:
+		return playerHasTag(ctx, s.sender, name, perm.GetValue())
+	case chatcommandpb.CommandPermission_ROLE:
+		uuidStr, _ := player.GetUUIDString()
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		members := s.roles[perm.GetValue()]
+		if _, ok := members[strings.ToLower(uuidStr)]; ok && uuidStr != "" {
+			return true, nil
+		}
+		_, ok := members[strings.ToLower(name)]
+		return ok, nil
+	default:
+		return false, nil
+	}
+}
+
+func (s *ChatCommandService) parseChatArguments(specs []*chatcommandpb.ArgumentSpec, tokens []string) ([]*chatcommandpb.ChatArgument, error) {
+	out := make([]*chatcommandpb.ChatArgument, 0, len(specs))
+	for i, spec := range specs {
+		arg := &chatcommandpb.ChatArgument{Name: spec.GetName()}
+		out = append(out, arg)
+		if i >= len(tokens) {
+			if !spec.GetOptional() {
+				return nil, fmt.Errorf("missing argument <%s>", spec.GetName())
+			}
+			continue
+		}
+		raw := tokens[i]
+		switch spec.GetType() {
+		case chatcommandpb.ArgumentSpec_INT:
+			value, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("<%s> must be an integer", spec.GetName())
+			}
+			arg.Value = &chatcommandpb.ChatArgument_IntValue{IntValue: value}
+		case chatcommandpb.ArgumentSpec_FLOAT:
+			value, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, fmt.Errorf("<%s> must be a number", spec.GetName())
+			}
+			arg.Value = &chatcommandpb.ChatArgument_FloatValue{FloatValue: value}
+		case chatcommandpb.ArgumentSpec_BOOL:
+			value, ok := parseChatBool(raw)
+			if !ok {
+				return nil, fmt.Errorf("<%s> must be true or false", spec.GetName())
+			}
+			arg.Value = &chatcommandpb.ChatArgument_BoolValue{BoolValue: value}
+		case chatcommandpb.ArgumentSpec_CHOICE:
+			choice := ""
+			for _, c := range spec.GetChoices() {
+				if strings.EqualFold(c, raw) {
+					choice = c
+					break
+				}
+			}
+			if choice == "" {
+				return nil, fmt.Errorf("<%s> must be one of %s", spec.GetName(), strings.Join(spec.GetChoices(), ", "))
+			}
+			arg.Value = &chatcommandpb.ChatArgument_StringValue{StringValue: choice}
+		case chatcommandpb.ArgumentSpec_PLAYER:
+			target, err := fetchPlayerByName(s.state, raw)
+			if err != nil {
+				return nil, fmt.Errorf("player %s is not online", raw)
+			}
+			canonical, ok := target.GetUsername()
+			if !ok || canonical == "" {
+				canonical = raw
+			}
+			arg.Value = &chatcommandpb.ChatArgument_StringValue{StringValue: canonical}
+		case chatcommandpb.ArgumentSpec_GREEDY:
+			arg.Value = &chatcommandpb.ChatArgument_StringValue{StringValue: strings.Join(tokens[i:], " ")}
+			arg.Present = true
+			return out, nil
+		default:
+			arg.Value = &chatcommandpb.ChatArgument_StringValue{StringValue: raw}
+		}
+		arg.Present = true
+	}
+	if len(tokens) > len(specs) {
+		return nil, errors.New("too many arguments")
+	}
+	return out, nil
+}
+
+func (s *ChatCommandService) replyHelp(ctx context.Context, prefix, playerName string, args []string) {
+	s.mu.RLock()
+	table := s.commands[prefix]
+	if len(args) > 0 {
+		entry := table[strings.ToLower(strings.TrimPrefix(args[0], prefix))]
+		s.mu.RUnlock()
+		if entry == nil {
+			s.reply(ctx, playerName, fmt.Sprintf("§cUnknown command %s%s", prefix, args[0]))
+			return
+		}
+		s.reply(ctx, playerName, formatChatCommandHelp(entry, true))
+		return
+	}
+	entries := uniqueChatEntries(table)
+	s.mu.RUnlock()
+
+	lines := []string{"§eAvailable commands:"}
+	for _, entry := range entries {
+		lines = append(lines, formatChatCommandHelp(entry, false))
+	}
+	s.reply(ctx, playerName, strings.Join(lines, "\n"))
+}
+
+func (s *ChatCommandService) reply(ctx context.Context, playerName, message string) {
+	if playerName == "" {
+		return
+	}
+	_ = tellPlayer(ctx, s.state, playerName, message)
+}
+
+func validateChatCommandSpec(spec *chatcommandpb.ChatCommandSpec) error {
+	if spec == nil {
+		return errors.New("command spec is nil")
+	}
+	for _, key := range chatCommandKeys(spec) {
+		if key == "" || strings.ContainsAny(key, " \t\"") {
+			return fmt.Errorf("invalid command name %q", key)
+		}
+		if key == chatHelpCommand {
+			return fmt.Errorf("command name %q is reserved", key)
+		}
+	}
+	optional := false
+	args := spec.GetArguments()
+	for i, arg := range args {
+		if strings.TrimSpace(arg.GetName()) == "" {
+			return fmt.Errorf("command %s: argument %d has no name", spec.GetName(), i)
+		}
+		if arg.GetOptional() {
+			optional = true
+		} else if optional {
+			return fmt.Errorf("command %s: required argument %s follows an optional one", spec.GetName(), arg.GetName())
+		}
+		if arg.GetType() == chatcommandpb.ArgumentSpec_CHOICE && len(arg.GetChoices()) == 0 {
+			return fmt.Errorf("command %s: choice argument %s has no choices", spec.GetName(), arg.GetName())
+		}
+		if arg.GetType() == chatcommandpb.ArgumentSpec_GREEDY && i != len(args)-1 {
+			return fmt.Errorf("command %s: greedy argument %s must be last", spec.GetName(), arg.GetName())
+		}
+	}
+	return nil
+}
+
+func chatCommandKeys(spec *chatcommandpb.ChatCommandSpec) []string {
+	keys := []string{strings.ToLower(strings.TrimSpace(spec.GetName()))}
+	for _, alias := range spec.GetAliases() {
+		keys = append(keys, strings.ToLower(strings.TrimSpace(alias)))
+	}
+	return keys
+}
+
+func chatCommandUsage(prefix string, spec *chatcommandpb.ChatCommandSpec) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	b.WriteString(spec.GetName())
+	for _, arg := range spec.GetArguments() {
+		label := arg.GetName()
+		switch arg.GetType() {
+		case chatcommandpb.ArgumentSpec_CHOICE:
+			label = strings.Join(arg.GetChoices(), "|")
+		case chatcommandpb.ArgumentSpec_GREEDY:
+			label += "..."
+		}
+		if arg.GetOptional() {
+			fmt.Fprintf(&b, " [%s]", label)
+		} else {
+			fmt.Fprintf(&b, " <%s>", label)
+		}
+	}
+	return b.String()
+}
+
+func formatChatCommandHelp(entry *chatCommandEntry, detailed bool) string {
+	line := "§a" + entry.usage
+	if desc := entry.spec.GetDescription(); desc != "" {
+		line += " §7- " + desc
+	}
+	if !detailed {
+		return line
+	}
+	lines := []string{line}
+	if aliases := entry.spec.GetAliases(); len(aliases) > 0 {
+		lines = append(lines, "§7Aliases: "+strings.Join(aliases, ", "))
+	}
+	for _, arg := range entry.spec.GetArguments() {
+		if desc := arg.GetDescription(); desc != "" {
+			lines = append(lines, fmt.Sprintf("§7  %s: %s", arg.GetName(), desc))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func uniqueChatEntries(table map[string]*chatCommandEntry) []*chatCommandEntry {
+	seen := make(map[*chatCommandEntry]struct{}, len(table))
+	out := make([]*chatCommandEntry, 0, len(table))
+	for _, entry := range table {
+		if _, ok := seen[entry]; ok {
+			continue
+		}
+		seen[entry] = struct{}{}
+		out = append(out, entry)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].spec.GetName() < out[j].spec.GetName()
+	})
+	return out
+}
+
+// tokenizeChatCommand splits a command line on whitespace. Double quotes group
+// words and a backslash escapes the next character inside quotes.
+func tokenizeChatCommand(line string) ([]string, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		inQuote bool
+		escaped bool
+		started bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case inQuote && r == '\\':
+			escaped = true
+		case r == '"':
+			inQuote = !inQuote
+			started = true
+		case !inQuote && (r == ' ' || r == '\t'):
+			if started {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				started = false
+			}
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+	if inQuote || escaped {
+		return tokens, errors.New("unterminated quote")
+	}
+	if started {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+func parseChatBool(raw string) (bool, bool) {
+	switch strings.ToLower(raw) {
+	case "true", "yes", "on", "1":
+		return true, true
+	case "false", "no", "off", "0":
+		return false, true
+	default:
+		return false, false
+	}
+}
@@ -3,18 +3,26 @@ package server
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
+	"strconv"
 	"strings"
+	"time"
 
 	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
 	"github.com/Yeah114/FunShuttler/game_control/game_interface"
 	"github.com/Yeah114/tempest-core/network/app"
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
 	commandpb "github.com/Yeah114/tempest-core/network_api/command"
 	responsepb "github.com/Yeah114/tempest-core/network_api/response"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// defaultCommandResponseTimeout bounds response waits when the request sets
+// no timeout_ms; a shorter gRPC deadline still takes precedence.
+const defaultCommandResponseTimeout = 30 * time.Second
+
 // CommandService implements gRPC command endpoints.
 type CommandService struct {
 	commandpb.UnimplementedCommandServiceServer
@@ -27,100 +35,296 @@ func NewCommandService(state *app.FatalderState) *CommandService {
 }
 
 func (s *CommandService) SendWOCommand(ctx context.Context, req *commandpb.SendWOCommandRequest) (*responsepb.GeneralResponse, error) {
-	err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		return iface.Commands().SendSettingsCommand(strings.TrimSpace(req.GetCmd()), false)
-	})
+	cmd, err := s.checkPolicy(ctx, req.GetCmd())
+	if err != nil {
+		return nil, err
+	}
+	cmds, err := s.pacedCommands(ctx, req.GetPacing())
 	if err != nil {
 		return nil, toStatusError(err)
 	}
+	if err := cmds.SendSettingsCommand(cmd, false); err != nil {
+		return nil, toStatusError(err)
+	}
 	return generalSuccess(""), nil
 }
 
 func (s *CommandService) SendWSCommand(ctx context.Context, req *commandpb.SendWSCommandRequest) (*responsepb.GeneralResponse, error) {
-	err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		return iface.Commands().SendWSCommand(strings.TrimSpace(req.GetCmd()))
-	})
+	cmd, err := s.checkPolicy(ctx, req.GetCmd())
+	if err != nil {
+		return nil, err
+	}
+	cmds, err := s.pacedCommands(ctx, req.GetPacing())
 	if err != nil {
 		return nil, toStatusError(err)
 	}
+	if err := cmds.SendWSCommand(cmd); err != nil {
+		return nil, toStatusError(err)
+	}
 	return generalSuccess(""), nil
 }
 
 func (s *CommandService) SendPlayerCommand(ctx context.Context, req *commandpb.SendPlayerCommandRequest) (*responsepb.GeneralResponse, error) {
-	err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		return iface.Commands().SendPlayerCommand(strings.TrimSpace(req.GetCmd()))
-	})
+	cmd, err := s.checkPolicy(ctx, req.GetCmd())
+	if err != nil {
+		return nil, err
+	}
+	cmds, err := s.pacedCommands(ctx, req.GetPacing())
 	if err != nil {
 		return nil, toStatusError(err)
 	}
+	if err := cmds.SendPlayerCommand(cmd); err != nil {
+		return nil, toStatusError(err)
+	}
 	return generalSuccess(""), nil
 }
 
 func (s *CommandService) SendAICommand(ctx context.Context, req *commandpb.SendAICommandRequest) (*responsepb.GeneralResponse, error) {
-	cmd := buildAIExecute(strings.TrimSpace(req.GetRuntimeId()), strings.TrimSpace(req.GetCmd()))
-	err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		return iface.Commands().SendWSCommand(cmd)
-	})
+	cmd, err := buildAIExecute(strings.TrimSpace(req.GetRuntimeId()), strings.TrimSpace(req.GetCmd()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if cmd, err = s.checkPolicy(ctx, cmd); err != nil {
+		return nil, err
+	}
+	cmds, err := s.pacedCommands(ctx, req.GetPacing())
 	if err != nil {
 		return nil, toStatusError(err)
 	}
+	if err := cmds.SendWSCommand(cmd); err != nil {
+		return nil, toStatusError(err)
+	}
 	return generalSuccess(""), nil
 }
 
-func (s *CommandService) SendWSCommandWithResponse(ctx context.Context, req *commandpb.SendWSCommandWithResponseRequest) (*responsepb.GeneralResponse, error) {
-	var output *fpacket.CommandOutput
-	err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		var err error
-		output, err = iface.Commands().SendWSCommandWithResp(strings.TrimSpace(req.GetCmd()))
-		return err
-	})
+func (s *CommandService) SendWSCommandWithResponse(ctx context.Context, req *commandpb.SendWSCommandWithResponseRequest) (*commandpb.CommandOutputResponse, error) {
+	output, err := s.awaitCommandOutput(ctx, req.GetPacing(), req.GetTimeoutMs(), strings.TrimSpace(req.GetCmd()), (*game_interface.Commands).SendWSCommandWithResp)
 	if err != nil {
 		return nil, toStatusError(err)
 	}
-	payload, err := marshalCommandOutput(output)
+	resp, err := commandOutputSuccess(output)
 	if err != nil {
 		return nil, toStatusError(err)
 	}
-	return generalSuccess(payload), nil
+	return resp, nil
 }
 
-func (s *CommandService) SendPlayerCommandWithResponse(ctx context.Context, req *commandpb.SendPlayerCommandWithResponseRequest) (*responsepb.GeneralResponse, error) {
-	var output *fpacket.CommandOutput
-	err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		var err error
-		output, err = iface.Commands().SendPlayerCommandWithResp(strings.TrimSpace(req.GetCmd()))
-		return err
-	})
+func (s *CommandService) SendPlayerCommandWithResponse(ctx context.Context, req *commandpb.SendPlayerCommandWithResponseRequest) (*commandpb.CommandOutputResponse, error) {
+	output, err := s.awaitCommandOutput(ctx, req.GetPacing(), req.GetTimeoutMs(), strings.TrimSpace(req.GetCmd()), (*game_interface.Commands).SendPlayerCommandWithResp)
 	if err != nil {
 		return nil, toStatusError(err)
 	}
-	payload, err := marshalCommandOutput(output)
+	resp, err := commandOutputSuccess(output)
 	if err != nil {
 		return nil, toStatusError(err)
 	}
-	return generalSuccess(payload), nil
+	return resp, nil
 }
 
-func (s *CommandService) SendAICommandWithResponse(ctx context.Context, req *commandpb.SendAICommandWithResponseRequest) (*responsepb.GeneralResponse, error) {
+func (s *CommandService) SendAICommandWithResponse(ctx context.Context, req *commandpb.SendAICommandWithResponseRequest) (*commandpb.CommandOutputResponse, error) {
 	runtimeID := strings.TrimSpace(req.GetRuntimeId())
 	if runtimeID == "" {
 		return nil, status.Error(codes.InvalidArgument, "runtime_id required")
 	}
-	var output *fpacket.CommandOutput
-	cmd := buildAIExecute(runtimeID, strings.TrimSpace(req.GetCmd()))
-	err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		var err error
-		output, err = iface.Commands().SendWSCommandWithResp(cmd)
-		return err
-	})
+	cmd, err := buildAIExecute(runtimeID, strings.TrimSpace(req.GetCmd()))
 	if err != nil {
 		return nil, toStatusError(err)
 	}
-	payload, err := marshalCommandOutput(output)
+	output, err := s.awaitCommandOutput(ctx, req.GetPacing(), req.GetTimeoutMs(), cmd, (*game_interface.Commands).SendWSCommandWithResp)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, err := commandOutputSuccess(output)
 	if err != nil {
 		return nil, toStatusError(err)
 	}
-	return generalSuccess(payload), nil
+	return resp, nil
+}
+
+// awaitCommandOutput checks cmd against the command policy of the caller and
+// sends it with dispatchCommandOutput. Use it for commands that carry what
+// the client asked for.
+func (s *CommandService) awaitCommandOutput(ctx context.Context, pacing *commandpb.CommandPacing, timeoutMs uint32, cmd string, send func(*game_interface.Commands, string) (*fpacket.CommandOutput, error)) (*fpacket.CommandOutput, error) {
+	cmd, err := s.checkPolicy(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return s.dispatchCommandOutput(ctx, pacing, timeoutMs, cmd, send)
+}
+
+// dispatchCommandOutput queues cmd on the dispatcher without consulting the
+// command policy, runs send without holding the state lock and gives up once
+// ctx or timeoutMs expires. Time spent in the queue counts towards the
+// timeout. The underlying call cannot be cancelled, so it keeps running in
+// the background and its late result is discarded.
+func (s *CommandService) dispatchCommandOutput(ctx context.Context, pacing *commandpb.CommandPacing, timeoutMs uint32, cmd string, send func(*game_interface.Commands, string) (*fpacket.CommandOutput, error)) (*fpacket.CommandOutput, error) {
+	timeout := defaultCommandResponseTimeout
+	if timeoutMs > 0 {
+		timeout = time.Duration(timeoutMs) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	started := time.Now()
+	sender, err := s.pacedCommands(ctx, pacing)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, commandTimeoutError(cmd, false, time.Since(started))
+		}
+		return nil, err
+	}
+
+	type result struct {
+		output *fpacket.CommandOutput
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		output, err := send(sender, cmd)
+		done <- result{output: output, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.output, r.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return nil, status.Error(codes.Canceled, "command response wait cancelled")
+		}
+		return nil, commandTimeoutError(cmd, true, time.Since(started))
+	}
+}
+
+// commandTimeoutError reports a missing command response. dispatched tells
+// the caller whether the command left the dispatcher queue; send gives no
+// signal once the packet is written, so a dispatched command may or may not
+// have run on the server.
+func commandTimeoutError(cmd string, dispatched bool, waited time.Duration) error {
+	msg := "command was not dispatched before the deadline"
+	if dispatched {
+		msg = "command dispatched but no response before the deadline; it may not have run"
+	}
+	st := status.New(codes.DeadlineExceeded, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "COMMAND_RESPONSE_TIMEOUT",
+		Domain: "tempest-core",
+		Metadata: map[string]string{
+			"cmd":        cmd,
+			"dispatched": strconv.FormatBool(dispatched),
+			"waited_ms":  strconv.FormatInt(waited.Milliseconds(), 10),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// commandOutputSuccess wraps output in a typed response. The JSON payload is
+// kept for clients that have not moved to the typed output yet.
+func commandOutputSuccess(output *fpacket.CommandOutput) (*commandpb.CommandOutputResponse, error) {
+	payload, err := marshalCommandOutput(output)
+	if err != nil {
+		return nil, err
+	}
+	return &commandpb.CommandOutputResponse{
+		Status:  commandpb.CommandOutputResponse_SUCCESS,
+		Payload: payload,
+		Output:  commandOutputToProto(output),
+	}, nil
+}
+
+func commandOutputToProto(output *fpacket.CommandOutput) *commandpb.CommandOutput {
+	out := &commandpb.CommandOutput{}
+	if output == nil {
+		return out
+	}
+	out.SuccessCount = output.SuccessCount
+	out.OutputType = commandpb.CommandOutput_Type(output.OutputType)
+	out.DataSet = output.DataSet
+	lines := make([]string, 0, len(output.OutputMessages))
+	for _, msg := range output.OutputMessages {
+		rendered := app.RenderLangKey(msg.Message, msg.Parameters)
+		out.Messages = append(out.Messages, &commandpb.CommandOutputMessage{
+			MessageId:  msg.Message,
+			Success:    msg.Success,
+			Parameters: append([]string(nil), msg.Parameters...),
+			Rendered:   rendered,
+		})
+		lines = append(lines, rendered)
+	}
+	out.Rendered = strings.Join(lines, "\n")
+	return out
+}
+
+func (s *CommandService) GetCommandQueueStats(ctx context.Context, req *commandpb.GetCommandQueueStatsRequest) (*commandpb.GetCommandQueueStatsResponse, error) {
+	stats := s.state.Dispatcher().Stats()
+	out := &commandpb.GetCommandQueueStatsResponse{Config: pacingConfigToProto(stats.Config)}
+	for lane, ls := range stats.Lanes {
+		out.Lanes = append(out.Lanes, &commandpb.CommandLaneStats{
+			Priority:      priorityFromLane(app.CommandLane(lane)),
+			Depth:         uint32(ls.Depth),
+			MaxDepth:      uint32(ls.MaxDepth),
+			Dispatched:    ls.Dispatched,
+			Rejected:      ls.Rejected,
+			Cancelled:     ls.Cancelled,
+			AverageWaitMs: ls.AverageWait.Milliseconds(),
+		})
+	}
+	return out, nil
+}
+
+// ConfigureCommandPacing changes the dispatcher budget for every caller, so
+// it takes the same right as replacing the command policy.
+func (s *CommandService) ConfigureCommandPacing(ctx context.Context, req *commandpb.ConfigureCommandPacingRequest) (*commandpb.CommandPacingConfig, error) {
+	if !s.state.CommandPolicy().CanManage(policyToken(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "caller may not configure command pacing")
+	}
+	cfg := req.GetConfig()
+	if cfg == nil {
+		return nil, status.Error(codes.InvalidArgument, "config required")
+	}
+	applied := s.state.Dispatcher().Configure(app.DispatcherConfig{
+		PerTick:   int(cfg.GetPerTick()),
+		Tick:      time.Duration(cfg.GetTickMs()) * time.Millisecond,
+		QueueSize: int(cfg.GetQueueSize()),
+	})
+	return pacingConfigToProto(applied), nil
+}
+
+// pacedCommands waits for a dispatcher slot in the lane requested by pacing.
+func (s *CommandService) pacedCommands(ctx context.Context, pacing *commandpb.CommandPacing) (*game_interface.Commands, error) {
+	return s.state.PacedCommands(ctx, laneFromPriority(pacing.GetPriority()), pacing.GetFailFast())
+}
+
+func laneFromPriority(priority commandpb.CommandPriority) app.CommandLane {
+	switch priority {
+	case commandpb.CommandPriority_ADMIN:
+		return app.LaneAdmin
+	case commandpb.CommandPriority_COSMETIC:
+		return app.LaneCosmetic
+	default:
+		return app.LaneAutomation
+	}
+}
+
+func priorityFromLane(lane app.CommandLane) commandpb.CommandPriority {
+	switch lane {
+	case app.LaneAdmin:
+		return commandpb.CommandPriority_ADMIN
+	case app.LaneCosmetic:
+		return commandpb.CommandPriority_COSMETIC
+	default:
+		return commandpb.CommandPriority_AUTOMATION
+	}
+}
+
+func pacingConfigToProto(cfg app.DispatcherConfig) *commandpb.CommandPacingConfig {
+	return &commandpb.CommandPacingConfig{
+		PerTick:   uint32(cfg.PerTick),
+		TickMs:    uint32(cfg.Tick.Milliseconds()),
+		QueueSize: uint32(cfg.QueueSize),
+	}
 }
 
 func marshalCommandOutput(output *fpacket.CommandOutput) (string, error) {
@@ -134,12 +338,15 @@ func marshalCommandOutput(output *fpacket.CommandOutput) (string, error) {
 	return string(bs), nil
 }
 
-func buildAIExecute(runtimeID, cmd string) string {
+// buildAIExecute runs cmd as the entity with runtimeID. An empty runtimeID
+// leaves cmd unchanged.
+func buildAIExecute(runtimeID, cmd string) (string, error) {
 	if runtimeID == "" {
-		return cmd
+		return cmd, nil
 	}
-	if cmd == "" {
-		return fmt.Sprintf("execute as @e[runtime_id=%s] run ", runtimeID)
+	id, err := strconv.ParseUint(runtimeID, 10, 64)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid runtime_id %q", runtimeID)
 	}
-	return fmt.Sprintf("execute as @e[runtime_id=%s] at @s run %s", runtimeID, cmd)
+	return cmdbuild.Execute(cmdbuild.EntityByRuntimeID(id), cmd)
 }
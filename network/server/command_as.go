@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	responsepb "github.com/Yeah114/tempest-core/network_api/response"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CommandService) SendCommandAs(ctx context.Context, req *commandpb.SendCommandAsRequest) (*responsepb.GeneralResponse, error) {
+	cmd, err := s.buildCommandAs(req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if cmd, err = s.checkPolicy(ctx, cmd); err != nil {
+		return nil, err
+	}
+	cmds, err := s.pacedCommands(ctx, req.GetPacing())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := cmds.SendWSCommand(cmd); err != nil {
+		return nil, toStatusError(err)
+	}
+	return generalSuccess(""), nil
+}
+
+func (s *CommandService) SendCommandAsWithResponse(ctx context.Context, req *commandpb.SendCommandAsRequest) (*commandpb.CommandOutputResponse, error) {
+	cmd, err := s.buildCommandAs(req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	output, err := s.awaitCommandOutput(ctx, req.GetPacing(), req.GetTimeoutMs(), cmd, (*game_interface.Commands).SendWSCommandWithResp)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp, err := commandOutputSuccess(output)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+// buildCommandAs resolves the request target and wraps the command in an
+// "execute as <target> at @s run" prefix.
+func (s *CommandService) buildCommandAs(req *commandpb.SendCommandAsRequest) (string, error) {
+	cmd := strings.TrimSpace(req.GetCmd())
+	if cmd == "" {
+		return "", status.Error(codes.InvalidArgument, "cmd required")
+	}
+	target, err := s.resolveCommandTarget(req)
+	if err != nil {
+		return "", err
+	}
+	return cmdbuild.Execute(target, cmd)
+}
+
+func (s *CommandService) resolveCommandTarget(req *commandpb.SendCommandAsRequest) (cmdbuild.Selector, error) {
+	switch target := req.GetTarget().(type) {
+	case *commandpb.SendCommandAsRequest_PlayerUuid:
+		player, err := fetchPlayerByUUID(s.state, strings.TrimSpace(target.PlayerUuid))
+		if err != nil {
+			return cmdbuild.Selector{}, err
+		}
+		name, ok := player.GetUsername()
+		if !ok || name == "" {
+			return cmdbuild.Selector{}, status.Error(codes.Internal, "player name unavailable")
+		}
+		return cmdbuild.PlayerNamed(name), nil
+	case *commandpb.SendCommandAsRequest_Username:
+		player, err := fetchPlayerByName(s.state, strings.TrimSpace(target.Username))
+		if err != nil {
+			return cmdbuild.Selector{}, err
+		}
+		name, ok := player.GetUsername()
+		if !ok || name == "" {
+			return cmdbuild.Selector{}, status.Error(codes.Internal, "player name unavailable")
+		}
+		return cmdbuild.PlayerNamed(name), nil
+	case *commandpb.SendCommandAsRequest_EntityUniqueId:
+		return s.selectorForUniqueID(target.EntityUniqueId)
+	case *commandpb.SendCommandAsRequest_Selector:
+		return selectorFromProto(target.Selector), nil
+	default:
+		return cmdbuild.Selector{}, status.Error(codes.InvalidArgument, "target required")
+	}
+}
+
+// selectorForUniqueID looks the unique ID up among online players first and
+// then among tracked entities.
+func (s *CommandService) selectorForUniqueID(uniqueID int64) (cmdbuild.Selector, error) {
+	players, err := s.state.SnapshotPlayers()
+	if err != nil {
+		return cmdbuild.Selector{}, err
+	}
+	for _, player := range players {
+		if id, ok := player.GetEntityUniqueID(); !ok || id != uniqueID {
+			continue
+		}
+		if name, ok := player.GetUsername(); ok && name != "" {
+			return cmdbuild.PlayerNamed(name), nil
+		}
+	}
+	if entity, ok := s.state.Entities().GetByUniqueID(uniqueID); ok {
+		return cmdbuild.EntityByRuntimeID(entity.RuntimeID), nil
+	}
+	return cmdbuild.Selector{}, wrapNotFound("entity not found")
+}
@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxBatchCommands    = 4096
+	maxBatchConcurrency = 16
+)
+
+func (s *CommandService) SendCommandBatch(ctx context.Context, req *commandpb.SendCommandBatchRequest) (*commandpb.SendCommandBatchResponse, error) {
+	started := time.Now()
+	out := &commandpb.SendCommandBatchResponse{
+		Results: make([]*commandpb.BatchCommandResult, 0, len(req.GetCommands())),
+	}
+	err := s.runBatch(ctx, req, func(result *commandpb.BatchCommandResult) error {
+		out.Results = append(out.Results, result)
+		switch result.GetStatus() {
+		case commandpb.BatchCommandResult_SUCCESS:
+			out.Succeeded++
+		case commandpb.BatchCommandResult_FAILED:
+			out.Failed++
+		default:
+			out.Skipped++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out.DurationMs = time.Since(started).Milliseconds()
+	return out, nil
+}
+
+func (s *CommandService) StreamCommandBatch(req *commandpb.SendCommandBatchRequest, stream commandpb.CommandService_StreamCommandBatchServer) error {
+	return s.runBatch(stream.Context(), req, stream.Send)
+}
+
+// runBatch executes req with a bounded worker pool and calls emit once per
+// command in request order. Commands that never started because of
+// stop_on_error or cancellation are reported as SKIPPED.
+func (s *CommandService) runBatch(ctx context.Context, req *commandpb.SendCommandBatchRequest, emit func(*commandpb.BatchCommandResult) error) error {
+	commands := req.GetCommands()
+	if len(commands) == 0 {
+		return status.Error(codes.InvalidArgument, "commands required")
+	}
+	if len(commands) > maxBatchCommands {
+		return status.Errorf(codes.InvalidArgument, "batch of %d commands exceeds limit %d", len(commands), maxBatchCommands)
+	}
+	lines, err := s.resolveBatch(ctx, commands)
+	if err != nil {
+		return err
+	}
+	concurrency := int(req.GetConcurrency())
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	if concurrency > maxBatchConcurrency {
+		concurrency = maxBatchConcurrency
+	}
+	if concurrency > len(commands) {
+		concurrency = len(commands)
+	}
+
+	if _, err := s.state.Commands(); err != nil {
+		return toStatusError(err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		stopped atomic.Bool
+		wg      sync.WaitGroup
+		jobs    = make(chan int)
+		done    = make(chan int, len(commands))
+		results = make([]*commandpb.BatchCommandResult, len(commands))
+	)
+	go func() {
+		defer close(jobs)
+		for i := range commands {
+			if stopped.Load() {
+				return
+			}
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				result := s.runBatchCommand(ctx, req, commands[i].GetMode(), lines[i])
+				result.Index = uint32(i)
+				if result.GetStatus() == commandpb.BatchCommandResult_FAILED && req.GetStopOnError() {
+					stopped.Store(true)
+				}
+				results[i] = result
+				done <- i
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	next := 0
+	flush := func() error {
+		for next < len(results) && results[next] != nil {
+			if err := emit(results[next]); err != nil {
+				return err
+			}
+			next++
+		}
+		return nil
+	}
+	for range done {
+		if err := flush(); err != nil {
+			cancel()
+			return err
+		}
+	}
+	reason := "not started"
+	if stopped.Load() {
+		reason = "skipped after earlier failure"
+	} else if ctx.Err() != nil {
+		reason = ctx.Err().Error()
+	}
+	for i := next; i < len(results); i++ {
+		if results[i] == nil {
+			results[i] = &commandpb.BatchCommandResult{
+				Index:    uint32(i),
+				Cmd:      lines[i],
+				Status:   commandpb.BatchCommandResult_SKIPPED,
+				ErrorMsg: reason,
+			}
+		}
+	}
+	return flush()
+}
+
+// resolveBatch builds the line sent for each command and applies the command
+// policy to all of them, so a violation rejects the batch before anything has
+// run.
+func (s *CommandService) resolveBatch(ctx context.Context, commands []*commandpb.BatchCommand) ([]string, error) {
+	lines := make([]string, len(commands))
+	for i, cmd := range commands {
+		line := strings.TrimSpace(cmd.GetCmd())
+		if line == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "command %d is empty", i)
+		}
+		if cmd.GetMode() == commandpb.CommandMode_AI {
+			runtimeID := strings.TrimSpace(cmd.GetRuntimeId())
+			if runtimeID == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "command %d: runtime_id required for AI mode", i)
+			}
+			var err error
+			if line, err = buildAIExecute(runtimeID, line); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "command %d: %v", i, status.Convert(err).Message())
+			}
+		}
+		checked, err := s.checkPolicy(ctx, line)
+		if err != nil {
+			st := status.Convert(err)
+			return nil, status.Errorf(st.Code(), "command %d: %s", i, st.Message())
+		}
+		lines[i] = checked
+	}
+	return lines, nil
+}
+
+// runBatchCommand sends one command that resolveBatch already checked
+// against the policy. It is paced and bounded by the request's timeout like
+// a single command, so an unanswered command fails instead of holding its
+// worker.
+func (s *CommandService) runBatchCommand(ctx context.Context, req *commandpb.SendCommandBatchRequest, mode commandpb.CommandMode, line string) *commandpb.BatchCommandResult {
+	result := &commandpb.BatchCommandResult{Cmd: line}
+	started := time.Now()
+	output, err := s.dispatchCommandOutput(ctx, req.GetPacing(), req.GetTimeoutMs(), line, func(sender *game_interface.Commands, cmd string) (*fpacket.CommandOutput, error) {
+		return sendBatchCommand(sender, mode, cmd, req.GetWantOutput())
+	})
+	result.StartedAtMs = started.UnixMilli()
+	result.DurationMs = time.Since(started).Milliseconds()
+	if err != nil {
+		result.Status = commandpb.BatchCommandResult_FAILED
+		if ctx.Err() != nil {
+			result.Status = commandpb.BatchCommandResult_SKIPPED
+		}
+		result.ErrorMsg = status.Convert(err).Message()
+		return result
+	}
+	if output != nil {
+		result.SuccessCount = output.SuccessCount
+		result.CommandOutput = commandOutputToProto(output)
+		if payload, err := marshalCommandOutput(output); err == nil {
+			result.Output = payload
+		}
+		if output.SuccessCount == 0 {
+			result.Status = commandpb.BatchCommandResult_FAILED
+			result.ErrorMsg = "command reported no successes"
+		}
+	}
+	return result
+}
+
+// sendBatchCommand dispatches one command. AI commands arrive already
+// wrapped in their execute prefix. Output is only collected when wantOutput
+// is set; WO commands never produce output.
+func sendBatchCommand(sender *game_interface.Commands, mode commandpb.CommandMode, cmd string, wantOutput bool) (*fpacket.CommandOutput, error) {
+	switch mode {
+	case commandpb.CommandMode_WS, commandpb.CommandMode_AI:
+		if wantOutput {
+			return sender.SendWSCommandWithResp(cmd)
+		}
+		return nil, sender.SendWSCommand(cmd)
+	case commandpb.CommandMode_WO:
+		return nil, sender.SendSettingsCommand(cmd, false)
+	case commandpb.CommandMode_PLAYER:
+		if wantOutput {
+			return sender.SendPlayerCommandWithResp(cmd)
+		}
+		return nil, sender.SendPlayerCommand(cmd)
+	default:
+		return nil, fmt.Errorf("unknown command mode %d", mode)
+	}
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CommandService) BuildCommandFragment(ctx context.Context, req *commandpb.BuildCommandFragmentRequest) (*commandpb.BuildCommandFragmentResponse, error) {
+	var (
+		fragment string
+		err      error
+	)
+	switch f := req.GetFragment().(type) {
+	case *commandpb.BuildCommandFragmentRequest_Quoted:
+		fragment, err = cmdbuild.Quote(f.Quoted)
+	case *commandpb.BuildCommandFragmentRequest_Selector:
+		fragment, err = selectorFromProto(f.Selector).String()
+	case *commandpb.BuildCommandFragmentRequest_Rawtext:
+		fragment, err = cmdbuild.RawText(rawTextFromProto(f.Rawtext.GetComponents())...)
+	case *commandpb.BuildCommandFragmentRequest_Position:
+		fragment, err = positionFromProto(f.Position).String()
+	case *commandpb.BuildCommandFragmentRequest_Execute:
+		if f.Execute.GetAs() == nil {
+			return nil, status.Error(codes.InvalidArgument, "execute.as required")
+		}
+		fragment, err = cmdbuild.Execute(selectorFromProto(f.Execute.GetAs()), f.Execute.GetCmd())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "fragment required")
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &commandpb.BuildCommandFragmentResponse{Fragment: fragment}, nil
+}
+
+var selectorTargets = map[commandpb.SelectorSpec_Target]cmdbuild.Target{
+	commandpb.SelectorSpec_ALL_PLAYERS:    cmdbuild.AllPlayers,
+	commandpb.SelectorSpec_ENTITIES:       cmdbuild.Entities,
+	commandpb.SelectorSpec_NEAREST_PLAYER: cmdbuild.NearestPlayer,
+	commandpb.SelectorSpec_RANDOM_PLAYER:  cmdbuild.RandomPlayer,
+	commandpb.SelectorSpec_SELF:           cmdbuild.Self,
+	commandpb.SelectorSpec_INITIATOR:      cmdbuild.Initiator,
+}
+
+func selectorFromProto(in *commandpb.SelectorSpec) cmdbuild.Selector {
+	out := cmdbuild.Selector{
+		Target:       selectorTargets[in.GetTarget()],
+		Name:         in.GetName(),
+		ExcludeNames: in.GetExcludeNames(),
+		Tags:         in.GetTags(),
+		ExcludeTags:  in.GetExcludeTags(),
+		Type:         in.GetType(),
+		ExcludeTypes: in.GetExcludeTypes(),
+		RuntimeID:    in.RuntimeId,
+		Radius:       in.Radius,
+		MinRadius:    in.MinRadius,
+	}
+	if in.Count != nil {
+		count := int(*in.Count)
+		out.Count = &count
+	}
+	if in.GetOrigin() != nil {
+		origin := positionFromProto(in.GetOrigin())
+		out.Origin = &origin
+	}
+	if len(in.GetScores()) > 0 {
+		out.Scores = make(map[string]cmdbuild.ScoreRange, len(in.GetScores()))
+		for objective, r := range in.GetScores() {
+			out.Scores[objective] = cmdbuild.ScoreRange{Min: r.Min, Max: r.Max}
+		}
+	}
+	return out
+}
+
+func positionFromProto(in *commandpb.Position) cmdbuild.Position {
+	return cmdbuild.Position{
+		X: coordFromProto(in.GetX()),
+		Y: coordFromProto(in.GetY()),
+		Z: coordFromProto(in.GetZ()),
+	}
+}
+
+func coordFromProto(in *commandpb.Coordinate) cmdbuild.Coord {
+	out := cmdbuild.Coord{Value: in.GetValue()}
+	switch in.GetMode() {
+	case commandpb.Coordinate_RELATIVE:
+		out.Mode = cmdbuild.Relative
+	case commandpb.Coordinate_LOCAL:
+		out.Mode = cmdbuild.Local
+	}
+	return out
+}
+
+func rawTextFromProto(in []*commandpb.RawTextComponent) []cmdbuild.Component {
+	out := make([]cmdbuild.Component, 0, len(in))
+	for _, c := range in {
+		switch part := c.GetPart().(type) {
+		case *commandpb.RawTextComponent_Translate_:
+			out = append(out, cmdbuild.Component{
+				Translate: part.Translate.GetKey(),
+				With:      rawTextFromProto(part.Translate.GetWith()),
+			})
+		case *commandpb.RawTextComponent_Selector:
+			sel := selectorFromProto(part.Selector)
+			out = append(out, cmdbuild.Component{Selector: &sel})
+		case *commandpb.RawTextComponent_Score_:
+			out = append(out, cmdbuild.Component{Score: &cmdbuild.ScoreComponent{
+				Name:      part.Score.GetName(),
+				Objective: part.Score.GetObjective(),
+			}})
+		default:
+			out = append(out, cmdbuild.Text(c.GetText()))
+		}
+	}
+	return out
+}
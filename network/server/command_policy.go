@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Yeah114/tempest-core/network/app/cmdpolicy"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// policyTokenHeader carries the token that selects a policy role override.
+const policyTokenHeader = "x-tempest-token"
+
+// policyRoleKey marks contexts of work that runs on behalf of a role rather
+// than a caller, such as scheduled jobs.
+type policyRoleKey struct{}
+
+func withPolicyRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, policyRoleKey{}, role)
+}
+
+func (s *CommandService) GetCommandPolicy(ctx context.Context, req *commandpb.GetCommandPolicyRequest) (*commandpb.CommandPolicy, error) {
+	return policyToProto(s.state.CommandPolicy().Policy()), nil
+}
+
+func (s *CommandService) SetCommandPolicy(ctx context.Context, req *commandpb.SetCommandPolicyRequest) (*commandpb.CommandPolicy, error) {
+	if req.GetPolicy() == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy required")
+	}
+	engine := s.state.CommandPolicy()
+	if !engine.CanManage(policyToken(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "caller may not manage the command policy")
+	}
+	if err := engine.Set(policyFromProto(req.GetPolicy())); err != nil {
+		if errors.Is(err, cmdpolicy.ErrInvalidPolicy) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, toStatusError(err)
+	}
+	return policyToProto(engine.Policy()), nil
+}
+
+func (s *CommandService) CheckCommand(ctx context.Context, req *commandpb.CheckCommandRequest) (*commandpb.CheckCommandResponse, error) {
+	d := s.state.CommandPolicy().Evaluate(req.GetCmd(), policyToken(ctx))
+	return &commandpb.CheckCommandResponse{
+		Allowed: d.Allowed,
+		Command: d.Command,
+		Rule:    d.Rule,
+		Role:    d.Role,
+	}, nil
+}
+
+// checkPolicy applies the command policy for the caller and returns the
+// command that should be sent in place of cmd.
+func (s *CommandService) checkPolicy(ctx context.Context, cmd string) (string, error) {
+	var d cmdpolicy.Decision
+	if role, ok := ctx.Value(policyRoleKey{}).(string); ok {
+		d = s.state.CommandPolicy().EvaluateRole(cmd, role)
+	} else {
+		d = s.state.CommandPolicy().Evaluate(cmd, policyToken(ctx))
+	}
+	if !d.Allowed {
+		return "", policyDenied(d)
+	}
+	return d.Command, nil
+}
+
+// policyRole returns the role name of the caller, or an empty string.
+func (s *CommandService) policyRole(ctx context.Context) string {
+	role, _ := s.state.CommandPolicy().Role(policyToken(ctx))
+	return role.Role
+}
+
+func policyToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(policyTokenHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func policyDenied(d cmdpolicy.Decision) error {
+	rule := d.Rule
+	msg := fmt.Sprintf("command blocked by policy rule %q", rule)
+	if rule == "" {
+		rule = "default"
+		msg = "command blocked by the default policy"
+	}
+	st := status.New(codes.PermissionDenied, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "COMMAND_POLICY_DENIED",
+		Domain: "tempest-core",
+		Metadata: map[string]string{
+			"rule": rule,
+			"role": d.Role,
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// policyToProto converts p for clients. Role tokens are never echoed back.
+func policyToProto(p cmdpolicy.Policy) *commandpb.CommandPolicy {
+	out := &commandpb.CommandPolicy{
+		Rules:       rulesToProto(p.Rules),
+		DefaultDeny: p.DefaultDeny,
+	}
+	for _, o := range p.Overrides {
+		out.Overrides = append(out.Overrides, &commandpb.PolicyRoleOverride{
+			Role:              o.Role,
+			Rules:             rulesToProto(o.Rules),
+			DefaultDeny:       o.DefaultDeny,
+			ManagePolicy:      o.ManagePolicy,
+			ManagePermissions: o.ManagePermissions,
+		})
+	}
+	return out
+}
+
+func policyFromProto(in *commandpb.CommandPolicy) cmdpolicy.Policy {
+	out := cmdpolicy.Policy{
+		Rules:       rulesFromProto(in.GetRules()),
+		DefaultDeny: in.GetDefaultDeny(),
+	}
+	for _, o := range in.GetOverrides() {
+		out.Overrides = append(out.Overrides, cmdpolicy.Override{
+			Role:              o.GetRole(),
+			Token:             o.GetToken(),
+			Rules:             rulesFromProto(o.GetRules()),
+			DefaultDeny:       o.GetDefaultDeny(),
+			ManagePolicy:      o.GetManagePolicy(),
+			ManagePermissions: o.GetManagePermissions(),
+		})
+	}
+	return out
+}
+
+func rulesToProto(rules []cmdpolicy.Rule) []*commandpb.PolicyRule {
+	out := make([]*commandpb.PolicyRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, &commandpb.PolicyRule{
+			Name:               r.Name,
+			Pattern:            r.Pattern,
+			Action:             commandpb.PolicyRule_Action(r.Action),
+			Replacement:        r.Replacement,
+			UnboundedSelectors: r.UnboundedSelectors,
+		})
+	}
+	return out
+}
+
+func rulesFromProto(rules []*commandpb.PolicyRule) []cmdpolicy.Rule {
+	out := make([]cmdpolicy.Rule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, cmdpolicy.Rule{
+			Name:               r.GetName(),
+			Pattern:            r.GetPattern(),
+			Action:             cmdpolicy.Action(r.GetAction()),
+			Replacement:        r.GetReplacement(),
+			UnboundedSelectors: r.GetUnboundedSelectors(),
+		})
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+
+	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	"github.com/Yeah114/tempest-core/network/app"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// queryCommand sends cmd on the automation lane and waits for its output.
+// Typed services use it to read game state through command output. cmd is
+// built by the server, so the caller's command policy does not apply.
+func (s *CommandService) queryCommand(ctx context.Context, cmd string) (*fpacket.CommandOutput, error) {
+	return s.dispatchCommandOutput(ctx, nil, 0, cmd, (*game_interface.Commands).SendWSCommandWithResp)
+}
+
+// requestCommand is queryCommand for commands that carry out a caller's
+// request, such as setting a score; they are checked against the caller's
+// command policy.
+func (s *CommandService) requestCommand(ctx context.Context, cmd string) (*fpacket.CommandOutput, error) {
+	return s.awaitCommandOutput(ctx, nil, 0, cmd, (*game_interface.Commands).SendWSCommandWithResp)
+}
+
+// outputError reports a command that ran without succeeding, using its
+// first rendered message as the error text.
+func outputError(output *fpacket.CommandOutput) error {
+	if output == nil {
+		return status.Error(codes.Internal, "command returned no output")
+	}
+	if output.SuccessCount > 0 {
+		return nil
+	}
+	msg := "command reported no successes"
+	if len(output.OutputMessages) > 0 {
+		m := output.OutputMessages[0]
+		msg = app.RenderLangKey(m.Message, m.Parameters)
+	}
+	return status.Error(codes.FailedPrecondition, msg)
+}
+
+// outputMessages returns the messages of output whose key is key.
+func outputMessages(output *fpacket.CommandOutput, key string) [][]string {
+	var out [][]string
+	for _, m := range output.OutputMessages {
+		if m.Message == key || m.Message == "%"+key {
+			out = append(out, m.Parameters)
+		}
+	}
+	return out
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	"github.com/Yeah114/tempest-core/network/app/script"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxScriptBytes = 256 << 10
+
+// RunScript parses and runs a command script, streaming one event per
+// executed line and a final DONE event with the totals. Syntax errors reject
+// the script before anything is sent; runtime errors end the stream.
+func (s *CommandService) RunScript(req *commandpb.RunScriptRequest, stream commandpb.CommandService_RunScriptServer) error {
+	if len(req.GetScript()) > maxScriptBytes {
+		return status.Errorf(codes.InvalidArgument, "script of %d bytes exceeds limit %d", len(req.GetScript()), maxScriptBytes)
+	}
+	parsed, err := script.Parse(req.GetScript())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.state.Commands(); err != nil {
+		return toStatusError(err)
+	}
+	env := &scriptEnv{commands: s, pacing: req.GetPacing(), timeoutMs: req.GetTimeoutMs()}
+	opts := script.Options{StopOnError: req.GetStopOnError(), MaxCommands: int(req.GetMaxCommands())}
+	summary, err := parsed.Run(stream.Context(), env, req.GetVariables(), opts, func(ev script.Event) error {
+		return stream.Send(scriptEventToProto(ev))
+	})
+	switch {
+	case errors.Is(err, script.ErrRuntime):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case err != nil:
+		return toStatusError(err)
+	}
+	return stream.Send(&commandpb.ScriptEvent{
+		Kind:     commandpb.ScriptEvent_DONE,
+		Success:  summary.Failed == 0,
+		Commands: uint32(summary.Commands),
+		Failed:   uint32(summary.Failed),
+		Stopped:  summary.Stopped,
+	})
+}
+
+// scriptEnv runs script commands over WS with the request's pacing, so the
+// command policy applies to every expanded line.
+type scriptEnv struct {
+	commands  *CommandService
+	pacing    *commandpb.CommandPacing
+	timeoutMs uint32
+}
+
+func (e *scriptEnv) Command(ctx context.Context, cmd string) (script.Result, error) {
+	output, err := e.commands.awaitCommandOutput(ctx, e.pacing, e.timeoutMs, cmd, (*game_interface.Commands).SendWSCommandWithResp)
+	if err != nil {
+		return script.Result{}, errors.New(status.Convert(err).Message())
+	}
+	if output == nil {
+		return script.Result{}, nil
+	}
+	return script.Result{SuccessCount: output.SuccessCount, Output: commandOutputToProto(output).GetRendered()}, nil
+}
+
+func (e *scriptEnv) Players(ctx context.Context) ([]string, error) {
+	players, err := e.commands.state.SnapshotPlayers()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(players))
+	for _, player := range players {
+		if name, ok := player.GetUsername(); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func scriptEventToProto(ev script.Event) *commandpb.ScriptEvent {
+	out := &commandpb.ScriptEvent{
+		Line:         uint32(ev.Line),
+		Text:         ev.Text,
+		Success:      ev.Success,
+		SuccessCount: ev.SuccessCount,
+		Output:       ev.Output,
+		ErrorMsg:     ev.Err,
+		DurationMs:   ev.Duration.Milliseconds(),
+	}
+	switch ev.Kind {
+	case script.EventSet:
+		out.Kind = commandpb.ScriptEvent_SET
+	case script.EventWait:
+		out.Kind = commandpb.ScriptEvent_WAIT
+	case script.EventLoop:
+		out.Kind = commandpb.ScriptEvent_LOOP
+	default:
+		out.Kind = commandpb.ScriptEvent_COMMAND
+	}
+	return out
+}
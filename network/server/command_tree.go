@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Yeah114/tempest-core/network/app"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxCommandCompletions caps CompleteCommand when the caller sets no limit.
+const maxCommandCompletions = 50
+
+func (s *CommandService) GetCommandTree(ctx context.Context, req *commandpb.GetCommandTreeRequest) (*commandpb.GetCommandTreeResponse, error) {
+	tree, err := s.commandTree()
+	if err != nil {
+		return nil, err
+	}
+	resp := &commandpb.GetCommandTreeResponse{}
+	if name := req.GetName(); name != "" {
+		spec, ok := tree.Lookup(name)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "unknown command %q", name)
+		}
+		resp.Commands = append(resp.Commands, commandSpecToProto(spec))
+		return resp, nil
+	}
+	for _, spec := range tree.Commands() {
+		resp.Commands = append(resp.Commands, commandSpecToProto(spec))
+	}
+	return resp, nil
+}
+
+func (s *CommandService) ValidateCommand(ctx context.Context, req *commandpb.ValidateCommandRequest) (*commandpb.ValidateCommandResponse, error) {
+	tree, err := s.commandTree()
+	if err != nil {
+		return nil, err
+	}
+	v := app.ValidateCommand(tree, req.GetCmd())
+	return &commandpb.ValidateCommandResponse{
+		Valid:       v.Valid,
+		Command:     v.Command,
+		Overload:    int32(v.Overload),
+		ErrorOffset: int32(v.ErrorOffset),
+		Message:     v.Message,
+		Expected:    v.Expected,
+	}, nil
+}
+
+func (s *CommandService) CompleteCommand(ctx context.Context, req *commandpb.CompleteCommandRequest) (*commandpb.CompleteCommandResponse, error) {
+	tree, err := s.commandTree()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	if players, err := s.state.SnapshotPlayers(); err == nil {
+		for _, player := range players {
+			if name, ok := player.GetUsername(); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = maxCommandCompletions
+	}
+	// An omitted cursor must not read as offset 0, which completes an
+	// empty line.
+	cursor := len(req.GetCmd())
+	if req.Cursor != nil {
+		cursor = int(req.GetCursor())
+	}
+	resp := &commandpb.CompleteCommandResponse{}
+	for _, c := range app.CompleteCommand(tree, req.GetCmd(), cursor, names) {
+		if len(resp.Completions) >= limit {
+			break
+		}
+		resp.Completions = append(resp.Completions, &commandpb.CommandCompletion{
+			Text:        c.Text,
+			Detail:      c.Detail,
+			ReplaceFrom: int32(c.ReplaceFrom),
+		})
+	}
+	return resp, nil
+}
+
+func (s *CommandService) commandTree() (*app.CommandTree, error) {
+	tree := s.state.CommandTree()
+	if tree == nil {
+		return nil, status.Error(codes.FailedPrecondition, "command tree not received yet")
+	}
+	return tree, nil
+}
+
+func commandSpecToProto(spec *app.CommandSpec) *commandpb.CommandSpec {
+	out := &commandpb.CommandSpec{
+		Name:            spec.Name,
+		Description:     spec.Description,
+		Aliases:         spec.Aliases,
+		PermissionLevel: uint32(spec.PermissionLevel),
+	}
+	for _, overload := range spec.Overloads {
+		params := make([]*commandpb.CommandParameter, 0, len(overload))
+		for _, p := range overload {
+			params = append(params, &commandpb.CommandParameter{
+				Name:       p.Name,
+				Kind:       p.Kind.String(),
+				Optional:   p.Optional,
+				EnumName:   p.EnumName,
+				EnumValues: p.EnumValues,
+				Suffix:     p.Suffix,
+			})
+		}
+		out.Overloads = append(out.Overloads, &commandpb.CommandOverload{Parameters: params})
+	}
+	return out
+}
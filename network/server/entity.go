@@ -0,0 +1,193 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/Yeah114/FunShuttler/game_control/resources_control"
+	"github.com/Yeah114/tempest-core/network/app"
+	entitypb "github.com/Yeah114/tempest-core/network_api/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EntityService exposes the entity tracker over gRPC.
+type EntityService struct {
+	entitypb.UnimplementedEntityServiceServer
+	state    *app.FatalderState
+	commands *CommandService
+}
+
+// NewEntityService constructs an entity service.
+func NewEntityService(state *app.FatalderState) *EntityService {
+	return &EntityService{state: state, commands: NewCommandService(state)}
+}
+
+func (s *EntityService) QueryEntities(ctx context.Context, req *entitypb.QueryEntitiesRequest) (*entitypb.QueryEntitiesResponse, error) {
+	filter, err := s.entityFilter(req.GetFilter())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	entities := s.state.Entities().Query(filter)
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].RuntimeID < entities[j].RuntimeID
+	})
+	if limit := int(req.GetLimit()); limit > 0 && len(entities) > limit {
+		entities = entities[:limit]
+	}
+	out := &entitypb.QueryEntitiesResponse{Entities: make([]*entitypb.Entity, 0, len(entities))}
+	for _, entity := range entities {
+		out.Entities = append(out.Entities, entityToProto(entity))
+	}
+	return out, nil
+}
+
+func (s *EntityService) GetEntity(ctx context.Context, req *entitypb.GetEntityRequest) (*entitypb.Entity, error) {
+	var (
+		entity app.TrackedEntity
+		ok     bool
+	)
+	switch id := req.GetId().(type) {
+	case *entitypb.GetEntityRequest_UniqueId:
+		entity, ok = s.state.Entities().GetByUniqueID(id.UniqueId)
+	case *entitypb.GetEntityRequest_RuntimeId:
+		entity, ok = s.state.Entities().Get(id.RuntimeId)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unique_id or runtime_id required")
+	}
+	if !ok {
+		return nil, toStatusError(wrapNotFound("entity not found"))
+	}
+	return entityToProto(entity), nil
+}
+
+func (s *EntityService) ListenEntities(req *entitypb.ListenEntitiesRequest, stream entitypb.EntityService_ListenEntitiesServer) error {
+	filter, err := s.entityFilter(req.GetFilter())
+	if err != nil {
+		return toStatusError(err)
+	}
+	var kinds map[entitypb.EntityEvent_Type]struct{}
+	if len(req.GetEvents()) > 0 {
+		kinds = make(map[entitypb.EntityEvent_Type]struct{}, len(req.GetEvents()))
+		for _, kind := range req.GetEvents() {
+			kinds[kind] = struct{}{}
+		}
+	}
+
+	events, cancel := s.state.Entities().Events(1024)
+	defer cancel()
+
+	if req.GetIncludeExisting() {
+		for _, entity := range s.state.Entities().Query(filter) {
+			if err := stream.Send(&entitypb.EntityEvent{
+				Type:        entitypb.EntityEvent_SPAWN,
+				Entity:      entityToProto(entity),
+				TimestampMs: entity.SpawnedAt.UnixMilli(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case evt, ok := <-events:
+			if !ok {
+				return nil
+			}
+			kind := entityEventType(evt.Type)
+			if kinds != nil {
+				if _, wanted := kinds[kind]; !wanted {
+					continue
+				}
+			}
+			if !filter.Match(evt.Entity) {
+				continue
+			}
+			if err := stream.Send(&entitypb.EntityEvent{
+				Type:        kind,
+				Entity:      entityToProto(evt.Entity),
+				TimestampMs: evt.Timestamp.UnixMilli(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *EntityService) entityFilter(in *entitypb.EntityFilter) (app.EntityFilter, error) {
+	filter := app.EntityFilter{
+		Types:  in.GetTypes(),
+		Radius: in.GetRadius(),
+	}
+	if filter.Radius < 0 {
+		return filter, status.Error(codes.InvalidArgument, "radius must not be negative")
+	}
+	if in.GetAroundBot() {
+		pos, err := fetchBotPosition(s.state)
+		if err != nil {
+			return filter, err
+		}
+		filter.Center = pos
+	} else if c := in.GetCenter(); c != nil {
+		filter.Center = [3]float32{c.GetX(), c.GetY(), c.GetZ()}
+	}
+	return filter, nil
+}
+
+func fetchBotPosition(state *app.FatalderState) ([3]float32, error) {
+	var pos [3]float32
+	err := state.WithResources(func(res *resources_control.Resources) error {
+		holder := res.UQHolder()
+		if holder == nil {
+			return errors.New("uqholder unavailable")
+		}
+		micro := holder.Micro()
+		if micro == nil {
+			return errors.New("micro uqholder unavailable")
+		}
+		extend := micro.GetExtendInfo()
+		if extend == nil {
+			return errors.New("extend info unavailable")
+		}
+		botPos, _ := extend.GetBotPosition()
+		pos = [3]float32{botPos[0], botPos[1], botPos[2]}
+		return nil
+	})
+	return pos, err
+}
+
+func entityToProto(entity app.TrackedEntity) *entitypb.Entity {
+	out := &entitypb.Entity{
+		UniqueId:    entity.UniqueID,
+		RuntimeId:   entity.RuntimeID,
+		Type:        entity.Type,
+		Position:    &entitypb.Vec3{X: entity.Position[0], Y: entity.Position[1], Z: entity.Position[2]},
+		Rotation:    &entitypb.Vec3{X: entity.Rotation[0], Y: entity.Rotation[1], Z: entity.Rotation[2]},
+		SpawnedAtMs: entity.SpawnedAt.UnixMilli(),
+		UpdatedAtMs: entity.UpdatedAt.UnixMilli(),
+	}
+	if len(entity.Metadata) > 0 {
+		if data, err := json.Marshal(entity.Metadata); err == nil {
+			out.Metadata = string(data)
+		}
+	}
+	return out
+}
+
+func entityEventType(kind app.EntityEventType) entitypb.EntityEvent_Type {
+	switch kind {
+	case app.EntityDespawned:
+		return entitypb.EntityEvent_DESPAWN
+	case app.EntityMoved:
+		return entitypb.EntityEvent_MOVE
+	case app.EntityUpdated:
+		return entitypb.EntityEvent_UPDATE
+	default:
+		return entitypb.EntityEvent_SPAWN
+	}
+}
@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+	entitypb "github.com/Yeah114/tempest-core/network_api/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	langQueryTarget = "commands.querytarget.success"
+	langTestFor     = "commands.testfor.success"
+
+	defaultTargetPageSize = 64
+	maxTargetPageSize     = 256
+	maxTargetPages        = 64
+	// targetPageOverlap widens each page's min radius so entities at the
+	// page boundary are not lost to rounding; duplicates are dropped by ID.
+	targetPageOverlap = 0.5
+)
+
+// queryTargetEntry is one element of the querytarget JSON payload.
+type queryTargetEntry struct {
+	Dimension int32 `json:"dimension"`
+	Position  struct {
+		X float32 `json:"x"`
+		Y float32 `json:"y"`
+		Z float32 `json:"z"`
+	} `json:"position"`
+	UniqueID json.RawMessage `json:"uniqueId"`
+	YRot     float32         `json:"yRot"`
+}
+
+// QueryTargets runs querytarget for a selector and returns the parsed
+// records. Typed selectors without a count are paged by distance from their
+// origin so a single command output never has to carry every match.
+func (s *EntityService) QueryTargets(ctx context.Context, req *entitypb.QueryTargetsRequest) (*entitypb.QueryTargetsResponse, error) {
+	limit := int(req.GetLimit())
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultTargetPageSize
+	}
+	if pageSize > maxTargetPageSize {
+		pageSize = maxTargetPageSize
+	}
+
+	resp := &entitypb.QueryTargetsResponse{}
+	var sel cmdbuild.Selector
+	switch target := req.GetTarget().(type) {
+	case *entitypb.QueryTargetsRequest_Selector:
+		if strings.TrimSpace(target.Selector) == "" {
+			return nil, status.Error(codes.InvalidArgument, "selector required")
+		}
+		raw, err := cmdbuild.RawSelector(target.Selector)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		records, err := s.queryTargetPage(ctx, raw)
+		if err != nil {
+			return nil, err
+		}
+		resp.Pages = 1
+		resp.Targets, resp.Truncated = truncateTargets(records, limit)
+		return resp, nil
+	case *entitypb.QueryTargetsRequest_Spec:
+		sel = selectorFromProto(target.Spec)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "selector required")
+	}
+
+	origin, pageable := targetPageOrigin(sel)
+	if !pageable {
+		raw, err := sel.String()
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		records, err := s.queryTargetPage(ctx, raw)
+		if err != nil {
+			return nil, err
+		}
+		resp.Pages = 1
+		resp.Targets, resp.Truncated = truncateTargets(records, limit)
+		return resp, nil
+	}
+	return pageTargets(ctx, sel, origin, pageSize, limit, s.queryTargetPage)
+}
+
+// pageTargets queries sel in pages of pageSize ordered by distance from
+// origin, raising the min radius past each page. A full page without new
+// entities means more than pageSize of them share a distance band that
+// cannot be stepped over, so the result is marked truncated.
+func pageTargets(ctx context.Context, sel cmdbuild.Selector, origin cmdbuild.Position, pageSize, limit int, fetch func(context.Context, string) ([]*entitypb.TargetRecord, error)) (*entitypb.QueryTargetsResponse, error) {
+	resp := &entitypb.QueryTargetsResponse{}
+	seen := make(map[string]bool)
+	minRadius := 0.0
+	if sel.MinRadius != nil {
+		minRadius = *sel.MinRadius
+	}
+	for resp.Pages < maxTargetPages {
+		page := sel
+		page.Origin = &origin
+		page.Count = &pageSize
+		page.MinRadius = &minRadius
+		raw, err := page.String()
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		records, err := fetch(ctx, raw)
+		if err != nil {
+			return nil, err
+		}
+		resp.Pages++
+		fresh := 0
+		furthest := minRadius
+		for _, r := range records {
+			if d := targetDistance(origin, r); d > furthest {
+				furthest = d
+			}
+			key := targetKey(r)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			fresh++
+			resp.Targets = append(resp.Targets, r)
+		}
+		if limit > 0 && len(resp.Targets) >= limit {
+			resp.Targets, resp.Truncated = truncateTargets(resp.Targets, limit)
+			return resp, nil
+		}
+		if len(records) < pageSize {
+			return resp, nil
+		}
+		if fresh == 0 {
+			resp.Truncated = true
+			return resp, nil
+		}
+		minRadius = math.Max(minRadius, furthest-targetPageOverlap)
+	}
+	resp.Truncated = true
+	return resp, nil
+}
+
+// TestForTargets runs testfor and returns the names it matched.
+func (s *EntityService) TestForTargets(ctx context.Context, req *entitypb.TestForTargetsRequest) (*entitypb.TestForTargetsResponse, error) {
+	var raw string
+	switch target := req.GetTarget().(type) {
+	case *entitypb.TestForTargetsRequest_Selector:
+		if strings.TrimSpace(target.Selector) == "" {
+			return nil, status.Error(codes.InvalidArgument, "selector required")
+		}
+		var err error
+		if raw, err = cmdbuild.RawSelector(target.Selector); err != nil {
+			return nil, toStatusError(err)
+		}
+	case *entitypb.TestForTargetsRequest_Spec:
+		var err error
+		if raw, err = selectorFromProto(target.Spec).String(); err != nil {
+			return nil, toStatusError(err)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "selector required")
+	}
+	output, err := s.commands.queryCommand(ctx, "testfor "+raw)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &entitypb.TestForTargetsResponse{Matched: output.SuccessCount > 0}
+	for _, params := range outputMessages(output, langTestFor) {
+		if len(params) == 0 {
+			continue
+		}
+		for _, name := range strings.Split(params[0], ", ") {
+			if name = strings.TrimSpace(name); name != "" {
+				resp.Names = append(resp.Names, name)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// queryTargetPage sends one querytarget command. A selector that matches
+// nothing yields no records rather than an error.
+func (s *EntityService) queryTargetPage(ctx context.Context, selector string) ([]*entitypb.TargetRecord, error) {
+	output, err := s.commands.queryCommand(ctx, "querytarget "+selector)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	var out []*entitypb.TargetRecord
+	for _, params := range outputMessages(output, langQueryTarget) {
+		if len(params) == 0 {
+			continue
+		}
+		var entries []queryTargetEntry
+		if err := json.Unmarshal([]byte(params[0]), &entries); err != nil {
+			return nil, status.Errorf(codes.Internal, "parse querytarget output: %v", err)
+		}
+		for _, e := range entries {
+			out = append(out, targetRecord(e))
+		}
+	}
+	return out, nil
+}
+
+func targetRecord(e queryTargetEntry) *entitypb.TargetRecord {
+	r := &entitypb.TargetRecord{
+		Dimension: e.Dimension,
+		Position:  &entitypb.Vec3{X: e.Position.X, Y: e.Position.Y, Z: e.Position.Z},
+		YRot:      e.YRot,
+	}
+	// uniqueId is the numeric entity ID, sent as a string by current
+	// versions; anything else is kept verbatim as a UUID.
+	id := strings.Trim(string(e.UniqueID), `"`)
+	if v, err := strconv.ParseInt(id, 10, 64); err == nil {
+		r.UniqueId = v
+	} else {
+		r.Uuid = id
+	}
+	return r
+}
+
+func targetKey(r *entitypb.TargetRecord) string {
+	if r.GetUuid() != "" {
+		return r.GetUuid()
+	}
+	return strconv.FormatInt(r.GetUniqueId(), 10)
+}
+
+// targetPageOrigin decides whether sel can be paged. Paging sorts by distance
+// from an absolute origin, so selectors with their own count, a relative
+// origin, or a radius measured from the executor run as one query.
+func targetPageOrigin(sel cmdbuild.Selector) (cmdbuild.Position, bool) {
+	if sel.Count != nil || sel.RuntimeID != nil {
+		return cmdbuild.Position{}, false
+	}
+	switch sel.Target {
+	case cmdbuild.AllPlayers, cmdbuild.Entities:
+	default:
+		return cmdbuild.Position{}, false
+	}
+	if sel.Origin == nil {
+		if sel.Radius != nil || sel.MinRadius != nil {
+			return cmdbuild.Position{}, false
+		}
+		return cmdbuild.At(0, 0, 0), true
+	}
+	for _, c := range []cmdbuild.Coord{sel.Origin.X, sel.Origin.Y, sel.Origin.Z} {
+		if c.Mode != cmdbuild.Absolute {
+			return cmdbuild.Position{}, false
+		}
+	}
+	return *sel.Origin, true
+}
+
+func targetDistance(origin cmdbuild.Position, r *entitypb.TargetRecord) float64 {
+	dx := float64(r.GetPosition().GetX()) - origin.X.Value
+	dy := float64(r.GetPosition().GetY()) - origin.Y.Value
+	dz := float64(r.GetPosition().GetZ()) - origin.Z.Value
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+func truncateTargets(records []*entitypb.TargetRecord, limit int) ([]*entitypb.TargetRecord, bool) {
+	if limit > 0 && len(records) > limit {
+		return records[:limit], true
+	}
+	return records, false
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+	entitypb "github.com/Yeah114/tempest-core/network_api/entity"
+)
+
+var pageArgs = regexp.MustCompile(`\b(c|rm)=([0-9.]+)`)
+
+// fakeQueryTarget answers querytarget like the server: the c entities of
+// world nearest to the origin at least rm away from it.
+func fakeQueryTarget(world []*entitypb.TargetRecord, calls *int) func(context.Context, string) ([]*entitypb.TargetRecord, error) {
+	return func(ctx context.Context, raw string) ([]*entitypb.TargetRecord, error) {
+		*calls++
+		count, minRadius := len(world), 0.0
+		for _, m := range pageArgs.FindAllStringSubmatch(raw, -1) {
+			v, _ := strconv.ParseFloat(m[2], 64)
+			if m[1] == "c" {
+				count = int(v)
+			} else {
+				minRadius = v
+			}
+		}
+		var out []*entitypb.TargetRecord
+		for _, r := range world {
+			if targetDistance(cmdbuild.At(0, 0, 0), r) >= minRadius {
+				out = append(out, r)
+			}
+		}
+		sort.SliceStable(out, func(i, j int) bool {
+			return targetDistance(cmdbuild.At(0, 0, 0), out[i]) < targetDistance(cmdbuild.At(0, 0, 0), out[j])
+		})
+		if len(out) > count {
+			out = out[:count]
+		}
+		return out, nil
+	}
+}
+
+// entitiesAt places one entity at each distance along the x axis.
+func entitiesAt(distances ...float32) []*entitypb.TargetRecord {
+	out := make([]*entitypb.TargetRecord, len(distances))
+	for i, d := range distances {
+		out[i] = &entitypb.TargetRecord{UniqueId: int64(i + 1), Position: &entitypb.Vec3{X: d}}
+	}
+	return out
+}
+
+func spread(n int) []float32 {
+	out := make([]float32, n)
+	for i := range out {
+		out[i] = float32(i)
+	}
+	return out
+}
+
+func same(n int, d float32) []float32 {
+	out := make([]float32, n)
+	for i := range out {
+		out[i] = d
+	}
+	return out
+}
+
+func TestPageTargets(t *testing.T) {
+	tests := []struct {
+		name      string
+		world     []*entitypb.TargetRecord
+		pageSize  int
+		limit     int
+		want      int
+		pages     uint32
+		truncated bool
+	}{
+		{name: "single partial page", world: entitiesAt(spread(10)...), pageSize: 64, want: 10, pages: 1},
+		{name: "several pages", world: entitiesAt(spread(150)...), pageSize: 64, want: 150, pages: 3},
+		{name: "exact page multiple", world: entitiesAt(spread(128)...), pageSize: 64, want: 128, pages: 3},
+		{name: "limit", world: entitiesAt(spread(150)...), pageSize: 64, limit: 70, want: 70, pages: 2, truncated: true},
+		{name: "crowded distance band", world: entitiesAt(same(100, 5)...), pageSize: 64, want: 64, pages: 2, truncated: true},
+		{name: "empty", pageSize: 64, want: 0, pages: 1},
+	}
+	for _, tt := range tests {
+		calls := 0
+		sel := cmdbuild.Selector{Target: cmdbuild.Entities}
+		resp, err := pageTargets(context.Background(), sel, cmdbuild.At(0, 0, 0), tt.pageSize, tt.limit, fakeQueryTarget(tt.world, &calls))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if len(resp.GetTargets()) != tt.want || resp.GetPages() != tt.pages || resp.GetTruncated() != tt.truncated {
+			t.Errorf("%s: got %d targets, %d pages, truncated %v; want %d, %d, %v",
+				tt.name, len(resp.GetTargets()), resp.GetPages(), resp.GetTruncated(), tt.want, tt.pages, tt.truncated)
+		}
+		seen := make(map[int64]bool)
+		for _, r := range resp.GetTargets() {
+			if seen[r.GetUniqueId()] {
+				t.Errorf("%s: entity %d returned twice", tt.name, r.GetUniqueId())
+			}
+			seen[r.GetUniqueId()] = true
+		}
+		if uint32(calls) != resp.GetPages() {
+			t.Errorf("%s: %d queries for %d pages", tt.name, calls, resp.GetPages())
+		}
+	}
+}
+
+func TestPageTargetsError(t *testing.T) {
+	failed := errors.New("boom")
+	fetch := func(context.Context, string) ([]*entitypb.TargetRecord, error) { return nil, failed }
+	_, err := pageTargets(context.Background(), cmdbuild.Selector{}, cmdbuild.At(0, 0, 0), 64, 0, fetch)
+	if !errors.Is(err, failed) {
+		t.Errorf("error = %v, want %v", err, failed)
+	}
+}
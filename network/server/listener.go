@@ -9,12 +9,12 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
 	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
 	"github.com/Yeah114/FunShuttler/game_control/game_interface"
 	"github.com/Yeah114/FunShuttler/game_control/resources_control"
-	"github.com/Yeah114/FunShuttler/uqholder"
 	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
 	"github.com/Yeah114/tempest-core/network/app"
 	listenerpb "github.com/Yeah114/tempest-core/network_api/listener"
@@ -288,7 +288,7 @@ func (s *ListenerService) ListenTypedBytesPacket(ctx context.Context, req *liste
 func (s *ListenerService) ListenPlayerChange(req *listenerpb.ListenPlayerChangeRequest, stream listenerpb.ListenerService_ListenPlayerChangeServer) error {
 	type playerEvent struct {
 		action string
-		uuid   string
+		info   *listenerpb.PlayerAction
 		err    error
 	}
 
@@ -330,9 +330,19 @@ func (s *ListenerService) ListenPlayerChange(req *listenerpb.ListenPlayerChangeR
 			default:
 				return
 			}
+			now := time.Now().UnixMilli()
 			for _, entry := range playerList.Entries {
+				info := &listenerpb.PlayerAction{
+					Action:         action,
+					Uuid:           entry.UUID.String(),
+					Username:       entry.Username,
+					Xuid:           entry.XUID,
+					EntityUniqueId: entry.EntityUniqueID,
+					BuildPlatform:  entry.BuildPlatform,
+					TimestampMs:    now,
+				}
 				select {
-				case events <- playerEvent{action: action, uuid: entry.UUID.String()}:
+				case events <- playerEvent{action: action, info: info}:
 				default:
 				}
 			}
@@ -348,22 +358,32 @@ func (s *ListenerService) ListenPlayerChange(req *listenerpb.ListenPlayerChangeR
 		}
 	}()
 
-	// Emit existing players as "exist".
+	// Emit existing players as "exist", then mark the snapshot as complete.
 	registry := s.state.Players()
 	players, err := s.state.SnapshotPlayers()
 	if err == nil {
+		now := time.Now().UnixMilli()
 		for _, player := range players {
 			if player == nil {
 				continue
 			}
-			if uuidStr, ok := player.GetUUIDString(); ok {
-				registry.Rebind(uuidStr, player)
+			info := playerActionFromReader("exist", player, registry)
+			info.TimestampMs = now
+			if info.GetUuid() != "" {
+				registry.Rebind(info.GetUuid(), player)
 			}
-			if err := stream.Send(&listenerpb.PlayerAction{Action: "exist"}); err != nil {
+			if err := stream.Send(info); err != nil {
 				return err
 			}
 		}
 	}
+	if err := stream.Send(&listenerpb.PlayerAction{
+		Action:           "exist_finished",
+		TimestampMs:      time.Now().UnixMilli(),
+		SnapshotFinished: true,
+	}); err != nil {
+		return err
+	}
 
 	for {
 		select {
@@ -373,19 +393,24 @@ func (s *ListenerService) ListenPlayerChange(req *listenerpb.ListenPlayerChangeR
 			if evt.err != nil {
 				return evt.err
 			}
-			if evt.action == "" {
+			if evt.action == "" || evt.info == nil {
 				continue
 			}
-			if evt.uuid != "" {
+			if uuidStr := evt.info.GetUuid(); uuidStr != "" {
 				if evt.action == "offline" {
-					registry.Delete(evt.uuid)
+					// Removal entries only carry the UUID; fill in what we still know.
+					if player, ok := registry.Get(uuidStr); ok && player != nil {
+						mergePlayerAction(evt.info, playerActionFromReader(evt.action, player, registry))
+					}
+					registry.Delete(uuidStr)
 				} else {
-					if player, lookupErr := s.lookupPlayer(evt.uuid); lookupErr == nil {
-						registry.Rebind(evt.uuid, player)
+					if player, lookupErr := s.lookupPlayer(uuidStr); lookupErr == nil {
+						registry.Rebind(uuidStr, player)
+						mergePlayerAction(evt.info, playerActionFromReader(evt.action, player, registry))
 					}
 				}
 			}
-			if err := stream.Send(&listenerpb.PlayerAction{Action: evt.action}); err != nil {
+			if err := stream.Send(evt.info); err != nil {
 				return err
 			}
 		}
@@ -393,7 +418,11 @@ func (s *ListenerService) ListenPlayerChange(req *listenerpb.ListenPlayerChangeR
 }
 
 func (s *ListenerService) ListenChat(req *listenerpb.ListenChatRequest, stream listenerpb.ListenerService_ListenChatServer) error {
-	return s.streamTextPackets("", stream)
+	filter, err := newChatFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.streamTextPackets(filter, stream)
 }
 
 func (s *ListenerService) ListenCommandBlock(req *listenerpb.ListenCommandBlockRequest, stream listenerpb.ListenerService_ListenCommandBlockServer) error {
@@ -401,13 +430,14 @@ func (s *ListenerService) ListenCommandBlock(req *listenerpb.ListenCommandBlockR
 	if name == "" {
 		return status.Error(codes.InvalidArgument, "name required")
 	}
-	return s.streamTextPackets(name, stream)
+	return s.streamTextPackets(&chatFilter{rawName: name}, stream)
 }
 
-func (s *ListenerService) streamTextPackets(filter string, stream listenerpb.ListenerService_ListenChatServer) error {
+func (s *ListenerService) streamTextPackets(filter *chatFilter, stream listenerpb.ListenerService_ListenChatServer) error {
 	type chatEvent struct {
-		payload []byte
-		err     error
+		text *fpacket.Text
+		at   time.Time
+		err  error
 	}
 
 	events := make(chan chatEvent, 256)
@@ -436,15 +466,14 @@ func (s *ListenerService) streamTextPackets(filter string, stream listenerpb.Lis
 				return
 			}
 			text, ok := pk.(*fpacket.Text)
-			if !ok {
+			if !ok || s.state.Inputs().Claim(text) {
 				return
 			}
-			if filter != "" && text.SourceName != filter {
+			if !filter.matchPacket(text) {
 				return
 			}
-			payload, err := buildChatPayload(text)
 			select {
-			case events <- chatEvent{payload: payload, err: err}:
+			case events <- chatEvent{text: text, at: time.Now()}:
 			default:
 			}
 		})
@@ -459,6 +488,10 @@ func (s *ListenerService) streamTextPackets(filter string, stream listenerpb.Lis
 		}
 	}()
 
+	if filter.excludeSelf {
+		filter.botName, filter.botUUID = fetchBotIdentity(s.state)
+	}
+
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -467,10 +500,17 @@ func (s *ListenerService) streamTextPackets(filter string, stream listenerpb.Lis
 			if evt.err != nil {
 				return evt.err
 			}
-			if len(evt.payload) == 0 {
+			if evt.text == nil {
 				continue
 			}
-			if err := stream.Send(&listenerpb.Chat{Payload: string(evt.payload)}); err != nil {
+			chat, err := buildChatEvent(s.state, evt.text, evt.at)
+			if err != nil {
+				return err
+			}
+			if !filter.matchEvent(chat) {
+				continue
+			}
+			if err := stream.Send(chat); err != nil {
 				return err
 			}
 		}
@@ -497,38 +537,40 @@ func (s *ListenerService) pushBytesEvent(evt bytesEvent) {
 	queue.Push(evt)
 }
 
-func (s *ListenerService) lookupPlayer(uuidStr string) (uqdefines.PlayerUQReader, error) {
-	return fetchPlayerByUUID(s.state, uuidStr)
-}
-
-type chatPayload struct {
-	Name          string   `json:"name"`
-	Msg           []string `json:"msg"`
-	Type          byte     `json:"type"`
-	RawMsg        string   `json:"raw_msg"`
-	RawName       string   `json:"raw_name"`
-	RawParameters []string `json:"raw_parameters"`
+func playerActionFromReader(action string, player uqdefines.PlayerUQReader, registry *app.PlayerRegistry) *listenerpb.PlayerAction {
+	info := &listenerpb.PlayerAction{Action: action}
+	if uuidStr, ok := player.GetUUIDString(); ok {
+		info.Uuid = uuidStr
+		info.Xuid, _ = registry.XUID(uuidStr)
+	}
+	if name, ok := player.GetUsername(); ok {
+		info.Username = name
+	}
+	if id, ok := player.GetEntityUniqueID(); ok {
+		info.EntityUniqueId = id
+	}
+	if platform, ok := player.GetBuildPlatform(); ok {
+		info.BuildPlatform = platform
+	}
+	return info
 }
 
-func buildChatPayload(text *fpacket.Text) ([]byte, error) {
-	payload := chatPayload{
-		Name:          uqholder.ToPlainName(text.SourceName),
-		Msg:           splitWords(text.Message),
-		Type:          text.TextType,
-		RawMsg:        text.Message,
-		RawName:       text.SourceName,
-		RawParameters: append([]string(nil), text.Parameters...),
+// mergePlayerAction fills zero-valued identity fields in dst from src.
+func mergePlayerAction(dst, src *listenerpb.PlayerAction) {
+	if dst.Username == "" {
+		dst.Username = src.GetUsername()
+	}
+	if dst.EntityUniqueId == 0 {
+		dst.EntityUniqueId = src.GetEntityUniqueId()
+	}
+	if dst.BuildPlatform == 0 {
+		dst.BuildPlatform = src.GetBuildPlatform()
+	}
+	if dst.Xuid == "" {
+		dst.Xuid = src.GetXuid()
 	}
-	return json.Marshal(payload)
 }
 
-func splitWords(s string) []string {
-	fields := strings.Fields(s)
-	result := make([]string, 0, len(fields))
-	for _, field := range fields {
-		if field != "" {
-			result = append(result, field)
-		}
-	}
-	return result
+func (s *ListenerService) lookupPlayer(uuidStr string) (uqdefines.PlayerUQReader, error) {
+	return fetchPlayerByUUID(s.state, uuidStr)
 }
@@ -0,0 +1,505 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
+	"github.com/Yeah114/tempest-core/network/app"
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+	"github.com/Yeah114/tempest-core/network/app/permissions"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	permissionpb "github.com/Yeah114/tempest-core/network_api/permission"
+	responsepb "github.com/Yeah114/tempest-core/network_api/response"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// permissionsFile is the name of the role store inside the data directory.
+	permissionsFile = "permissions.json"
+	// roleApplyDelay gives a joining player time to spawn before commands
+	// target them by name.
+	roleApplyDelay = 3 * time.Second
+)
+
+// permissionAbilities names the abilities a role controls, in report order.
+var permissionAbilities = []struct {
+	name string
+	flag uint32
+}{
+	{"build", protocol.AbilityBuild},
+	{"mine", protocol.AbilityMine},
+	{"doors_and_switches", protocol.AbilityDoorsAndSwitches},
+	{"open_containers", protocol.AbilityOpenContainers},
+	{"attack_players", protocol.AbilityAttackPlayers},
+	{"attack_mobs", protocol.AbilityAttackMobs},
+	{"operator_commands", protocol.AbilityOperatorCommands},
+	{"teleport", protocol.AbilityTeleport},
+}
+
+// PermissionService manages persistent permission roles and applies them to
+// players as they join.
+type PermissionService struct {
+	permissionpb.UnimplementedPermissionServiceServer
+	state    *app.FatalderState
+	commands *CommandService
+	store    *permissions.Store
+
+	mu      sync.Mutex
+	applied map[string]*permissionpb.RoleApplication
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPermissionService loads persisted roles and starts applying them to
+// joining players.
+func NewPermissionService(state *app.FatalderState) (*PermissionService, error) {
+	store, err := permissions.Open(state.DataPath(permissionsFile))
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &PermissionService{
+		state:    state,
+		commands: NewCommandService(state),
+		store:    store,
+		applied:  make(map[string]*permissionpb.RoleApplication),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	joins, unsubscribe := state.PlayerJoins(256)
+	s.wg.Add(1)
+	go s.watchJoins(joins, unsubscribe)
+	return s, nil
+}
+
+// Close stops applying roles and waits for applications in progress.
+func (s *PermissionService) Close() {
+	if s != nil && s.cancel != nil {
+		s.cancel()
+		s.wg.Wait()
+	}
+}
+
+func (s *PermissionService) PutRole(ctx context.Context, req *permissionpb.PutRoleRequest) (*permissionpb.PermissionRole, error) {
+	in := req.GetRole()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "role required")
+	}
+	if err := s.checkManager(ctx); err != nil {
+		return nil, err
+	}
+	role := roleFromProto(in)
+	if role.Op != nil && *role.Op && !role.Abilities.OperatorCommands {
+		return nil, status.Error(codes.InvalidArgument, "op requires the operator_commands ability")
+	}
+	for _, tag := range role.Tags {
+		if _, err := cmdbuild.Quote(strings.TrimSpace(tag)); err != nil {
+			return nil, toStatusError(err)
+		}
+	}
+	saved, err := s.store.PutRole(role)
+	if err != nil {
+		return nil, permissionStatusError(err)
+	}
+	return roleToProto(saved), nil
+}
+
+func (s *PermissionService) GetRole(ctx context.Context, req *permissionpb.GetRoleRequest) (*permissionpb.PermissionRole, error) {
+	role, err := s.store.Role(strings.TrimSpace(req.GetName()))
+	if err != nil {
+		return nil, permissionStatusError(err)
+	}
+	return roleToProto(role), nil
+}
+
+func (s *PermissionService) ListRoles(ctx context.Context, req *permissionpb.ListRolesRequest) (*permissionpb.ListRolesResponse, error) {
+	roles := s.store.Roles()
+	resp := &permissionpb.ListRolesResponse{Roles: make([]*permissionpb.PermissionRole, 0, len(roles))}
+	for _, role := range roles {
+		resp.Roles = append(resp.Roles, roleToProto(role))
+	}
+	return resp, nil
+}
+
+func (s *PermissionService) DeleteRole(ctx context.Context, req *permissionpb.DeleteRoleRequest) (*responsepb.GeneralResponse, error) {
+	if err := s.checkManager(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteRole(strings.TrimSpace(req.GetName())); err != nil {
+		return nil, permissionStatusError(err)
+	}
+	return generalSuccess(""), nil
+}
+
+func (s *PermissionService) AssignRole(ctx context.Context, req *permissionpb.AssignRoleRequest) (*permissionpb.RoleAssignment, error) {
+	in := req.GetAssignment()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "assignment required")
+	}
+	if err := s.checkManager(ctx); err != nil {
+		return nil, err
+	}
+	assignment, err := s.store.Assign(permissions.Assignment{
+		Kind:    matchKindFromProto(in.GetKind()),
+		Pattern: in.GetPattern(),
+		Role:    strings.TrimSpace(in.GetRole()),
+	})
+	if err != nil {
+		return nil, permissionStatusError(err)
+	}
+	return assignmentToProto(assignment), nil
+}
+
+func (s *PermissionService) UnassignRole(ctx context.Context, req *permissionpb.UnassignRoleRequest) (*responsepb.GeneralResponse, error) {
+	if err := s.checkManager(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.store.Unassign(matchKindFromProto(req.GetKind()), req.GetPattern()); err != nil {
+		return nil, permissionStatusError(err)
+	}
+	return generalSuccess(""), nil
+}
+
+func (s *PermissionService) ListAssignments(ctx context.Context, req *permissionpb.ListAssignmentsRequest) (*permissionpb.ListAssignmentsResponse, error) {
+	assignments := s.store.Assignments()
+	resp := &permissionpb.ListAssignmentsResponse{Assignments: make([]*permissionpb.RoleAssignment, 0, len(assignments))}
+	for _, a := range assignments {
+		resp.Assignments = append(resp.Assignments, assignmentToProto(a))
+	}
+	return resp, nil
+}
+
+// ApplyRole applies the role assigned to an online player right away.
+// Failed steps are listed in the result rather than failing the call.
+func (s *PermissionService) ApplyRole(ctx context.Context, req *permissionpb.ApplyRoleRequest) (*permissionpb.RoleApplication, error) {
+	uuidStr := strings.TrimSpace(req.GetUuidStr())
+	if uuidStr == "" {
+		return nil, status.Error(codes.InvalidArgument, "player uuid required")
+	}
+	if err := s.checkManager(ctx); err != nil {
+		return nil, err
+	}
+	player, err := fetchPlayerByUUID(s.state, uuidStr)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	name, _ := player.GetUsername()
+	entityID, ok := player.GetEntityUniqueID()
+	if !ok {
+		return nil, status.Error(codes.Internal, "entity unique id unavailable")
+	}
+	role, _, ok := s.store.Resolve(uuidStr, name)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "no role assigned to player")
+	}
+	return s.apply(ctx, uuidStr, name, entityID, role, player), nil
+}
+
+// checkManager rejects callers that may not change permission roles. Roles
+// are applied with the service's own rights, so changing or applying one
+// is as powerful as sending its op and tag commands.
+func (s *PermissionService) checkManager(ctx context.Context) error {
+	if !s.state.CommandPolicy().CanManagePermissions(policyToken(ctx)) {
+		return status.Error(codes.PermissionDenied, "caller may not manage permission roles")
+	}
+	return nil
+}
+
+// GetRoleDrift compares the abilities and operator status of players with
+// an assigned role against what the role asks for.
+func (s *PermissionService) GetRoleDrift(ctx context.Context, req *permissionpb.GetRoleDriftRequest) (*permissionpb.GetRoleDriftResponse, error) {
+	resp := &permissionpb.GetRoleDriftResponse{}
+	var players []uqdefines.PlayerUQReader
+	if len(req.GetUuidStrs()) == 0 {
+		all, err := s.state.SnapshotPlayers()
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		players = all
+	}
+	for _, raw := range req.GetUuidStrs() {
+		uuidStr := strings.TrimSpace(raw)
+		if uuidStr == "" {
+			continue
+		}
+		player, err := fetchPlayerByUUID(s.state, uuidStr)
+		if errors.As(err, new(*notFoundError)) {
+			resp.Missing = append(resp.Missing, uuidStr)
+			continue
+		}
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		players = append(players, player)
+	}
+	_, botUUID := fetchBotIdentity(s.state)
+	for _, player := range players {
+		uuidStr, ok := player.GetUUIDString()
+		if !ok || strings.EqualFold(uuidStr, botUUID) {
+			continue
+		}
+		name, _ := player.GetUsername()
+		role, assignment, ok := s.store.Resolve(uuidStr, name)
+		if !ok {
+			continue
+		}
+		resp.Players = append(resp.Players, s.drift(uuidStr, name, player, role, assignment))
+	}
+	return resp, nil
+}
+
+func (s *PermissionService) drift(uuidStr, name string, player uqdefines.PlayerUQReader, role permissions.Role, assignment permissions.Assignment) *permissionpb.PlayerRoleDrift {
+	desired := abilitiesMask(role.Abilities)
+	out := &permissionpb.PlayerRoleDrift{
+		UuidStr:       uuidStr,
+		Name:          name,
+		Assignment:    assignmentToProto(assignment),
+		DesiredValues: desired,
+	}
+	s.mu.Lock()
+	out.LastApplication = s.applied[strings.ToLower(uuidStr)]
+	s.mu.Unlock()
+	if role.Op != nil && *role.Op != playerIsOp(player) {
+		out.OpDrift = true
+	}
+	values, ok := player.GetValues()
+	if !ok {
+		// Without ability values the player cannot be shown to be in sync.
+		return out
+	}
+	out.ActualValues = values & abilityMask
+	for _, ability := range permissionAbilities {
+		want, have := desired&ability.flag != 0, out.ActualValues&ability.flag != 0
+		switch {
+		case want && !have:
+			out.MissingAbilities = append(out.MissingAbilities, ability.name)
+		case have && !want:
+			out.ExtraAbilities = append(out.ExtraAbilities, ability.name)
+		}
+	}
+	out.InSync = !out.OpDrift && len(out.MissingAbilities) == 0 && len(out.ExtraAbilities) == 0
+	return out
+}
+
+// watchJoins applies the assigned role to every player announced online,
+// including those already online when a session starts.
+func (s *PermissionService) watchJoins(joins <-chan app.PlayerJoin, unsubscribe func()) {
+	defer s.wg.Done()
+	defer unsubscribe()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case join, ok := <-joins:
+			if !ok {
+				return
+			}
+			if _, _, ok := s.store.Resolve(join.UUID, join.Name); !ok {
+				continue
+			}
+			s.wg.Add(1)
+			go s.applyOnJoin(join)
+		}
+	}
+}
+
+func (s *PermissionService) applyOnJoin(join app.PlayerJoin) {
+	defer s.wg.Done()
+	timer := time.NewTimer(roleApplyDelay)
+	defer timer.Stop()
+	select {
+	case <-s.ctx.Done():
+		return
+	case <-timer.C:
+	}
+	if _, botUUID := fetchBotIdentity(s.state); strings.EqualFold(join.UUID, botUUID) {
+		return
+	}
+	// Resolve again in case the assignments changed while waiting.
+	role, _, ok := s.store.Resolve(join.UUID, join.Name)
+	if !ok {
+		return
+	}
+	player, _ := fetchPlayerByUUID(s.state, join.UUID)
+	s.apply(s.ctx, join.UUID, join.Name, join.EntityUniqueID, role, player)
+}
+
+// apply sends the op or deop command, the ability request and the tag
+// commands of role, and records the outcome for drift reports. player may
+// be nil when the UQHolder does not know the player yet. Only managers can
+// change roles, so the commands skip the command policy.
+func (s *PermissionService) apply(ctx context.Context, uuidStr, name string, entityID int64, role permissions.Role, player uqdefines.PlayerUQReader) *permissionpb.RoleApplication {
+	result := &permissionpb.RoleApplication{
+		UuidStr:     uuidStr,
+		Name:        name,
+		Role:        role.Name,
+		AppliedAtMs: time.Now().UnixMilli(),
+	}
+	pacing := &commandpb.CommandPacing{Priority: commandpb.CommandPriority_AUTOMATION}
+	target, targetErr := quotedCommandTarget(name)
+	if targetErr != nil {
+		result.Errors = append(result.Errors, targetErr.Error())
+	}
+	// Op status changes the permission level, so it goes before abilities.
+	if role.Op != nil && targetErr == nil && (player == nil || playerIsOp(player) != *role.Op) {
+		cmd := opCommand(*role.Op, target)
+		output, err := s.commands.dispatchCommandOutput(ctx, pacing, 0, cmd, (*game_interface.Commands).SendWSCommandWithResp)
+		if err == nil {
+			err = outputError(output)
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %s", cmd, status.Convert(err).Message()))
+		}
+	}
+	if err := requestAbilities(s.state, entityID, abilitiesMask(role.Abilities)); err != nil {
+		result.Errors = append(result.Errors, "abilities: "+err.Error())
+	}
+	if targetErr == nil {
+		for _, tag := range role.Tags {
+			quoted, err := cmdbuild.Quote(tag)
+			if err != nil {
+				result.Errors = append(result.Errors, err.Error())
+				continue
+			}
+			// Adding a tag the player already has reports no success, so
+			// only send failures count.
+			cmd := fmt.Sprintf("tag %s add %s", target, quoted)
+			if _, err := s.commands.dispatchCommandOutput(ctx, pacing, 0, cmd, (*game_interface.Commands).SendWSCommandWithResp); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: %s", cmd, status.Convert(err).Message()))
+			}
+		}
+	}
+	result.Success = len(result.Errors) == 0
+	s.mu.Lock()
+	s.applied[strings.ToLower(uuidStr)] = result
+	s.mu.Unlock()
+	return result
+}
+
+func opCommand(op bool, target string) string {
+	if op {
+		return "op " + target
+	}
+	return "deop " + target
+}
+
+func playerIsOp(player uqdefines.PlayerUQReader) bool {
+	if perm, ok := player.GetCommandPermissions(); ok && perm >= byte(fpacket.CommandPermissionLevelAdmin) {
+		return true
+	}
+	op, _ := abilityEnabled(player, protocol.AbilityOperatorCommands)
+	return op
+}
+
+func abilitiesMask(a permissions.Abilities) uint32 {
+	var mask uint32
+	for _, field := range []struct {
+		set  bool
+		flag uint32
+	}{
+		{a.Build, protocol.AbilityBuild},
+		{a.Mine, protocol.AbilityMine},
+		{a.DoorsAndSwitches, protocol.AbilityDoorsAndSwitches},
+		{a.OpenContainers, protocol.AbilityOpenContainers},
+		{a.AttackPlayers, protocol.AbilityAttackPlayers},
+		{a.AttackMobs, protocol.AbilityAttackMobs},
+		{a.OperatorCommands, protocol.AbilityOperatorCommands},
+		{a.Teleport, protocol.AbilityTeleport},
+	} {
+		if field.set {
+			mask |= field.flag
+		}
+	}
+	return mask
+}
+
+func permissionStatusError(err error) error {
+	switch {
+	case errors.Is(err, permissions.ErrRoleNotFound), errors.Is(err, permissions.ErrAssignmentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, permissions.ErrRoleInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, permissions.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return toStatusError(err)
+	}
+}
+
+func roleFromProto(in *permissionpb.PermissionRole) permissions.Role {
+	a := in.GetAbilities()
+	return permissions.Role{
+		Name: in.GetName(),
+		Abilities: permissions.Abilities{
+			Build:            a.GetBuild(),
+			Mine:             a.GetMine(),
+			DoorsAndSwitches: a.GetDoorsAndSwitches(),
+			OpenContainers:   a.GetOpenContainers(),
+			AttackPlayers:    a.GetAttackPlayers(),
+			AttackMobs:       a.GetAttackMobs(),
+			OperatorCommands: a.GetOperatorCommands(),
+			Teleport:         a.GetTeleport(),
+		},
+		Op:   in.Op,
+		Tags: in.GetTags(),
+	}
+}
+
+func roleToProto(role permissions.Role) *permissionpb.PermissionRole {
+	a := role.Abilities
+	return &permissionpb.PermissionRole{
+		Name: role.Name,
+		Abilities: &permissionpb.RoleAbilities{
+			Build:            a.Build,
+			Mine:             a.Mine,
+			DoorsAndSwitches: a.DoorsAndSwitches,
+			OpenContainers:   a.OpenContainers,
+			AttackPlayers:    a.AttackPlayers,
+			AttackMobs:       a.AttackMobs,
+			OperatorCommands: a.OperatorCommands,
+			Teleport:         a.Teleport,
+		},
+		Op:          role.Op,
+		Tags:        role.Tags,
+		UpdatedAtMs: role.UpdatedAt.UnixMilli(),
+	}
+}
+
+func matchKindFromProto(kind permissionpb.RoleAssignment_Kind) permissions.MatchKind {
+	switch kind {
+	case permissionpb.RoleAssignment_NAME:
+		return permissions.MatchName
+	case permissionpb.RoleAssignment_DEFAULT:
+		return permissions.MatchDefault
+	default:
+		return permissions.MatchUUID
+	}
+}
+
+func assignmentToProto(a permissions.Assignment) *permissionpb.RoleAssignment {
+	out := &permissionpb.RoleAssignment{
+		Pattern:     a.Pattern,
+		Role:        a.Role,
+		CreatedAtMs: a.CreatedAt.UnixMilli(),
+	}
+	switch a.Kind {
+	case permissions.MatchName:
+		out.Kind = permissionpb.RoleAssignment_NAME
+	case permissions.MatchDefault:
+		out.Kind = permissionpb.RoleAssignment_DEFAULT
+	default:
+		out.Kind = permissionpb.RoleAssignment_UUID
+	}
+	return out
+}
@@ -1,16 +1,16 @@
 package server
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
 	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
 	"github.com/Yeah114/FunShuttler/game_control/resources_control"
 	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
 	"github.com/Yeah114/tempest-core/network/app"
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
 )
 
 const abilityMask = protocol.AbilityBuild |
@@ -92,6 +92,12 @@ func updateAbility(state *app.FatalderState, player uqdefines.PlayerUQReader, fl
 	} else {
 		values &^= flag
 	}
+	return requestAbilities(state, entityID, values)
+}
+
+// requestAbilities asks the server to give the player the permission
+// abilities in values, deriving the matching permission level.
+func requestAbilities(state *app.FatalderState, entityID int64, values uint32) error {
 	requested := uint16(values & abilityMask)
 	level := permissionLevelFor(values & abilityMask)
 
@@ -116,19 +122,47 @@ func permissionLevelFor(mask uint32) uint8 {
 }
 
 func buildRawText(message string) (string, error) {
-	nodes := map[string]any{
-		"rawtext": []map[string]string{
-			{"text": message},
-		},
+	return cmdbuild.RawText(cmdbuild.Text(message))
+}
+
+// tellPlayer sends message to the named player through tellraw. Waiting for
+// a dispatcher slot is bounded by ctx and the default response timeout.
+func tellPlayer(ctx context.Context, state *app.FatalderState, name, message string) error {
+	payload, err := buildRawText(message)
+	if err != nil {
+		return err
+	}
+	target, err := quotedCommandTarget(name)
+	if err != nil {
+		return err
+	}
+	cmd := fmt.Sprintf("tellraw %s %s", target, payload)
+	ctx, cancel := context.WithTimeout(ctx, defaultCommandResponseTimeout)
+	defer cancel()
+	cmds, err := state.PacedCommands(ctx, app.LaneCosmetic, false)
+	if err != nil {
+		return err
+	}
+	return cmds.SendWSCommand(cmd)
+}
+
+// playerHasTag reports whether the named online player carries tag.
+func playerHasTag(ctx context.Context, commands *CommandService, name, tag string) (bool, error) {
+	if name == "" || tag == "" {
+		return false, nil
+	}
+	selector, err := cmdbuild.Selector{Target: cmdbuild.AllPlayers, Name: name, Tags: []string{tag}}.String()
+	if err != nil {
+		return false, err
 	}
-	bs, err := json.Marshal(nodes)
+	output, err := commands.queryCommand(ctx, "testfor "+selector)
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return string(bs), nil
+	return output != nil && output.SuccessCount > 0, nil
 }
 
-func quotedCommandTarget(name string) string {
-	name = strings.ReplaceAll(name, `"`, `\"`)
-	return fmt.Sprintf(`"%s"`, name)
+// quotedCommandTarget quotes a player name for use as a command target.
+func quotedCommandTarget(name string) (string, error) {
+	return cmdbuild.Quote(name)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
 	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
@@ -17,6 +18,11 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultInputTimeout = time.Minute
+	maxInputTimeout     = 10 * time.Minute
+)
+
 // PlayerKitService exposes player utilities over gRPC.
 type PlayerKitService struct {
 	playerkitpb.UnimplementedPlayerKitServiceServer
@@ -308,11 +314,11 @@ func (s *PlayerKitService) GetPlayerOnline(ctx context.Context, req *playerkitpb
 }
 
 func (s *PlayerKitService) SendPlayerChat(ctx context.Context, req *playerkitpb.SendPlayerChatRequest) (*responsepb.GeneralResponse, error) {
-	return s.sendMessage(req.GetUuidStr(), strings.TrimSpace(req.GetMsg()), "tellraw", "")
+	return s.sendMessage(ctx, req.GetUuidStr(), strings.TrimSpace(req.GetMsg()), "tellraw", "")
 }
 
 func (s *PlayerKitService) SendPlayerRawChat(ctx context.Context, req *playerkitpb.SendPlayerRawChatRequest) (*responsepb.GeneralResponse, error) {
-	return s.sendMessage(req.GetUuidStr(), strings.TrimSpace(req.GetMsg()), "tellraw", "")
+	return s.sendMessage(ctx, req.GetUuidStr(), strings.TrimSpace(req.GetMsg()), "tellraw", "")
 }
 
 func (s *PlayerKitService) SendPlayerTitle(ctx context.Context, req *playerkitpb.SendPlayerTitleRequest) (*responsepb.GeneralResponse, error) {
@@ -328,8 +334,11 @@ func (s *PlayerKitService) SendPlayerTitle(ctx context.Context, req *playerkitpb
 		return nil, status.Error(codes.Internal, "player name unavailable")
 	}
 
-	err = s.withCommands(func(cmds *game_interface.Commands) error {
-		target := quotedCommandTarget(name)
+	err = s.withCommands(ctx, func(cmds *game_interface.Commands) error {
+		target, err := quotedCommandTarget(name)
+		if err != nil {
+			return err
+		}
 		if title != "" {
 			payload, err := buildRawText(title)
 			if err != nil {
@@ -357,14 +366,83 @@ func (s *PlayerKitService) SendPlayerTitle(ctx context.Context, req *playerkitpb
 }
 
 func (s *PlayerKitService) SendPlayerActionBar(ctx context.Context, req *playerkitpb.SendPlayerActionBarRequest) (*responsepb.GeneralResponse, error) {
-	return s.sendMessage(req.GetUuidStr(), strings.TrimSpace(req.GetActionBar()), "titleraw", "actionbar")
+	return s.sendMessage(ctx, req.GetUuidStr(), strings.TrimSpace(req.GetActionBar()), "titleraw", "actionbar")
 }
 
+// InterceptPlayerJustNextInput registers an interception of the player's
+// next chat message under retriever_id. The captured message is collected
+// with WaitPlayerInput.
 func (s *PlayerKitService) InterceptPlayerJustNextInput(ctx context.Context, req *playerkitpb.InterceptPlayerJustNextInputRequest) (*responsepb.GeneralResponse, error) {
-	// Not yet implemented; acknowledge the call.
+	retrieverID, err := clientRetrieverID(req.GetRetrieverId())
+	if err != nil {
+		return nil, err
+	}
+	timeout := defaultInputTimeout
+	if ms := req.GetTimeoutMs(); ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+	if timeout > maxInputTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "timeout exceeds %s", maxInputTimeout)
+	}
+	uuidStr := strings.TrimSpace(req.GetUuidStr())
+	player, err := s.ensurePlayer(uuidStr)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	name, ok := player.GetUsername()
+	if !ok || name == "" {
+		return nil, status.Error(codes.Internal, "player name unavailable")
+	}
+	if err := s.state.Inputs().Register(retrieverID, uuidStr, name, timeout, req.GetSuppress()); err != nil {
+		return nil, toStatusError(err)
+	}
+	return generalSuccess(""), nil
+}
+
+// WaitPlayerInput blocks until the interception under retriever_id captures
+// a message, times out or is cancelled.
+func (s *PlayerKitService) WaitPlayerInput(ctx context.Context, req *playerkitpb.WaitPlayerInputRequest) (*playerkitpb.PlayerInput, error) {
+	retrieverID, err := clientRetrieverID(req.GetRetrieverId())
+	if err != nil {
+		return nil, err
+	}
+	input, err := s.state.Inputs().Wait(ctx, retrieverID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &playerkitpb.PlayerInput{
+		RetrieverId:  input.RetrieverID,
+		UuidStr:      input.PlayerUUID,
+		Name:         input.PlayerName,
+		Message:      input.Message,
+		CapturedAtMs: input.At.UnixMilli(),
+	}, nil
+}
+
+func (s *PlayerKitService) CancelPlayerInput(ctx context.Context, req *playerkitpb.CancelPlayerInputRequest) (*responsepb.GeneralResponse, error) {
+	retrieverID, err := clientRetrieverID(req.GetRetrieverId())
+	if err != nil {
+		return nil, err
+	}
+	if !s.state.Inputs().Cancel(retrieverID) {
+		return nil, toStatusError(app.ErrRetrieverUnknown)
+	}
 	return generalSuccess(""), nil
 }
 
+// clientRetrieverID trims a retriever ID sent by a client and rejects the
+// prefix reserved for PlayerPrompt.
+func clientRetrieverID(id string) (string, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return "", status.Error(codes.InvalidArgument, "retriever id required")
+	}
+	if strings.HasPrefix(id, promptRetrieverPrefix) {
+		return "", status.Errorf(codes.InvalidArgument, "retriever ids starting with %q are reserved", promptRetrieverPrefix)
+	}
+	return id, nil
+}
+
 func (s *PlayerKitService) ensurePlayer(uuidStr string) (uqdefines.PlayerUQReader, error) {
 	if uuidStr == "" {
 		return nil, status.Error(codes.InvalidArgument, "player uuid required")
@@ -404,7 +482,7 @@ func (s *PlayerKitService) setAbility(uuid string, ability uint32, allow bool) (
 	return generalSuccess(""), nil
 }
 
-func (s *PlayerKitService) sendMessage(uuid, message, command, action string) (*responsepb.GeneralResponse, error) {
+func (s *PlayerKitService) sendMessage(ctx context.Context, uuid, message, command, action string) (*responsepb.GeneralResponse, error) {
 	if message == "" {
 		return nil, status.Error(codes.InvalidArgument, "message required")
 	}
@@ -417,12 +495,15 @@ func (s *PlayerKitService) sendMessage(uuid, message, command, action string) (*
 		return nil, status.Error(codes.Internal, "player name unavailable")
 	}
 
-	err = s.withCommands(func(cmds *game_interface.Commands) error {
+	err = s.withCommands(ctx, func(cmds *game_interface.Commands) error {
 		payload, err := buildRawText(message)
 		if err != nil {
 			return err
 		}
-		target := quotedCommandTarget(name)
+		target, err := quotedCommandTarget(name)
+		if err != nil {
+			return err
+		}
 		cmd := command
 		if action != "" {
 			cmd = fmt.Sprintf("%s %s %s %s", command, target, action, payload)
@@ -437,12 +518,13 @@ func (s *PlayerKitService) sendMessage(uuid, message, command, action string) (*
 	return generalSuccess(""), nil
 }
 
-func (s *PlayerKitService) withCommands(fn func(*game_interface.Commands) error) error {
-	return s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
-		cmds := iface.Commands()
-		if cmds == nil {
-			return status.Error(codes.FailedPrecondition, "commands interface unavailable")
-		}
-		return fn(cmds)
-	})
+func (s *PlayerKitService) withCommands(ctx context.Context, fn func(*game_interface.Commands) error) error {
+	cmds, err := s.state.PacedCommands(ctx, app.LaneAutomation, false)
+	if err != nil {
+		return err
+	}
+	if cmds == nil {
+		return status.Error(codes.FailedPrecondition, "commands interface unavailable")
+	}
+	return fn(cmds)
 }
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	"github.com/Yeah114/FunShuttler/game_control/resources_control"
+	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
+	playerkitpb "github.com/Yeah114/tempest-core/network_api/playerkit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAbilityConfirmTimeout = 5 * time.Second
+	maxAbilityConfirmTimeout     = time.Minute
+)
+
+// SetPlayerAbilities changes several permission abilities with a single
+// RequestPermissions packet and waits for an UpdateAbilities for the player
+// that carries the requested set. Updates that do not match, such as a stale
+// one already in flight, are skipped. When the timeout passes the last update
+// seen is returned unconfirmed; no update at all is a DeadlineExceeded error.
+func (s *PlayerKitService) SetPlayerAbilities(ctx context.Context, req *playerkitpb.SetPlayerAbilitiesRequest) (*playerkitpb.SetPlayerAbilitiesResponse, error) {
+	changes := req.GetAbilities()
+	if changes == nil {
+		return nil, status.Error(codes.InvalidArgument, "abilities required")
+	}
+	timeout := defaultAbilityConfirmTimeout
+	if ms := req.GetTimeoutMs(); ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+	if timeout > maxAbilityConfirmTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "timeout exceeds %s", maxAbilityConfirmTimeout)
+	}
+	player, err := s.ensurePlayer(strings.TrimSpace(req.GetUuidStr()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	entityID, ok := player.GetEntityUniqueID()
+	if !ok {
+		return nil, status.Error(codes.Internal, "entity unique id unavailable")
+	}
+	values, err := applyAbilityChanges(player, changes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Listen before sending so a fast reply is not missed.
+	confirmations := make(chan protocol.AbilityData, 8)
+	var listener *resources_control.PacketListener
+	var listenerID string
+	if err := s.state.WithGameInterface(func(iface *game_interface.GameInterface) error {
+		listener = iface.PacketListener()
+		if listener == nil {
+			return errors.New("packet listener unavailable")
+		}
+		var err error
+		listenerID, err = listener.ListenPacket([]uint32{fpacket.IDUpdateAbilities}, func(pk fpacket.Packet, connErr error) {
+			update, ok := pk.(*fpacket.UpdateAbilities)
+			if connErr != nil || !ok || update.AbilityData.EntityUniqueID != entityID {
+				return
+			}
+			for {
+				select {
+				case confirmations <- update.AbilityData:
+					return
+				default:
+				}
+				// Keep the newest updates when the reader falls behind.
+				select {
+				case <-confirmations:
+				default:
+				}
+			}
+		})
+		return err
+	}); err != nil {
+		return nil, toStatusError(err)
+	}
+	defer listener.DestroyListener(listenerID)
+
+	if err := requestAbilities(s.state, entityID, values); err != nil {
+		return nil, toStatusError(err)
+	}
+	requested := values & abilityMask
+	resp := &playerkitpb.SetPlayerAbilitiesResponse{
+		RequestedValues: requested,
+		PermissionLevel: uint32(permissionLevelFor(requested)),
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	var last *protocol.AbilityData
+	for {
+		select {
+		case data := <-confirmations:
+			last = &data
+			if baseAbilityValues(data)&abilityMask == requested {
+				fillAbilityResponse(resp, data)
+				resp.Confirmed = true
+				return resp, nil
+			}
+		case <-timer.C:
+			if last == nil {
+				return nil, status.Error(codes.DeadlineExceeded, "server did not confirm ability update")
+			}
+			fillAbilityResponse(resp, *last)
+			return resp, nil
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// fillAbilityResponse reports the abilities an UpdateAbilities carried.
+func fillAbilityResponse(resp *playerkitpb.SetPlayerAbilitiesResponse, data protocol.AbilityData) {
+	confirmed := baseAbilityValues(data)
+	resp.AbilityValues = confirmed
+	resp.Abilities = abilitiesToProto(confirmed)
+	resp.PermissionLevel = uint32(data.PlayerPermissions)
+}
+
+// applyAbilityChanges merges changes into the player's current abilities. A
+// complete set does not need the current values.
+func applyAbilityChanges(player uqdefines.PlayerUQReader, changes *playerkitpb.AbilityChanges) (uint32, error) {
+	fields := []struct {
+		value *bool
+		flag  uint32
+	}{
+		{changes.Build, protocol.AbilityBuild},
+		{changes.Mine, protocol.AbilityMine},
+		{changes.DoorsAndSwitches, protocol.AbilityDoorsAndSwitches},
+		{changes.OpenContainers, protocol.AbilityOpenContainers},
+		{changes.AttackPlayers, protocol.AbilityAttackPlayers},
+		{changes.AttackMobs, protocol.AbilityAttackMobs},
+		{changes.OperatorCommands, protocol.AbilityOperatorCommands},
+		{changes.Teleport, protocol.AbilityTeleport},
+	}
+	var values uint32
+	for _, field := range fields {
+		if field.value == nil {
+			current, ok := player.GetValues()
+			if !ok {
+				return 0, errors.New("ability values unavailable")
+			}
+			values = current
+			break
+		}
+	}
+	for _, field := range fields {
+		if field.value == nil {
+			continue
+		}
+		if *field.value {
+			values |= field.flag
+		} else {
+			values &^= field.flag
+		}
+	}
+	return values, nil
+}
+
+// baseAbilityValues returns the ability values of the base layer, which is
+// the one RequestPermissions changes.
+func baseAbilityValues(data protocol.AbilityData) uint32 {
+	for _, layer := range data.Layers {
+		if layer.Type == protocol.AbilityLayerTypeBase {
+			return layer.Values
+		}
+	}
+	return 0
+}
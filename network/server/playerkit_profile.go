@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	fpacket "github.com/Yeah114/FunShuttler/core/minecraft/protocol/packet"
+	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
+	playerkitpb "github.com/Yeah114/tempest-core/network_api/playerkit"
+)
+
+// GetPlayerProfile returns everything known about a player in one call.
+// Fields the UQHolder has not received yet are left at their zero value.
+func (s *PlayerKitService) GetPlayerProfile(ctx context.Context, req *playerkitpb.GetPlayerProfileRequest) (*playerkitpb.PlayerProfile, error) {
+	uuidStr := strings.TrimSpace(req.GetUuidStr())
+	player, err := s.ensurePlayer(uuidStr)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return playerProfile(uuidStr, player), nil
+}
+
+// GetPlayerProfiles returns the profiles of several players, or of every
+// online player when no UUID is given. Unknown UUIDs are listed in missing
+// instead of failing the whole call.
+func (s *PlayerKitService) GetPlayerProfiles(ctx context.Context, req *playerkitpb.GetPlayerProfilesRequest) (*playerkitpb.GetPlayerProfilesResponse, error) {
+	resp := &playerkitpb.GetPlayerProfilesResponse{}
+	if len(req.GetUuidStrs()) == 0 {
+		players, err := s.state.SnapshotPlayers()
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		for _, player := range players {
+			uuidStr, ok := player.GetUUIDString()
+			if !ok {
+				continue
+			}
+			resp.Profiles = append(resp.Profiles, playerProfile(uuidStr, player))
+		}
+		return resp, nil
+	}
+	seen := make(map[string]bool, len(req.GetUuidStrs()))
+	for _, raw := range req.GetUuidStrs() {
+		uuidStr := strings.TrimSpace(raw)
+		if uuidStr == "" || seen[uuidStr] {
+			continue
+		}
+		seen[uuidStr] = true
+		player, err := s.ensurePlayer(uuidStr)
+		if err != nil {
+			if errors.As(err, new(*notFoundError)) {
+				resp.Missing = append(resp.Missing, uuidStr)
+				continue
+			}
+			return nil, toStatusError(err)
+		}
+		resp.Profiles = append(resp.Profiles, playerProfile(uuidStr, player))
+	}
+	return resp, nil
+}
+
+func playerProfile(uuidStr string, player uqdefines.PlayerUQReader) *playerkitpb.PlayerProfile {
+	profile := &playerkitpb.PlayerProfile{
+		UuidStr: uuidStr,
+		Online:  player.StillOnline(),
+	}
+	profile.Name, _ = player.GetUsername()
+	profile.EntityUniqueId, _ = player.GetEntityUniqueID()
+	profile.EntityRuntimeId, _ = player.GetEntityRuntimeID()
+	if ts, ok := player.GetLoginTime(); ok {
+		profile.LoginTime = ts.Unix()
+	}
+	profile.PlatformChatId, _ = player.GetPlatformChatID()
+	profile.BuildPlatform, _ = player.GetBuildPlatform()
+	profile.SkinId, _ = player.GetSkinID()
+	profile.DeviceId, _ = player.GetDeviceID()
+	if perm, ok := player.GetCommandPermissions(); ok {
+		profile.CommandPermissionLevel = uint32(perm)
+		profile.IsOp = perm >= byte(fpacket.CommandPermissionLevelAdmin)
+	}
+	if values, ok := player.GetValues(); ok {
+		profile.AbilityValues = values
+		profile.Abilities = abilitiesToProto(values)
+		profile.IsOp = profile.IsOp || values&protocol.AbilityOperatorCommands != 0
+	}
+	if meta, ok := player.GetEntityMetadata(); ok {
+		profile.Metadata = metadataToProto(meta)
+	}
+	return profile
+}
+
+func abilitiesToProto(values uint32) *playerkitpb.PlayerAbilities {
+	has := func(flag uint32) bool { return values&flag != 0 }
+	return &playerkitpb.PlayerAbilities{
+		Build:             has(protocol.AbilityBuild),
+		Mine:              has(protocol.AbilityMine),
+		DoorsAndSwitches:  has(protocol.AbilityDoorsAndSwitches),
+		OpenContainers:    has(protocol.AbilityOpenContainers),
+		AttackPlayers:     has(protocol.AbilityAttackPlayers),
+		AttackMobs:        has(protocol.AbilityAttackMobs),
+		OperatorCommands:  has(protocol.AbilityOperatorCommands),
+		Teleport:          has(protocol.AbilityTeleport),
+		Invulnerable:      has(protocol.AbilityInvulnerable),
+		Flying:            has(protocol.AbilityFlying),
+		MayFly:            has(protocol.AbilityMayFly),
+		InstantBuild:      has(protocol.AbilityInstantBuild),
+		Lightning:         has(protocol.AbilityLightning),
+		Muted:             has(protocol.AbilityMuted),
+		WorldBuilder:      has(protocol.AbilityWorldBuilder),
+		NoClip:            has(protocol.AbilityNoClip),
+		PrivilegedBuilder: has(protocol.AbilityPrivilegedBuilder),
+	}
+}
+
+// metadataToProto decodes the commonly used entity data keys. The complete
+// map is kept in raw_json for everything else; raw_json is left empty when a
+// value cannot be encoded, so one odd entry does not hide the whole profile.
+func metadataToProto(meta map[uint32]any) *playerkitpb.PlayerMetadata {
+	flag := func(index int) bool {
+		key, bit := protocol.EntityDataKeyFlags, index
+		if index >= 64 {
+			key, bit = protocol.EntityDataKeyFlagsExtended, index-64
+		}
+		flags, _ := metadataInt(meta[key])
+		return flags&(1<<uint(bit)) != 0
+	}
+	out := &playerkitpb.PlayerMetadata{
+		OnFire:    flag(protocol.EntityDataFlagOnFire),
+		Sneaking:  flag(protocol.EntityDataFlagSneaking),
+		Riding:    flag(protocol.EntityDataFlagRiding),
+		Sprinting: flag(protocol.EntityDataFlagSprinting),
+		UsingItem: flag(protocol.EntityDataFlagUsingItem),
+		Invisible: flag(protocol.EntityDataFlagInvisible),
+		Immobile:  flag(protocol.EntityDataFlagImmobile),
+		Gliding:   flag(protocol.EntityDataFlagGliding),
+		Swimming:  flag(protocol.EntityDataFlagSwimming),
+	}
+	if data, err := json.Marshal(meta); err == nil {
+		out.RawJson = string(data)
+	}
+	out.NameTag, _ = meta[protocol.EntityDataKeyName].(string)
+	out.ScoreTag, _ = meta[protocol.EntityDataKeyScore].(string)
+	if v, ok := metadataInt(meta[protocol.EntityDataKeyVariant]); ok {
+		out.Variant = int32(v)
+	}
+	if v, ok := metadataInt(meta[protocol.EntityDataKeyAirSupply]); ok {
+		out.AirSupply = int32(v)
+	}
+	if v, ok := metadataInt(meta[protocol.EntityDataKeyMaxAirSupply]); ok {
+		out.MaxAirSupply = int32(v)
+	}
+	if v, ok := meta[protocol.EntityDataKeyScale].(float32); ok {
+		out.Scale = v
+	}
+	if v, ok := meta[protocol.EntityDataKeyWidth].(float32); ok {
+		out.Width = v
+	}
+	if v, ok := meta[protocol.EntityDataKeyHeight].(float32); ok {
+		out.Height = v
+	}
+	return out
+}
+
+// metadataInt widens the integer types entity data values are stored as.
+func metadataInt(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint8:
+		return int64(n), true
+	case uint16:
+		return int64(n), true
+	case uint32:
+		return int64(n), true
+	case uint64:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
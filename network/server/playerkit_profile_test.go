@@ -0,0 +1,27 @@
+package server
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+)
+
+func TestMetadataToProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		meta    map[uint32]any
+		nameTag string
+		rawJSON bool
+	}{
+		{name: "encodable", meta: map[uint32]any{protocol.EntityDataKeyName: "Steve"}, nameTag: "Steve", rawJSON: true},
+		// NaN has no JSON encoding; the decoded fields must survive it.
+		{name: "unencodable", meta: map[uint32]any{protocol.EntityDataKeyName: "Steve", protocol.EntityDataKeyScale: float32(math.NaN())}, nameTag: "Steve"},
+	}
+	for _, tt := range tests {
+		got := metadataToProto(tt.meta)
+		if got.GetNameTag() != tt.nameTag || (got.GetRawJson() != "") != tt.rawJSON {
+			t.Errorf("%s: name tag %q raw json %q; want name tag %q, raw json %v", tt.name, got.GetNameTag(), got.GetRawJson(), tt.nameTag, tt.rawJSON)
+		}
+	}
+}
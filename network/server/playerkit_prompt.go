@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Yeah114/tempest-core/network/app"
+	playerkitpb "github.com/Yeah114/tempest-core/network_api/playerkit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPromptAttempts = 3
+	maxPromptAttempts     = 10
+	defaultInvalidMessage = "Invalid answer: %s"
+)
+
+// promptRetrieverPrefix starts the retriever IDs of PlayerPrompt
+// interceptions. Clients may not use it, so the IDs cannot collide with
+// theirs and clients cannot wait on or cancel a prompt.
+const promptRetrieverPrefix = "prompt#"
+
+// promptSeq numbers the interceptions made by PlayerPrompt.
+var promptSeq atomic.Uint64
+
+// promptValidator checks an answer. It returns the reason for rejecting it,
+// or "" with the parsed number and choice index.
+type promptValidator func(answer string) (reason string, number float64, choice int32)
+
+// PlayerPrompt asks a player a question and waits for an answer that passes
+// validation, re-prompting after invalid answers. Timeouts and running out of
+// attempts are reported in the response rather than as errors.
+func (s *PlayerKitService) PlayerPrompt(ctx context.Context, req *playerkitpb.PlayerPromptRequest) (*playerkitpb.PlayerPromptResponse, error) {
+	question := strings.TrimSpace(req.GetQuestion())
+	if question == "" {
+		return nil, status.Error(codes.InvalidArgument, "question required")
+	}
+	validate, err := newPromptValidator(req)
+	if err != nil {
+		return nil, err
+	}
+	attempts := int(req.GetMaxAttempts())
+	if attempts == 0 {
+		attempts = defaultPromptAttempts
+	}
+	if attempts > maxPromptAttempts {
+		return nil, status.Errorf(codes.InvalidArgument, "max attempts exceeds %d", maxPromptAttempts)
+	}
+	timeout := defaultInputTimeout
+	if ms := req.GetTimeoutMs(); ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+	if timeout > maxInputTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "timeout exceeds %s", maxInputTimeout)
+	}
+	invalidMessage := req.GetInvalidMessage()
+	if strings.TrimSpace(invalidMessage) == "" {
+		invalidMessage = defaultInvalidMessage
+	}
+	command, action := "tellraw", ""
+	if req.GetChannel() == playerkitpb.PlayerPromptRequest_ACTION_BAR {
+		command, action = "titleraw", "actionbar"
+	}
+
+	uuidStr := strings.TrimSpace(req.GetUuidStr())
+	player, err := s.ensurePlayer(uuidStr)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	name, ok := player.GetUsername()
+	if !ok || name == "" {
+		return nil, status.Error(codes.Internal, "player name unavailable")
+	}
+
+	deadline := time.Now().Add(timeout)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	inputs := s.state.Inputs()
+	resp := &playerkitpb.PlayerPromptResponse{ChoiceIndex: -1}
+	message := question
+	for resp.Attempts < uint32(attempts) {
+		// Register before prompting so a quick reply is not missed.
+		retrieverID := fmt.Sprintf("%s%d", promptRetrieverPrefix, promptSeq.Add(1))
+		if err := inputs.Register(retrieverID, uuidStr, name, time.Until(deadline), req.GetSuppress()); err != nil {
+			return nil, toStatusError(err)
+		}
+		if _, err := s.sendMessage(ctx, uuidStr, message, command, action); err != nil {
+			inputs.Cancel(retrieverID)
+			return nil, err
+		}
+		input, err := inputs.Wait(ctx, retrieverID)
+		switch {
+		case errors.Is(err, app.ErrInputTimeout), errors.Is(err, context.DeadlineExceeded):
+			inputs.Cancel(retrieverID)
+			resp.Status = playerkitpb.PlayerPromptResponse_TIMEOUT
+			return resp, nil
+		case err != nil:
+			inputs.Cancel(retrieverID)
+			return nil, toStatusError(err)
+		}
+		resp.Attempts++
+		resp.Answer = strings.TrimSpace(input.Message)
+		reason, number, choice := validate(resp.Answer)
+		if reason == "" {
+			resp.Status = playerkitpb.PlayerPromptResponse_ANSWERED
+			resp.Number = number
+			resp.ChoiceIndex = choice
+			return resp, nil
+		}
+		message = strings.ReplaceAll(invalidMessage, "%s", reason) + "\n" + question
+	}
+	resp.Status = playerkitpb.PlayerPromptResponse_EXHAUSTED
+	return resp, nil
+}
+
+func newPromptValidator(req *playerkitpb.PlayerPromptRequest) (promptValidator, error) {
+	switch v := req.GetValidation().(type) {
+	case nil:
+		return func(answer string) (string, float64, int32) {
+			if answer == "" {
+				return "answer is empty", 0, -1
+			}
+			return "", 0, -1
+		}, nil
+	case *playerkitpb.PlayerPromptRequest_Regex:
+		pattern, err := regexp.Compile(`^(?:` + v.Regex + `)$`)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid regex: %v", err)
+		}
+		return func(answer string) (string, float64, int32) {
+			if !pattern.MatchString(answer) {
+				return "answer does not have the expected format", 0, -1
+			}
+			return "", 0, -1
+		}, nil
+	case *playerkitpb.PlayerPromptRequest_Number:
+		r := v.Number
+		if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+			return nil, status.Error(codes.InvalidArgument, "number range min exceeds max")
+		}
+		return func(answer string) (string, float64, int32) {
+			n, err := strconv.ParseFloat(answer, 64)
+			if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+				return "answer must be a number", 0, -1
+			}
+			if r.GetInteger() && n != math.Trunc(n) {
+				return "answer must be a whole number", 0, -1
+			}
+			if (r.Min != nil && n < *r.Min) || (r.Max != nil && n > *r.Max) {
+				return "answer must be " + describeRange(r), 0, -1
+			}
+			return "", n, -1
+		}, nil
+	case *playerkitpb.PlayerPromptRequest_Choices:
+		choices := v.Choices.GetChoices()
+		if len(choices) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "choices required")
+		}
+		caseSensitive := v.Choices.GetCaseSensitive()
+		return func(answer string) (string, float64, int32) {
+			for i, choice := range choices {
+				if answer == choice || (!caseSensitive && strings.EqualFold(answer, choice)) {
+					return "", 0, int32(i)
+				}
+			}
+			if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(choices) {
+				return "", 0, int32(n - 1)
+			}
+			return "answer must be one of " + strings.Join(choices, ", "), 0, -1
+		}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown validation")
+	}
+}
+
+func describeRange(r *playerkitpb.PromptNumberRange) string {
+	format := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	switch {
+	case r.Min != nil && r.Max != nil:
+		return fmt.Sprintf("between %s and %s", format(*r.Min), format(*r.Max))
+	case r.Min != nil:
+		return "at least " + format(*r.Min)
+	default:
+		return "at most " + format(*r.Max)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/Yeah114/tempest-core/network/app"
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
 	responsepb "github.com/Yeah114/tempest-core/network_api/response"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -64,6 +65,18 @@ func statusCodeForError(err error) codes.Code {
 		return codes.AlreadyExists
 	case errors.Is(err, app.ErrPlayerUUIDUnknown):
 		return codes.FailedPrecondition
+	case errors.Is(err, cmdbuild.ErrInvalidValue):
+		return codes.InvalidArgument
+	case errors.Is(err, app.ErrCommandQueueFull):
+		return codes.ResourceExhausted
+	case errors.Is(err, app.ErrRetrieverExists):
+		return codes.AlreadyExists
+	case errors.Is(err, app.ErrRetrieverUnknown):
+		return codes.NotFound
+	case errors.Is(err, app.ErrInputTimeout):
+		return codes.DeadlineExceeded
+	case errors.Is(err, app.ErrInputCancelled):
+		return codes.Canceled
 	case errors.As(err, new(*notFoundError)):
 		return codes.NotFound
 	case errors.Is(err, context.Canceled):
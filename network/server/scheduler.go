@@ -0,0 +1,252 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Yeah114/tempest-core/network/app"
+	"github.com/Yeah114/tempest-core/network/app/scheduler"
+	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	responsepb "github.com/Yeah114/tempest-core/network_api/response"
+	schedulerpb "github.com/Yeah114/tempest-core/network_api/scheduler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// schedulerFile is the name of the job store inside the data directory.
+const schedulerFile = "scheduler.json"
+
+// SchedulerService manages scheduled command jobs.
+type SchedulerService struct {
+	schedulerpb.UnimplementedSchedulerServiceServer
+	state     *app.FatalderState
+	commands  *CommandService
+	scheduler *scheduler.Scheduler
+}
+
+// NewSchedulerService loads persisted jobs and starts the scheduler.
+func NewSchedulerService(state *app.FatalderState) (*SchedulerService, error) {
+	s := &SchedulerService{
+		state:    state,
+		commands: NewCommandService(state),
+	}
+	sched, err := scheduler.New(state.DataPath(schedulerFile), s.runJob)
+	if err != nil {
+		return nil, err
+	}
+	s.scheduler = sched
+	return s, nil
+}
+
+// Close stops the scheduler and waits for running jobs.
+func (s *SchedulerService) Close() {
+	if s != nil && s.scheduler != nil {
+		s.scheduler.Close()
+	}
+}
+
+func (s *SchedulerService) CreateJob(ctx context.Context, req *schedulerpb.CreateJobRequest) (*schedulerpb.Job, error) {
+	if len(req.GetCommands()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "commands required")
+	}
+	if len(req.GetCommands()) > maxBatchCommands {
+		return nil, status.Errorf(codes.InvalidArgument, "job of %d commands exceeds limit %d", len(req.GetCommands()), maxBatchCommands)
+	}
+	// Reject commands the caller could not send directly; runs are checked
+	// again against the caller's role.
+	if _, err := s.commands.resolveBatch(ctx, req.GetCommands()); err != nil {
+		return nil, err
+	}
+	job := scheduler.Job{
+		Name:        req.GetName(),
+		Cron:        strings.TrimSpace(req.GetCron()),
+		Interval:    time.Duration(req.GetIntervalMs()) * time.Millisecond,
+		Timezone:    strings.TrimSpace(req.GetTimezone()),
+		StopOnError: req.GetStopOnError(),
+		Role:        s.commands.policyRole(ctx),
+		Paused:      req.GetPaused(),
+	}
+	for _, cmd := range req.GetCommands() {
+		job.Commands = append(job.Commands, scheduler.Command{
+			Cmd:       strings.TrimSpace(cmd.GetCmd()),
+			Mode:      cmd.GetMode().String(),
+			RuntimeID: strings.TrimSpace(cmd.GetRuntimeId()),
+		})
+	}
+	created, err := s.scheduler.Create(job)
+	if err != nil {
+		return nil, schedulerStatusError(err)
+	}
+	return jobToProto(created), nil
+}
+
+func (s *SchedulerService) ListJobs(ctx context.Context, req *schedulerpb.ListJobsRequest) (*schedulerpb.ListJobsResponse, error) {
+	jobs := s.scheduler.List()
+	resp := &schedulerpb.ListJobsResponse{Jobs: make([]*schedulerpb.Job, 0, len(jobs))}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, jobToProto(job))
+	}
+	return resp, nil
+}
+
+func (s *SchedulerService) GetJob(ctx context.Context, req *schedulerpb.GetJobRequest) (*schedulerpb.Job, error) {
+	job, err := s.scheduler.Get(strings.TrimSpace(req.GetId()))
+	if err != nil {
+		return nil, schedulerStatusError(err)
+	}
+	return jobToProto(job), nil
+}
+
+func (s *SchedulerService) PauseJob(ctx context.Context, req *schedulerpb.PauseJobRequest) (*schedulerpb.Job, error) {
+	id := strings.TrimSpace(req.GetId())
+	if err := s.checkJobOwner(ctx, id); err != nil {
+		return nil, err
+	}
+	job, err := s.scheduler.SetPaused(id, req.GetPaused())
+	if err != nil {
+		return nil, schedulerStatusError(err)
+	}
+	return jobToProto(job), nil
+}
+
+func (s *SchedulerService) DeleteJob(ctx context.Context, req *schedulerpb.DeleteJobRequest) (*responsepb.GeneralResponse, error) {
+	id := strings.TrimSpace(req.GetId())
+	if err := s.checkJobOwner(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.scheduler.Delete(id); err != nil {
+		return nil, schedulerStatusError(err)
+	}
+	return generalSuccess(""), nil
+}
+
+// checkJobOwner lets a job be paused, resumed or deleted by callers of the
+// policy role that created it, and by policy managers.
+func (s *SchedulerService) checkJobOwner(ctx context.Context, id string) error {
+	job, err := s.scheduler.Get(id)
+	if err != nil {
+		return schedulerStatusError(err)
+	}
+	if job.Role != s.commands.policyRole(ctx) && !s.state.CommandPolicy().CanManage(policyToken(ctx)) {
+		return status.Error(codes.PermissionDenied, "job belongs to another policy role")
+	}
+	return nil
+}
+
+// runJob executes a job as a command batch on the automation lane. Runs that
+// fall while the bot is disconnected are skipped. The batch does not wait
+// for command output, so a command counts as succeeded once it was sent; a
+// command the server rejects still shows up as a success.
+func (s *SchedulerService) runJob(ctx context.Context, job scheduler.Job) scheduler.Result {
+	if _, err := s.state.Commands(); err != nil {
+		return scheduler.Result{Status: scheduler.StatusSkipped, Message: err.Error()}
+	}
+	req := &commandpb.SendCommandBatchRequest{
+		StopOnError: job.StopOnError,
+		Pacing:      &commandpb.CommandPacing{Priority: commandpb.CommandPriority_AUTOMATION},
+	}
+	for _, cmd := range job.Commands {
+		req.Commands = append(req.Commands, &commandpb.BatchCommand{
+			Cmd:       cmd.Cmd,
+			Mode:      commandpb.CommandMode(commandpb.CommandMode_value[cmd.Mode]),
+			RuntimeId: cmd.RuntimeID,
+		})
+	}
+
+	var result scheduler.Result
+	err := s.commands.runBatch(withPolicyRole(ctx, job.Role), req, func(r *commandpb.BatchCommandResult) error {
+		switch r.GetStatus() {
+		case commandpb.BatchCommandResult_SUCCESS:
+			result.Succeeded++
+		case commandpb.BatchCommandResult_FAILED:
+			result.Failed++
+			if result.Message == "" {
+				result.Message = fmt.Sprintf("command %d: %s", r.GetIndex(), r.GetErrorMsg())
+			}
+		default:
+			result.Skipped++
+		}
+		return nil
+	})
+	switch {
+	case status.Code(err) == codes.FailedPrecondition:
+		// The session dropped between the connection check and the batch.
+		result.Status = scheduler.StatusSkipped
+		result.Message = status.Convert(err).Message()
+	case err != nil:
+		result.Status = scheduler.StatusFailed
+		result.Message = status.Convert(err).Message()
+	case result.Failed > 0:
+		result.Status = scheduler.StatusFailed
+	case result.Succeeded == 0:
+		result.Status = scheduler.StatusSkipped
+	default:
+		result.Status = scheduler.StatusSucceeded
+	}
+	return result
+}
+
+func schedulerStatusError(err error) error {
+	switch {
+	case errors.Is(err, scheduler.ErrJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, scheduler.ErrInvalidJob), errors.Is(err, scheduler.ErrInvalidSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return toStatusError(err)
+	}
+}
+
+func jobToProto(job scheduler.Job) *schedulerpb.Job {
+	out := &schedulerpb.Job{
+		Id:          job.ID,
+		Name:        job.Name,
+		Timezone:    job.Timezone,
+		StopOnError: job.StopOnError,
+		Paused:      job.Paused,
+		Role:        job.Role,
+		CreatedAtMs: job.CreatedAt.UnixMilli(),
+		Runs:        job.Runs,
+	}
+	if job.Cron != "" {
+		out.Schedule = &schedulerpb.Job_Cron{Cron: job.Cron}
+	} else {
+		out.Schedule = &schedulerpb.Job_IntervalMs{IntervalMs: uint64(job.Interval / time.Millisecond)}
+	}
+	if !job.NextRun.IsZero() && !job.Paused {
+		out.NextRunMs = job.NextRun.UnixMilli()
+	}
+	for _, cmd := range job.Commands {
+		out.Commands = append(out.Commands, &commandpb.BatchCommand{
+			Cmd:       cmd.Cmd,
+			Mode:      commandpb.CommandMode(commandpb.CommandMode_value[cmd.Mode]),
+			RuntimeId: cmd.RuntimeID,
+		})
+	}
+	if r := job.LastResult; r != nil {
+		out.LastResult = &schedulerpb.JobResult{
+			Status:      jobStatusToProto(r.Status),
+			StartedAtMs: r.StartedAt.UnixMilli(),
+			DurationMs:  r.Duration.Milliseconds(),
+			Message:     r.Message,
+			Succeeded:   uint32(r.Succeeded),
+			Failed:      uint32(r.Failed),
+			Skipped:     uint32(r.Skipped),
+		}
+	}
+	return out
+}
+
+func jobStatusToProto(st scheduler.Status) schedulerpb.JobResult_Status {
+	switch st {
+	case scheduler.StatusFailed:
+		return schedulerpb.JobResult_FAILED
+	case scheduler.StatusSkipped:
+		return schedulerpb.JobResult_SKIPPED
+	default:
+		return schedulerpb.JobResult_SUCCEEDED
+	}
+}
@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	"github.com/Yeah114/tempest-core/network/app"
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+	scoreboardpb "github.com/Yeah114/tempest-core/network_api/scoreboard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	langObjectivesEntry   = "commands.scoreboard.objectives.list.entry"
+	langPlayerScoresCount = "commands.scoreboard.players.list.player.count"
+	langPlayerScoresEntry = "commands.scoreboard.players.list.player.entry"
+)
+
+// ScoreboardService exposes typed scoreboard reads and writes. Reads of
+// displayed objectives come from the packet cache unless refresh is set;
+// everything else is answered through command output.
+type ScoreboardService struct {
+	scoreboardpb.UnimplementedScoreboardServiceServer
+	state    *app.FatalderState
+	commands *CommandService
+}
+
+// NewScoreboardService constructs a scoreboard service bound to shared state.
+func NewScoreboardService(state *app.FatalderState) *ScoreboardService {
+	return &ScoreboardService{state: state, commands: NewCommandService(state)}
+}
+
+func (s *ScoreboardService) ListObjectives(ctx context.Context, req *scoreboardpb.ListObjectivesRequest) (*scoreboardpb.ListObjectivesResponse, error) {
+	output, err := s.commands.queryCommand(ctx, "scoreboard objectives list")
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	slots := make(map[string][]string)
+	for _, o := range s.state.Scoreboard().Objectives() {
+		slots[o.Name] = o.Slots
+	}
+	resp := &scoreboardpb.ListObjectivesResponse{}
+	for _, params := range outputMessages(output, langObjectivesEntry) {
+		if len(params) < 3 {
+			continue
+		}
+		resp.Objectives = append(resp.Objectives, &scoreboardpb.Objective{
+			Name:         params[0],
+			DisplayName:  params[1],
+			Criteria:     params[2],
+			DisplaySlots: slots[params[0]],
+		})
+	}
+	return resp, nil
+}
+
+func (s *ScoreboardService) GetScore(ctx context.Context, req *scoreboardpb.GetScoreRequest) (*scoreboardpb.GetScoreResponse, error) {
+	objective, target, err := scoreArgs(req.GetObjective(), req.GetTarget())
+	if err != nil {
+		return nil, err
+	}
+	// The cache only carries displayed entries, which can leave out offline
+	// players, so a miss falls through to the command.
+	if !req.GetRefresh() {
+		if scores, ok := s.cachedScores(objective); ok {
+			for _, score := range scores {
+				if strings.EqualFold(score.GetName(), target) {
+					return &scoreboardpb.GetScoreResponse{
+						Source: scoreboardpb.ScoreSource_CACHE,
+						Found:  true,
+						Value:  score.GetValue(),
+					}, nil
+				}
+			}
+		}
+	}
+	quoted, err := cmdbuild.Quote(target)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	output, err := s.commands.queryCommand(ctx, "scoreboard players list "+quoted)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &scoreboardpb.GetScoreResponse{Source: scoreboardpb.ScoreSource_COMMAND}
+	for _, score := range parsePlayerScores(output.OutputMessages) {
+		if score.GetObjective() == objective {
+			resp.Found = true
+			resp.Value = score.GetValue()
+			break
+		}
+	}
+	return resp, nil
+}
+
+func (s *ScoreboardService) SetScore(ctx context.Context, req *scoreboardpb.SetScoreRequest) (*scoreboardpb.ScoreValue, error) {
+	cmd, err := scoreCommand("set", req.GetObjective(), req.GetTarget(), req.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	output, err := s.commands.requestCommand(ctx, cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := outputError(output); err != nil {
+		return nil, err
+	}
+	return &scoreboardpb.ScoreValue{Value: req.GetValue()}, nil
+}
+
+func (s *ScoreboardService) AddScore(ctx context.Context, req *scoreboardpb.AddScoreRequest) (*scoreboardpb.ScoreValue, error) {
+	action, delta := "add", req.GetDelta()
+	if delta == math.MinInt32 {
+		// Its negation does not fit scoreboard remove's int32 argument.
+		return nil, status.Error(codes.InvalidArgument, "delta out of range")
+	}
+	if delta < 0 {
+		action, delta = "remove", -delta
+	}
+	cmd, err := scoreCommand(action, req.GetObjective(), req.GetTarget(), delta)
+	if err != nil {
+		return nil, err
+	}
+	output, err := s.commands.requestCommand(ctx, cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := outputError(output); err != nil {
+		return nil, err
+	}
+	// Both add and remove answer with "... (now <value>)" as the last
+	// parameter.
+	for _, m := range output.OutputMessages {
+		if len(m.Parameters) == 4 && strings.HasPrefix(strings.TrimPrefix(m.Message, "%"), "commands.scoreboard.players.") {
+			if v, err := strconv.ParseInt(m.Parameters[3], 10, 32); err == nil {
+				return &scoreboardpb.ScoreValue{Value: int32(v)}, nil
+			}
+		}
+	}
+	return nil, status.Error(codes.Internal, "new score missing from command output")
+}
+
+func (s *ScoreboardService) GetObjective(ctx context.Context, req *scoreboardpb.GetObjectiveRequest) (*scoreboardpb.GetObjectiveResponse, error) {
+	objective := strings.TrimSpace(req.GetObjective())
+	if objective == "" {
+		return nil, status.Error(codes.InvalidArgument, "objective required")
+	}
+	if !req.GetRefresh() {
+		if scores, ok := s.cachedScores(objective); ok {
+			return &scoreboardpb.GetObjectiveResponse{
+				Objective: objective,
+				Scores:    scores,
+				Source:    scoreboardpb.ScoreSource_CACHE,
+			}, nil
+		}
+	}
+	// "*" lists every tracked participant with all of their scores.
+	output, err := s.commands.queryCommand(ctx, "scoreboard players list *")
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &scoreboardpb.GetObjectiveResponse{Objective: objective, Source: scoreboardpb.ScoreSource_COMMAND}
+	for _, score := range parsePlayerScores(output.OutputMessages) {
+		if score.GetObjective() == objective {
+			resp.Scores = append(resp.Scores, score)
+		}
+	}
+	sortScores(resp.Scores)
+	return resp, nil
+}
+
+// cachedScores converts the cache entries of objective, naming player
+// entries after the online player with the same unique ID.
+func (s *ScoreboardService) cachedScores(objective string) ([]*scoreboardpb.Score, bool) {
+	entries, ok := s.state.Scoreboard().Scores(objective)
+	if !ok {
+		return nil, false
+	}
+	names := make(map[int64]string)
+	if players, err := s.state.SnapshotPlayers(); err == nil {
+		for _, player := range players {
+			id, okID := player.GetEntityUniqueID()
+			name, okName := player.GetUsername()
+			if okID && okName {
+				names[id] = name
+			}
+		}
+	}
+	out := make([]*scoreboardpb.Score, 0, len(entries))
+	for _, e := range entries {
+		score := &scoreboardpb.Score{
+			Objective:      e.Objective,
+			Name:           e.Name,
+			Value:          e.Score,
+			Identity:       scoreboardpb.Score_Identity(e.Identity),
+			EntityUniqueId: e.EntityUniqueID,
+		}
+		if e.Identity == app.ScoreIdentityPlayer {
+			score.Name = names[e.EntityUniqueID]
+		}
+		out = append(out, score)
+	}
+	return out, true
+}
+
+// parsePlayerScores reads "scoreboard players list" output. Each
+// participant starts with a count line naming it, followed by one entry line
+// per objective.
+func parsePlayerScores(messages []protocol.CommandOutputMessage) []*scoreboardpb.Score {
+	var (
+		out     []*scoreboardpb.Score
+		current string
+	)
+	for _, m := range messages {
+		switch strings.TrimPrefix(m.Message, "%") {
+		case langPlayerScoresCount:
+			if len(m.Parameters) >= 2 {
+				current = m.Parameters[1]
+			}
+		case langPlayerScoresEntry:
+			if len(m.Parameters) < 3 {
+				continue
+			}
+			v, err := strconv.ParseInt(m.Parameters[0], 10, 32)
+			if err != nil {
+				continue
+			}
+			out = append(out, &scoreboardpb.Score{
+				Objective: m.Parameters[2],
+				Name:      current,
+				Value:     int32(v),
+			})
+		}
+	}
+	return out
+}
+
+func sortScores(scores []*scoreboardpb.Score) {
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].GetValue() != scores[j].GetValue() {
+			return scores[i].GetValue() > scores[j].GetValue()
+		}
+		return scores[i].GetName() < scores[j].GetName()
+	})
+}
+
+func scoreArgs(objective, target string) (string, string, error) {
+	objective = strings.TrimSpace(objective)
+	target = strings.TrimSpace(target)
+	if objective == "" {
+		return "", "", status.Error(codes.InvalidArgument, "objective required")
+	}
+	if target == "" {
+		return "", "", status.Error(codes.InvalidArgument, "target required")
+	}
+	return objective, target, nil
+}
+
+func scoreCommand(action, objective, target string, value int32) (string, error) {
+	objective, target, err := scoreArgs(objective, target)
+	if err != nil {
+		return "", err
+	}
+	quotedTarget, err := cmdbuild.Quote(target)
+	if err != nil {
+		return "", toStatusError(err)
+	}
+	quotedObjective, err := cmdbuild.Quote(objective)
+	if err != nil {
+		return "", toStatusError(err)
+	}
+	return fmt.Sprintf("scoreboard players %s %s %s %d", action, quotedTarget, quotedObjective, value), nil
+}
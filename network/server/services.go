@@ -2,32 +2,66 @@ package server
 
 import (
 	"github.com/Yeah114/tempest-core/network/app"
+	blockpb "github.com/Yeah114/tempest-core/network_api/block"
+	chatcommandpb "github.com/Yeah114/tempest-core/network_api/chatcommand"
 	commandpb "github.com/Yeah114/tempest-core/network_api/command"
+	entitypb "github.com/Yeah114/tempest-core/network_api/entity"
 	listenerpb "github.com/Yeah114/tempest-core/network_api/listener"
+	permissionpb "github.com/Yeah114/tempest-core/network_api/permission"
 	playerkitpb "github.com/Yeah114/tempest-core/network_api/playerkit"
 	reversalerpb "github.com/Yeah114/tempest-core/network_api/reversaler"
+	schedulerpb "github.com/Yeah114/tempest-core/network_api/scheduler"
+	scoreboardpb "github.com/Yeah114/tempest-core/network_api/scoreboard"
+	tagpb "github.com/Yeah114/tempest-core/network_api/tag"
 	utilspb "github.com/Yeah114/tempest-core/network_api/utils"
+	worldpb "github.com/Yeah114/tempest-core/network_api/world"
 	"google.golang.org/grpc"
 )
 
 // Services bundles all gRPC handlers.
 type Services struct {
-	Command    *CommandService
-	Listener   *ListenerService
-	PlayerKit  *PlayerKitService
-	Reversaler *ReversalerService
-	Utils      *UtilsService
+	Block       *BlockService
+	ChatCommand *ChatCommandService
+	Command     *CommandService
+	Entity      *EntityService
+	Listener    *ListenerService
+	Permission  *PermissionService
+	PlayerKit   *PlayerKitService
+	Reversaler  *ReversalerService
+	Scheduler   *SchedulerService
+	Scoreboard  *ScoreboardService
+	Tag         *TagService
+	Utils       *UtilsService
+	World       *WorldService
 }
 
-// NewServices wires up every service against shared state.
-func NewServices(state *app.FatalderState) *Services {
-	return &Services{
-		Command:    NewCommandService(state),
-		Listener:   NewListenerService(state),
-		PlayerKit:  NewPlayerKitService(state),
-		Reversaler: NewReversalerService(state),
-		Utils:      NewUtilsService(state),
+// NewServices wires up every service against shared state. It fails when
+// persisted service data cannot be loaded.
+func NewServices(state *app.FatalderState) (*Services, error) {
+	scheduler, err := NewSchedulerService(state)
+	if err != nil {
+		return nil, err
+	}
+	permission, err := NewPermissionService(state)
+	if err != nil {
+		scheduler.Close()
+		return nil, err
 	}
+	return &Services{
+		Block:       NewBlockService(state),
+		ChatCommand: NewChatCommandService(state),
+		Command:     NewCommandService(state),
+		Entity:      NewEntityService(state),
+		Listener:    NewListenerService(state),
+		Permission:  permission,
+		PlayerKit:   NewPlayerKitService(state),
+		Reversaler:  NewReversalerService(state),
+		Scheduler:   scheduler,
+		Scoreboard:  NewScoreboardService(state),
+		Tag:         NewTagService(state),
+		Utils:       NewUtilsService(state),
+		World:       NewWorldService(state),
+	}, nil
 }
 
 // Register attaches all services to the provided gRPC server.
@@ -35,9 +69,26 @@ func (s *Services) Register(server *grpc.Server) {
 	if s == nil {
 		return
 	}
+	blockpb.RegisterBlockServiceServer(server, s.Block)
+	chatcommandpb.RegisterChatCommandServiceServer(server, s.ChatCommand)
 	commandpb.RegisterCommandServiceServer(server, s.Command)
+	entitypb.RegisterEntityServiceServer(server, s.Entity)
 	listenerpb.RegisterListenerServiceServer(server, s.Listener)
+	permissionpb.RegisterPermissionServiceServer(server, s.Permission)
 	playerkitpb.RegisterPlayerKitServiceServer(server, s.PlayerKit)
 	reversalerpb.RegisterFateReversalerServiceServer(server, s.Reversaler)
+	schedulerpb.RegisterSchedulerServiceServer(server, s.Scheduler)
+	scoreboardpb.RegisterScoreboardServiceServer(server, s.Scoreboard)
+	tagpb.RegisterTagServiceServer(server, s.Tag)
 	utilspb.RegisterUtilsServiceServer(server, s.Utils)
+	worldpb.RegisterWorldServiceServer(server, s.World)
+}
+
+// Close stops background work owned by the services.
+func (s *Services) Close() {
+	if s == nil {
+		return
+	}
+	s.Scheduler.Close()
+	s.Permission.Close()
 }
@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	"github.com/Yeah114/FunShuttler/game_control/game_interface"
+	"github.com/Yeah114/tempest-core/network/app"
+	"github.com/Yeah114/tempest-core/network/app/cmdbuild"
+	tagpb "github.com/Yeah114/tempest-core/network_api/tag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	langTagList   = "commands.tag.list.single.success"
+	maxTagChanges = 1024
+)
+
+// TagService reads and changes entity tags through the tag command.
+type TagService struct {
+	tagpb.UnimplementedTagServiceServer
+	state    *app.FatalderState
+	commands *CommandService
+}
+
+// NewTagService constructs a tag service bound to shared state.
+func NewTagService(state *app.FatalderState) *TagService {
+	return &TagService{state: state, commands: NewCommandService(state)}
+}
+
+func (s *TagService) ListTags(ctx context.Context, req *tagpb.ListTagsRequest) (*tagpb.ListTagsResponse, error) {
+	player, err := fetchPlayerByUUID(s.state, strings.TrimSpace(req.GetPlayerUuid()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	name, ok := player.GetUsername()
+	if !ok || name == "" {
+		return nil, status.Error(codes.Internal, "player name unavailable")
+	}
+	selector, err := cmdbuild.PlayerNamed(name).String()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	output, err := s.commands.queryCommand(ctx, "tag "+selector+" list")
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := outputError(output); err != nil {
+		return nil, err
+	}
+	resp := &tagpb.ListTagsResponse{Username: name}
+	for _, params := range outputMessages(output, langTagList) {
+		if len(params) < 3 {
+			continue
+		}
+		for _, tag := range strings.Split(params[2], ", ") {
+			if tag = stripFormatting(tag); tag != "" {
+				resp.Tags = append(resp.Tags, tag)
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (s *TagService) AddTags(ctx context.Context, req *tagpb.ModifyTagsRequest) (*tagpb.ModifyTagsResponse, error) {
+	return s.modifyTags(ctx, req, "add")
+}
+
+func (s *TagService) RemoveTags(ctx context.Context, req *tagpb.ModifyTagsRequest) (*tagpb.ModifyTagsResponse, error) {
+	return s.modifyTags(ctx, req, "remove")
+}
+
+func (s *TagService) FindTaggedPlayers(ctx context.Context, req *tagpb.FindTaggedPlayersRequest) (*tagpb.FindTaggedPlayersResponse, error) {
+	tag := strings.TrimSpace(req.GetTag())
+	if tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag required")
+	}
+	selector, err := cmdbuild.Selector{Target: cmdbuild.AllPlayers, Tags: []string{tag}}.String()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	output, err := s.commands.queryCommand(ctx, "testfor "+selector)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &tagpb.FindTaggedPlayersResponse{}
+	if output.SuccessCount == 0 {
+		return resp, nil
+	}
+	matched := make(map[string]bool)
+	for _, params := range outputMessages(output, langTestFor) {
+		if len(params) == 0 {
+			continue
+		}
+		for _, name := range strings.Split(params[0], ", ") {
+			matched[strings.TrimSpace(name)] = true
+		}
+	}
+	players, err := s.state.SnapshotPlayers()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	for _, player := range players {
+		name, ok := player.GetUsername()
+		if !ok || !matched[name] {
+			continue
+		}
+		uuid, _ := player.GetUUIDString()
+		resp.Players = append(resp.Players, &tagpb.TaggedPlayer{Uuid: uuid, Username: name})
+	}
+	return resp, nil
+}
+
+// modifyTags applies every tag to every target, one command per pair, and
+// reports each outcome. A failed pair does not stop the rest.
+func (s *TagService) modifyTags(ctx context.Context, req *tagpb.ModifyTagsRequest, action string) (*tagpb.ModifyTagsResponse, error) {
+	if len(req.GetTargets()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "targets required")
+	}
+	if len(req.GetTags()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tags required")
+	}
+	if n := len(req.GetTargets()) * len(req.GetTags()); n > maxTagChanges {
+		return nil, status.Errorf(codes.InvalidArgument, "%d tag changes exceed limit %d", n, maxTagChanges)
+	}
+	tags := make([]string, len(req.GetTags()))
+	for i, tag := range req.GetTags() {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "tag %d is empty", i)
+		}
+		quoted, err := cmdbuild.Quote(tag)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		tags[i] = quoted
+	}
+	selectors := make([]string, len(req.GetTargets()))
+	for i, target := range req.GetTargets() {
+		selector, err := s.tagTargetSelector(target)
+		if err != nil {
+			st := status.Convert(toStatusError(err))
+			return nil, status.Errorf(st.Code(), "target %d: %s", i, st.Message())
+		}
+		selectors[i] = selector
+	}
+
+	resp := &tagpb.ModifyTagsResponse{}
+	for i, selector := range selectors {
+		for j, tag := range tags {
+			change := &tagpb.TagChange{TargetIndex: uint32(i), Tag: strings.TrimSpace(req.GetTags()[j])}
+			cmd := fmt.Sprintf("tag %s %s %s", selector, action, tag)
+			output, err := s.commands.awaitCommandOutput(ctx, req.GetPacing(), 0, cmd, (*game_interface.Commands).SendWSCommandWithResp)
+			switch {
+			case err != nil && ctx.Err() != nil:
+				return nil, toStatusError(err)
+			case err != nil:
+				change.Message = status.Convert(toStatusError(err)).Message()
+			case output.SuccessCount == 0:
+				change.Message = status.Convert(outputError(output)).Message()
+			default:
+				change.Success = true
+				change.Affected = tagsAffected(output.OutputMessages)
+			}
+			if change.Success {
+				resp.Succeeded++
+			} else {
+				resp.Failed++
+			}
+			resp.Changes = append(resp.Changes, change)
+		}
+	}
+	return resp, nil
+}
+
+func (s *TagService) tagTargetSelector(target *tagpb.TagTarget) (string, error) {
+	switch t := target.GetTarget().(type) {
+	case *tagpb.TagTarget_PlayerUuid:
+		player, err := fetchPlayerByUUID(s.state, strings.TrimSpace(t.PlayerUuid))
+		if err != nil {
+			return "", err
+		}
+		name, ok := player.GetUsername()
+		if !ok || name == "" {
+			return "", status.Error(codes.Internal, "player name unavailable")
+		}
+		return cmdbuild.PlayerNamed(name).String()
+	case *tagpb.TagTarget_Username:
+		name := strings.TrimSpace(t.Username)
+		if name == "" {
+			return "", status.Error(codes.InvalidArgument, "username required")
+		}
+		return cmdbuild.PlayerNamed(name).String()
+	case *tagpb.TagTarget_Selector:
+		if strings.TrimSpace(t.Selector) == "" {
+			return "", status.Error(codes.InvalidArgument, "selector required")
+		}
+		return cmdbuild.RawSelector(t.Selector)
+	case *tagpb.TagTarget_Spec:
+		return selectorFromProto(t.Spec).String()
+	default:
+		return "", status.Error(codes.InvalidArgument, "target required")
+	}
+}
+
+// tagsAffected reads the entity count from a tag success message: the
+// .single variants name one entity, the .multiple ones carry a count.
+func tagsAffected(messages []protocol.CommandOutputMessage) uint32 {
+	var total uint32
+	for _, m := range messages {
+		key := strings.TrimPrefix(m.Message, "%")
+		switch {
+		case strings.HasSuffix(key, ".success.single"):
+			total++
+		case strings.HasSuffix(key, ".success.multiple") && len(m.Parameters) >= 2:
+			if n, err := strconv.ParseUint(m.Parameters[1], 10, 32); err == nil {
+				total += uint32(n)
+			}
+		}
+	}
+	return total
+}
+
+// stripFormatting removes § formatting codes and surrounding space.
+func stripFormatting(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '§' {
+			i++
+			continue
+		}
+		b.WriteRune(runes[i])
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,312 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Yeah114/FunShuttler/core/minecraft/protocol"
+	"github.com/Yeah114/FunShuttler/game_control/resources_control"
+	uqdefines "github.com/Yeah114/FunShuttler/uqholder/defines"
+	"github.com/Yeah114/tempest-core/network/app"
+	responsepb "github.com/Yeah114/tempest-core/network_api/response"
+	worldpb "github.com/Yeah114/tempest-core/network_api/world"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	langWeatherQuery = "commands.weather.query"
+	// maxWeatherDuration is the largest duration the weather command takes.
+	maxWeatherDuration = 1000000
+)
+
+var timePresets = map[worldpb.TimePreset]string{
+	worldpb.TimePreset_DAY:      "day",
+	worldpb.TimePreset_NOON:     "noon",
+	worldpb.TimePreset_SUNSET:   "sunset",
+	worldpb.TimePreset_NIGHT:    "night",
+	worldpb.TimePreset_MIDNIGHT: "midnight",
+	worldpb.TimePreset_SUNRISE:  "sunrise",
+}
+
+var weatherNames = map[worldpb.Weather]string{
+	worldpb.Weather_CLEAR:   "clear",
+	worldpb.Weather_RAIN:    "rain",
+	worldpb.Weather_THUNDER: "thunder",
+}
+
+var difficultyNames = map[worldpb.Difficulty]string{
+	worldpb.Difficulty_PEACEFUL: "peaceful",
+	worldpb.Difficulty_EASY:     "easy",
+	worldpb.Difficulty_NORMAL:   "normal",
+	worldpb.Difficulty_HARD:     "hard",
+}
+
+// WorldService exposes typed world settings. Reads come from the extend info
+// kept by the UQ holder; writes are validated and sent as commands.
+type WorldService struct {
+	worldpb.UnimplementedWorldServiceServer
+	state    *app.FatalderState
+	commands *CommandService
+}
+
+// NewWorldService constructs a world service bound to shared state.
+func NewWorldService(state *app.FatalderState) *WorldService {
+	return &WorldService{state: state, commands: NewCommandService(state)}
+}
+
+func (s *WorldService) GetWorldInfo(ctx context.Context, req *worldpb.GetWorldInfoRequest) (*worldpb.WorldInfo, error) {
+	info := &worldpb.WorldInfo{}
+	err := s.withExtendInfo(func(extend uqdefines.ExtendInfo) error {
+		if value, ok := extend.GetTime(); ok {
+			info.Time = value
+		}
+		if value, ok := extend.GetDayTime(); ok {
+			info.DayTime = value
+		}
+		if value, ok := extend.GetDayTimePercent(); ok {
+			info.DayTimePercent = value
+		}
+		if value, ok := extend.GetCurrentTick(); ok {
+			info.CurrentTick = value
+		}
+		if value, ok := extend.GetWorldDifficulty(); ok {
+			info.Difficulty = worldpb.Difficulty(value)
+		}
+		if value, ok := extend.GetWorldGameMode(); ok {
+			info.GameMode = value
+		}
+		if rules, ok := extend.GetGameRules(); ok {
+			info.GameRules = gameRulesToProto(rules)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return info, nil
+}
+
+func (s *WorldService) GetGameRule(ctx context.Context, req *worldpb.GetGameRuleRequest) (*worldpb.GameRule, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "game rule name required")
+	}
+	return s.gameRule(name)
+}
+
+func (s *WorldService) SetGameRule(ctx context.Context, req *worldpb.SetGameRuleRequest) (*responsepb.GeneralResponse, error) {
+	name := strings.TrimSpace(req.GetRule().GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "game rule name required")
+	}
+	current, err := s.gameRule(name)
+	if err != nil {
+		return nil, err
+	}
+	var value string
+	switch v := req.GetRule().GetValue().(type) {
+	case *worldpb.GameRule_BoolValue:
+		if _, ok := current.GetValue().(*worldpb.GameRule_BoolValue); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "game rule %s is not a boolean", current.GetName())
+		}
+		value = strconv.FormatBool(v.BoolValue)
+	case *worldpb.GameRule_IntValue:
+		if _, ok := current.GetValue().(*worldpb.GameRule_IntValue); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "game rule %s is not an integer", current.GetName())
+		}
+		value = strconv.FormatInt(int64(v.IntValue), 10)
+	case *worldpb.GameRule_FloatValue:
+		if _, ok := current.GetValue().(*worldpb.GameRule_FloatValue); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "game rule %s is not a float", current.GetName())
+		}
+		if math.IsNaN(float64(v.FloatValue)) || math.IsInf(float64(v.FloatValue), 0) {
+			return nil, status.Error(codes.InvalidArgument, "game rule value must be finite")
+		}
+		value = strconv.FormatFloat(float64(v.FloatValue), 'f', -1, 32)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "game rule value required")
+	}
+	return s.run(ctx, fmt.Sprintf("gamerule %s %s", current.GetName(), value))
+}
+
+func (s *WorldService) SetTime(ctx context.Context, req *worldpb.SetTimeRequest) (*responsepb.GeneralResponse, error) {
+	switch t := req.GetTime().(type) {
+	case *worldpb.SetTimeRequest_Preset:
+		if req.GetAdd() {
+			return nil, status.Error(codes.InvalidArgument, "add requires ticks")
+		}
+		name, ok := timePresets[t.Preset]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown time preset %d", t.Preset)
+		}
+		return s.run(ctx, "time set "+name)
+	case *worldpb.SetTimeRequest_Ticks:
+		if req.GetAdd() {
+			return s.run(ctx, fmt.Sprintf("time add %d", t.Ticks))
+		}
+		if t.Ticks < 0 {
+			return nil, status.Error(codes.InvalidArgument, "time must not be negative")
+		}
+		return s.run(ctx, fmt.Sprintf("time set %d", t.Ticks))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "ticks or preset required")
+	}
+}
+
+// GetWeather asks the server, since weather is not part of the extend info.
+func (s *WorldService) GetWeather(ctx context.Context, req *worldpb.GetWeatherRequest) (*worldpb.GetWeatherResponse, error) {
+	output, err := s.commands.queryCommand(ctx, "weather query")
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := outputError(output); err != nil {
+		return nil, err
+	}
+	// The answer is keyed commands.weather.query.<state>.
+	for _, m := range output.OutputMessages {
+		key := strings.TrimPrefix(m.Message, "%")
+		if !strings.HasPrefix(key, langWeatherQuery+".") {
+			continue
+		}
+		state := strings.TrimPrefix(key, langWeatherQuery+".")
+		for weather, name := range weatherNames {
+			if name == state {
+				return &worldpb.GetWeatherResponse{Weather: weather}, nil
+			}
+		}
+	}
+	return nil, status.Error(codes.Internal, "weather missing from command output")
+}
+
+func (s *WorldService) SetWeather(ctx context.Context, req *worldpb.SetWeatherRequest) (*responsepb.GeneralResponse, error) {
+	name, ok := weatherNames[req.GetWeather()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown weather %d", req.GetWeather())
+	}
+	duration := req.GetDurationTicks()
+	switch {
+	case duration < 0 || duration > maxWeatherDuration:
+		return nil, status.Errorf(codes.InvalidArgument, "duration must be between 0 and %d ticks", maxWeatherDuration)
+	case duration > 0:
+		return s.run(ctx, fmt.Sprintf("weather %s %d", name, duration))
+	default:
+		return s.run(ctx, "weather "+name)
+	}
+}
+
+func (s *WorldService) SetDifficulty(ctx context.Context, req *worldpb.SetDifficultyRequest) (*responsepb.GeneralResponse, error) {
+	name, ok := difficultyNames[req.GetDifficulty()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown difficulty %d", req.GetDifficulty())
+	}
+	return s.run(ctx, "difficulty "+name)
+}
+
+// GetWorldSpawn returns the spawn point last announced by the server. The
+// announcement made while logging in can arrive before the tracker listens,
+// in which case the spawn stays unknown until it is next changed.
+func (s *WorldService) GetWorldSpawn(ctx context.Context, req *worldpb.GetWorldSpawnRequest) (*worldpb.WorldSpawn, error) {
+	pos, dimension, ok := s.state.WorldSpawn().Get()
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "world spawn not received yet")
+	}
+	return &worldpb.WorldSpawn{X: pos[0], Y: pos[1], Z: pos[2], Dimension: dimension}, nil
+}
+
+func (s *WorldService) SetWorldSpawn(ctx context.Context, req *worldpb.SetWorldSpawnRequest) (*responsepb.GeneralResponse, error) {
+	resp, err := s.run(ctx, fmt.Sprintf("setworldspawn %d %d %d", req.GetX(), req.GetY(), req.GetZ()))
+	if err != nil {
+		return nil, err
+	}
+	// The world spawn always lives in the overworld.
+	s.state.WorldSpawn().Set(protocol.BlockPos{req.GetX(), req.GetY(), req.GetZ()}, 0)
+	return resp, nil
+}
+
+// run sends cmd for the caller and turns a command without successes into
+// an error.
+func (s *WorldService) run(ctx context.Context, cmd string) (*responsepb.GeneralResponse, error) {
+	output, err := s.commands.requestCommand(ctx, cmd)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := outputError(output); err != nil {
+		return nil, err
+	}
+	return generalSuccess(""), nil
+}
+
+// gameRule looks name up case-insensitively among the rules the server sent.
+func (s *WorldService) gameRule(name string) (*worldpb.GameRule, error) {
+	var rules map[string]any
+	err := s.withExtendInfo(func(extend uqdefines.ExtendInfo) error {
+		var ok bool
+		if rules, ok = extend.GetGameRules(); !ok {
+			return status.Error(codes.FailedPrecondition, "game rules not received yet")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	for _, rule := range gameRulesToProto(rules) {
+		if strings.EqualFold(rule.GetName(), name) {
+			return rule, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "unknown game rule %s", name)
+}
+
+func (s *WorldService) withExtendInfo(fn func(uqdefines.ExtendInfo) error) error {
+	return s.state.WithResources(func(res *resources_control.Resources) error {
+		holder := res.UQHolder()
+		if holder == nil {
+			return errors.New("uqholder unavailable")
+		}
+		micro := holder.Micro()
+		if micro == nil {
+			return errors.New("micro uqholder unavailable")
+		}
+		extend := micro.GetExtendInfo()
+		if extend == nil {
+			return errors.New("extend info unavailable")
+		}
+		return fn(extend)
+	})
+}
+
+// gameRulesToProto types the untyped rule map, sorted by name. Rules whose
+// value has an unexpected type are left out.
+func gameRulesToProto(rules map[string]any) []*worldpb.GameRule {
+	out := make([]*worldpb.GameRule, 0, len(rules))
+	for name, value := range rules {
+		rule := &worldpb.GameRule{Name: name}
+		switch v := value.(type) {
+		case bool:
+			rule.Value = &worldpb.GameRule_BoolValue{BoolValue: v}
+		case int32:
+			rule.Value = &worldpb.GameRule_IntValue{IntValue: v}
+		case uint32:
+			rule.Value = &worldpb.GameRule_IntValue{IntValue: int32(v)}
+		case int:
+			rule.Value = &worldpb.GameRule_IntValue{IntValue: int32(v)}
+		case int64:
+			rule.Value = &worldpb.GameRule_IntValue{IntValue: int32(v)}
+		case float32:
+			rule.Value = &worldpb.GameRule_FloatValue{FloatValue: v}
+		case float64:
+			rule.Value = &worldpb.GameRule_FloatValue{FloatValue: float32(v)}
+		default:
+			continue
+		}
+		out = append(out, rule)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].GetName() < out[j].GetName() })
+	return out
+}
@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/block.proto
+
+package blockpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlockPos struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             int32                  `protobuf:"varint,3,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockPos) Reset() {
+	*x = BlockPos{}
+	mi := &file_proto_block_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockPos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockPos) ProtoMessage() {}
+
+func (x *BlockPos) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockPos.ProtoReflect.Descriptor instead.
+func (*BlockPos) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockPos) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *BlockPos) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *BlockPos) GetZ() int32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type BlockBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *BlockPos              `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           *BlockPos              `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockBox) Reset() {
+	*x = BlockBox{}
+	mi := &file_proto_block_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockBox) ProtoMessage() {}
+
+func (x *BlockBox) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockBox.ProtoReflect.Descriptor instead.
+func (*BlockBox) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockBox) GetMin() *BlockPos {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *BlockBox) GetMax() *BlockPos {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type BlockInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeId     uint32                 `protobuf:"varint,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	States        string                 `protobuf:"bytes,3,opt,name=states,proto3" json:"states,omitempty"`
+	Known         bool                   `protobuf:"varint,4,opt,name=known,proto3" json:"known,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
+	mi := &file_proto_block_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockInfo) ProtoMessage() {}
+
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockInfo) GetRuntimeId() uint32 {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetStates() string {
+	if x != nil {
+		return x.States
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+type GetBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      *BlockPos              `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Layer         uint32                 `protobuf:"varint,2,opt,name=layer,proto3" json:"layer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockRequest) Reset() {
+	*x = GetBlockRequest{}
+	mi := &file_proto_block_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockRequest) ProtoMessage() {}
+
+func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBlockRequest) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *GetBlockRequest) GetLayer() uint32 {
+	if x != nil {
+		return x.Layer
+	}
+	return 0
+}
+
+type GetBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cached        bool                   `protobuf:"varint,1,opt,name=cached,proto3" json:"cached,omitempty"`
+	Block         *BlockInfo             `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockResponse) Reset() {
+	*x = GetBlockResponse{}
+	mi := &file_proto_block_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockResponse) ProtoMessage() {}
+
+func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBlockResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *GetBlockResponse) GetBlock() *BlockInfo {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type GetAreaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Box           *BlockBox              `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Layer         uint32                 `protobuf:"varint,2,opt,name=layer,proto3" json:"layer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAreaRequest) Reset() {
+	*x = GetAreaRequest{}
+	mi := &file_proto_block_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAreaRequest) ProtoMessage() {}
+
+func (x *GetAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAreaRequest.ProtoReflect.Descriptor instead.
+func (*GetAreaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAreaRequest) GetBox() *BlockBox {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *GetAreaRequest) GetLayer() uint32 {
+	if x != nil {
+		return x.Layer
+	}
+	return 0
+}
+
+// Blocks are listed in y, z, x order; an index of -1 marks a position whose
+// sub-chunk is not cached.
+type GetAreaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Box           *BlockBox              `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Palette       []*BlockInfo           `protobuf:"bytes,2,rep,name=palette,proto3" json:"palette,omitempty"`
+	Indices       []int32                `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAreaResponse) Reset() {
+	*x = GetAreaResponse{}
+	mi := &file_proto_block_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAreaResponse) ProtoMessage() {}
+
+func (x *GetAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAreaResponse.ProtoReflect.Descriptor instead.
+func (*GetAreaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAreaResponse) GetBox() *BlockBox {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *GetAreaResponse) GetPalette() []*BlockInfo {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+func (x *GetAreaResponse) GetIndices() []int32 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type ListenBlockChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Box           *BlockBox              `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Layers        []uint32               `protobuf:"varint,2,rep,packed,name=layers,proto3" json:"layers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListenBlockChangesRequest) Reset() {
+	*x = ListenBlockChangesRequest{}
+	mi := &file_proto_block_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListenBlockChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenBlockChangesRequest) ProtoMessage() {}
+
+func (x *ListenBlockChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenBlockChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListenBlockChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListenBlockChangesRequest) GetBox() *BlockBox {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *ListenBlockChangesRequest) GetLayers() []uint32 {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+type BlockChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      *BlockPos              `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Layer         uint32                 `protobuf:"varint,2,opt,name=layer,proto3" json:"layer,omitempty"`
+	Block         *BlockInfo             `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Previous      *BlockInfo             `protobuf:"bytes,4,opt,name=previous,proto3" json:"previous,omitempty"`
+	TimestampMs   int64                  `protobuf:"varint,5,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockChange) Reset() {
+	*x = BlockChange{}
+	mi := &file_proto_block_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockChange) ProtoMessage() {}
+
+func (x *BlockChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_block_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockChange.ProtoReflect.Descriptor instead.
+func (*BlockChange) Descriptor() ([]byte, []int) {
+	return file_proto_block_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlockChange) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *BlockChange) GetLayer() uint32 {
+	if x != nil {
+		return x.Layer
+	}
+	return 0
+}
+
+func (x *BlockChange) GetBlock() *BlockInfo {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *BlockChange) GetPrevious() *BlockInfo {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *BlockChange) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+var File_proto_block_proto protoreflect.FileDescriptor
+
+const file_proto_block_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/block.proto\x12\x13fateark.proto.block\"4\n" +
+	"\bBlockPos\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\f\n" +
+	"\x01z\x18\x03 \x01(\x05R\x01z\"l\n" +
+	"\bBlockBox\x12/\n" +
+	"\x03min\x18\x01 \x01(\v2\x1d.fateark.proto.block.BlockPosR\x03min\x12/\n" +
+	"\x03max\x18\x02 \x01(\v2\x1d.fateark.proto.block.BlockPosR\x03max\"l\n" +
+	"\tBlockInfo\x12\x1d\n" +
+	"\n" +
+	"runtime_id\x18\x01 \x01(\rR\truntimeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06states\x18\x03 \x01(\tR\x06states\x12\x14\n" +
+	"\x05known\x18\x04 \x01(\bR\x05known\"b\n" +
+	"\x0fGetBlockRequest\x129\n" +
+	"\bposition\x18\x01 \x01(\v2\x1d.fateark.proto.block.BlockPosR\bposition\x12\x14\n" +
+	"\x05layer\x18\x02 \x01(\rR\x05layer\"`\n" +
+	"\x10GetBlockResponse\x12\x16\n" +
+	"\x06cached\x18\x01 \x01(\bR\x06cached\x124\n" +
+	"\x05block\x18\x02 \x01(\v2\x1e.fateark.proto.block.BlockInfoR\x05block\"W\n" +
+	"\x0eGetAreaRequest\x12/\n" +
+	"\x03box\x18\x01 \x01(\v2\x1d.fateark.proto.block.BlockBoxR\x03box\x12\x14\n" +
+	"\x05layer\x18\x02 \x01(\rR\x05layer\"\x96\x01\n" +
+	"\x0fGetAreaResponse\x12/\n" +
+	"\x03box\x18\x01 \x01(\v2\x1d.fateark.proto.block.BlockBoxR\x03box\x128\n" +
+	"\apalette\x18\x02 \x03(\v2\x1e.fateark.proto.block.BlockInfoR\apalette\x12\x18\n" +
+	"\aindices\x18\x03 \x03(\x05R\aindices\"d\n" +
+	"\x19ListenBlockChangesRequest\x12/\n" +
+	"\x03box\x18\x01 \x01(\v2\x1d.fateark.proto.block.BlockBoxR\x03box\x12\x16\n" +
+	"\x06layers\x18\x02 \x03(\rR\x06layers\"\xf3\x01\n" +
+	"\vBlockChange\x129\n" +
+	"\bposition\x18\x01 \x01(\v2\x1d.fateark.proto.block.BlockPosR\bposition\x12\x14\n" +
+	"\x05layer\x18\x02 \x01(\rR\x05layer\x124\n" +
+	"\x05block\x18\x03 \x01(\v2\x1e.fateark.proto.block.BlockInfoR\x05block\x12:\n" +
+	"\bprevious\x18\x04 \x01(\v2\x1e.fateark.proto.block.BlockInfoR\bprevious\x12!\n" +
+	"\ftimestamp_ms\x18\x05 \x01(\x03R\vtimestampMs2\xa7\x02\n" +
+	"\fBlockService\x12W\n" +
+	"\bGetBlock\x12$.fateark.proto.block.GetBlockRequest\x1a%.fateark.proto.block.GetBlockResponse\x12T\n" +
+	"\aGetArea\x12#.fateark.proto.block.GetAreaRequest\x1a$.fateark.proto.block.GetAreaResponse\x12h\n" +
+	"\x12ListenBlockChanges\x12..fateark.proto.block.ListenBlockChangesRequest\x1a .fateark.proto.block.BlockChange0\x01B;Z9github.com/Yeah114/tempest-core/network_api/block;blockpbb\x06proto3"
+
+var (
+	file_proto_block_proto_rawDescOnce sync.Once
+	file_proto_block_proto_rawDescData []byte
+)
+
+func file_proto_block_proto_rawDescGZIP() []byte {
+	file_proto_block_proto_rawDescOnce.Do(func() {
+		file_proto_block_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_block_proto_rawDesc), len(file_proto_block_proto_rawDesc)))
+	})
+	return file_proto_block_proto_rawDescData
+}
+
+var file_proto_block_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_block_proto_goTypes = []any{
+	(*BlockPos)(nil),                  // 0: fateark.proto.block.BlockPos
+	(*BlockBox)(nil),                  // 1: fateark.proto.block.BlockBox
+	(*BlockInfo)(nil),                 // 2: fateark.proto.block.BlockInfo
+	(*GetBlockRequest)(nil),           // 3: fateark.proto.block.GetBlockRequest
+	(*GetBlockResponse)(nil),          // 4: fateark.proto.block.GetBlockResponse
+	(*GetAreaRequest)(nil),            // 5: fateark.proto.block.GetAreaRequest
+	(*GetAreaResponse)(nil),           // 6: fateark.proto.block.GetAreaResponse
+	(*ListenBlockChangesRequest)(nil), // 7: fateark.proto.block.ListenBlockChangesRequest
+	(*BlockChange)(nil),               // 8: fateark.proto.block.BlockChange
+}
+var file_proto_block_proto_depIdxs = []int32{
+	0,  // 0: fateark.proto.block.BlockBox.min:type_name -> fateark.proto.block.BlockPos
+	0,  // 1: fateark.proto.block.BlockBox.max:type_name -> fateark.proto.block.BlockPos
+	0,  // 2: fateark.proto.block.GetBlockRequest.position:type_name -> fateark.proto.block.BlockPos
+	2,  // 3: fateark.proto.block.GetBlockResponse.block:type_name -> fateark.proto.block.BlockInfo
+	1,  // 4: fateark.proto.block.GetAreaRequest.box:type_name -> fateark.proto.block.BlockBox
+	1,  // 5: fateark.proto.block.GetAreaResponse.box:type_name -> fateark.proto.block.BlockBox
+	2,  // 6: fateark.proto.block.GetAreaResponse.palette:type_name -> fateark.proto.block.BlockInfo
+	1,  // 7: fateark.proto.block.ListenBlockChangesRequest.box:type_name -> fateark.proto.block.BlockBox
+	0,  // 8: fateark.proto.block.BlockChange.position:type_name -> fateark.proto.block.BlockPos
+	2,  // 9: fateark.proto.block.BlockChange.block:type_name -> fateark.proto.block.BlockInfo
+	2,  // 10: fateark.proto.block.BlockChange.previous:type_name -> fateark.proto.block.BlockInfo
+	3,  // 11: fateark.proto.block.BlockService.GetBlock:input_type -> fateark.proto.block.GetBlockRequest
+	5,  // 12: fateark.proto.block.BlockService.GetArea:input_type -> fateark.proto.block.GetAreaRequest
+	7,  // 13: fateark.proto.block.BlockService.ListenBlockChanges:input_type -> fateark.proto.block.ListenBlockChangesRequest
+	4,  // 14: fateark.proto.block.BlockService.GetBlock:output_type -> fateark.proto.block.GetBlockResponse
+	6,  // 15: fateark.proto.block.BlockService.GetArea:output_type -> fateark.proto.block.GetAreaResponse
+	8,  // 16: fateark.proto.block.BlockService.ListenBlockChanges:output_type -> fateark.proto.block.BlockChange
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_block_proto_init() }
+func file_proto_block_proto_init() {
+	if File_proto_block_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_block_proto_rawDesc), len(file_proto_block_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_block_proto_goTypes,
+		DependencyIndexes: file_proto_block_proto_depIdxs,
+		MessageInfos:      file_proto_block_proto_msgTypes,
+	}.Build()
+	File_proto_block_proto = out.File
+	file_proto_block_proto_goTypes = nil
+	file_proto_block_proto_depIdxs = nil
+}
@@ -0,0 +1,994 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/chatcommand.proto
+
+package chatcommandpb
+
+import (
+	response "github.com/Yeah114/tempest-core/network_api/response"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ArgumentSpec_Type int32
+
+const (
+	ArgumentSpec_STRING ArgumentSpec_Type = 0
+	ArgumentSpec_INT    ArgumentSpec_Type = 1
+	ArgumentSpec_FLOAT  ArgumentSpec_Type = 2
+	ArgumentSpec_BOOL   ArgumentSpec_Type = 3
+	ArgumentSpec_PLAYER ArgumentSpec_Type = 4
+	ArgumentSpec_CHOICE ArgumentSpec_Type = 5
+	ArgumentSpec_GREEDY ArgumentSpec_Type = 6
+)
+
+// Enum value maps for ArgumentSpec_Type.
+var (
+	ArgumentSpec_Type_name = map[int32]string{
+		0: "STRING",
+		1: "INT",
+		2: "FLOAT",
+		3: "BOOL",
+		4: "PLAYER",
+		5: "CHOICE",
+		6: "GREEDY",
+	}
+	ArgumentSpec_Type_value = map[string]int32{
+		"STRING": 0,
+		"INT":    1,
+		"FLOAT":  2,
+		"BOOL":   3,
+		"PLAYER": 4,
+		"CHOICE": 5,
+		"GREEDY": 6,
+	}
+)
+
+func (x ArgumentSpec_Type) Enum() *ArgumentSpec_Type {
+	p := new(ArgumentSpec_Type)
+	*p = x
+	return p
+}
+
+func (x ArgumentSpec_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArgumentSpec_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_chatcommand_proto_enumTypes[0].Descriptor()
+}
+
+func (ArgumentSpec_Type) Type() protoreflect.EnumType {
+	return &file_proto_chatcommand_proto_enumTypes[0]
+}
+
+func (x ArgumentSpec_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArgumentSpec_Type.Descriptor instead.
+func (ArgumentSpec_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type CommandPermission_Kind int32
+
+const (
+	CommandPermission_ANYONE CommandPermission_Kind = 0
+	CommandPermission_OP     CommandPermission_Kind = 1
+	CommandPermission_TAG    CommandPermission_Kind = 2
+	CommandPermission_ROLE   CommandPermission_Kind = 3
+)
+
+// Enum value maps for CommandPermission_Kind.
+var (
+	CommandPermission_Kind_name = map[int32]string{
+		0: "ANYONE",
+		1: "OP",
+		2: "TAG",
+		3: "ROLE",
+	}
+	CommandPermission_Kind_value = map[string]int32{
+		"ANYONE": 0,
+		"OP":     1,
+		"TAG":    2,
+		"ROLE":   3,
+	}
+)
+
+func (x CommandPermission_Kind) Enum() *CommandPermission_Kind {
+	p := new(CommandPermission_Kind)
+	*p = x
+	return p
+}
+
+func (x CommandPermission_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandPermission_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_chatcommand_proto_enumTypes[1].Descriptor()
+}
+
+func (CommandPermission_Kind) Type() protoreflect.EnumType {
+	return &file_proto_chatcommand_proto_enumTypes[1]
+}
+
+func (x CommandPermission_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandPermission_Kind.Descriptor instead.
+func (CommandPermission_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type ArgumentSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          ArgumentSpec_Type      `protobuf:"varint,2,opt,name=type,proto3,enum=fateark.proto.chatcommand.ArgumentSpec_Type" json:"type,omitempty"`
+	Optional      bool                   `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	Choices       []string               `protobuf:"bytes,4,rep,name=choices,proto3" json:"choices,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArgumentSpec) Reset() {
+	*x = ArgumentSpec{}
+	mi := &file_proto_chatcommand_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArgumentSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgumentSpec) ProtoMessage() {}
+
+func (x *ArgumentSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArgumentSpec.ProtoReflect.Descriptor instead.
+func (*ArgumentSpec) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ArgumentSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArgumentSpec) GetType() ArgumentSpec_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ArgumentSpec_STRING
+}
+
+func (x *ArgumentSpec) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *ArgumentSpec) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *ArgumentSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CommandPermission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          CommandPermission_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=fateark.proto.chatcommand.CommandPermission_Kind" json:"kind,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandPermission) Reset() {
+	*x = CommandPermission{}
+	mi := &file_proto_chatcommand_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandPermission) ProtoMessage() {}
+
+func (x *CommandPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandPermission.ProtoReflect.Descriptor instead.
+func (*CommandPermission) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CommandPermission) GetKind() CommandPermission_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return CommandPermission_ANYONE
+}
+
+func (x *CommandPermission) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ChatCommandSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Arguments     []*ArgumentSpec        `protobuf:"bytes,4,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Permission    *CommandPermission     `protobuf:"bytes,5,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatCommandSpec) Reset() {
+	*x = ChatCommandSpec{}
+	mi := &file_proto_chatcommand_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatCommandSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatCommandSpec) ProtoMessage() {}
+
+func (x *ChatCommandSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatCommandSpec.ProtoReflect.Descriptor instead.
+func (*ChatCommandSpec) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChatCommandSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChatCommandSpec) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *ChatCommandSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChatCommandSpec) GetArguments() []*ArgumentSpec {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ChatCommandSpec) GetPermission() *CommandPermission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+type ServeChatCommandsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Commands      []*ChatCommandSpec     `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServeChatCommandsRequest) Reset() {
+	*x = ServeChatCommandsRequest{}
+	mi := &file_proto_chatcommand_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServeChatCommandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServeChatCommandsRequest) ProtoMessage() {}
+
+func (x *ServeChatCommandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServeChatCommandsRequest.ProtoReflect.Descriptor instead.
+func (*ServeChatCommandsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ServeChatCommandsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ServeChatCommandsRequest) GetCommands() []*ChatCommandSpec {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type ChatArgument struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Present bool                   `protobuf:"varint,2,opt,name=present,proto3" json:"present,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*ChatArgument_StringValue
+	//	*ChatArgument_IntValue
+	//	*ChatArgument_FloatValue
+	//	*ChatArgument_BoolValue
+	Value         isChatArgument_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatArgument) Reset() {
+	*x = ChatArgument{}
+	mi := &file_proto_chatcommand_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatArgument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatArgument) ProtoMessage() {}
+
+func (x *ChatArgument) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatArgument.ProtoReflect.Descriptor instead.
+func (*ChatArgument) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChatArgument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChatArgument) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *ChatArgument) GetValue() isChatArgument_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ChatArgument) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*ChatArgument_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *ChatArgument) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*ChatArgument_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *ChatArgument) GetFloatValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*ChatArgument_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *ChatArgument) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*ChatArgument_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+type isChatArgument_Value interface {
+	isChatArgument_Value()
+}
+
+type ChatArgument_StringValue struct {
+	StringValue string `protobuf:"bytes,3,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type ChatArgument_IntValue struct {
+	IntValue int64 `protobuf:"varint,4,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type ChatArgument_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,5,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type ChatArgument_BoolValue struct {
+	BoolValue bool `protobuf:"varint,6,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*ChatArgument_StringValue) isChatArgument_Value() {}
+
+func (*ChatArgument_IntValue) isChatArgument_Value() {}
+
+func (*ChatArgument_FloatValue) isChatArgument_Value() {}
+
+func (*ChatArgument_BoolValue) isChatArgument_Value() {}
+
+type ChatCommandInvocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Alias         string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Arguments     []*ChatArgument        `protobuf:"bytes,4,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	PlayerUuid    string                 `protobuf:"bytes,5,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,6,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	RawMessage    string                 `protobuf:"bytes,7,opt,name=raw_message,json=rawMessage,proto3" json:"raw_message,omitempty"`
+	TimestampMs   int64                  `protobuf:"varint,8,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatCommandInvocation) Reset() {
+	*x = ChatCommandInvocation{}
+	mi := &file_proto_chatcommand_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatCommandInvocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatCommandInvocation) ProtoMessage() {}
+
+func (x *ChatCommandInvocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatCommandInvocation.ProtoReflect.Descriptor instead.
+func (*ChatCommandInvocation) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChatCommandInvocation) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ChatCommandInvocation) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ChatCommandInvocation) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *ChatCommandInvocation) GetArguments() []*ChatArgument {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ChatCommandInvocation) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *ChatCommandInvocation) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *ChatCommandInvocation) GetRawMessage() string {
+	if x != nil {
+		return x.RawMessage
+	}
+	return ""
+}
+
+func (x *ChatCommandInvocation) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+type ListChatCommandsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatCommandsRequest) Reset() {
+	*x = ListChatCommandsRequest{}
+	mi := &file_proto_chatcommand_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatCommandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatCommandsRequest) ProtoMessage() {}
+
+func (x *ListChatCommandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatCommandsRequest.ProtoReflect.Descriptor instead.
+func (*ListChatCommandsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListChatCommandsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type RegisteredChatCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Spec          *ChatCommandSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Usage         string                 `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisteredChatCommand) Reset() {
+	*x = RegisteredChatCommand{}
+	mi := &file_proto_chatcommand_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisteredChatCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredChatCommand) ProtoMessage() {}
+
+func (x *RegisteredChatCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredChatCommand.ProtoReflect.Descriptor instead.
+func (*RegisteredChatCommand) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegisteredChatCommand) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *RegisteredChatCommand) GetSpec() *ChatCommandSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *RegisteredChatCommand) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+type ListChatCommandsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Commands      []*RegisteredChatCommand `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatCommandsResponse) Reset() {
+	*x = ListChatCommandsResponse{}
+	mi := &file_proto_chatcommand_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatCommandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatCommandsResponse) ProtoMessage() {}
+
+func (x *ListChatCommandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatCommandsResponse.ProtoReflect.Descriptor instead.
+func (*ListChatCommandsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListChatCommandsResponse) GetCommands() []*RegisteredChatCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type SetChatRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	MemberUuids   []string               `protobuf:"bytes,2,rep,name=member_uuids,json=memberUuids,proto3" json:"member_uuids,omitempty"`
+	MemberNames   []string               `protobuf:"bytes,3,rep,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChatRoleRequest) Reset() {
+	*x = SetChatRoleRequest{}
+	mi := &file_proto_chatcommand_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatRoleRequest) ProtoMessage() {}
+
+func (x *SetChatRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chatcommand_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetChatRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chatcommand_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetChatRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetChatRoleRequest) GetMemberUuids() []string {
+	if x != nil {
+		return x.MemberUuids
+	}
+	return nil
+}
+
+func (x *SetChatRoleRequest) GetMemberNames() []string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return nil
+}
+
+var File_proto_chatcommand_proto protoreflect.FileDescriptor
+
+const file_proto_chatcommand_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/chatcommand.proto\x12\x19fateark.proto.chatcommand\x1a\x14proto/response.proto\"\x92\x02\n" +
+	"\fArgumentSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12@\n" +
+	"\x04type\x18\x02 \x01(\x0e2,.fateark.proto.chatcommand.ArgumentSpec.TypeR\x04type\x12\x1a\n" +
+	"\boptional\x18\x03 \x01(\bR\boptional\x12\x18\n" +
+	"\achoices\x18\x04 \x03(\tR\achoices\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"T\n" +
+	"\x04Type\x12\n" +
+	"\n" +
+	"\x06STRING\x10\x00\x12\a\n" +
+	"\x03INT\x10\x01\x12\t\n" +
+	"\x05FLOAT\x10\x02\x12\b\n" +
+	"\x04BOOL\x10\x03\x12\n" +
+	"\n" +
+	"\x06PLAYER\x10\x04\x12\n" +
+	"\n" +
+	"\x06CHOICE\x10\x05\x12\n" +
+	"\n" +
+	"\x06GREEDY\x10\x06\"\x9f\x01\n" +
+	"\x11CommandPermission\x12E\n" +
+	"\x04kind\x18\x01 \x01(\x0e21.fateark.proto.chatcommand.CommandPermission.KindR\x04kind\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"-\n" +
+	"\x04Kind\x12\n" +
+	"\n" +
+	"\x06ANYONE\x10\x00\x12\x06\n" +
+	"\x02OP\x10\x01\x12\a\n" +
+	"\x03TAG\x10\x02\x12\b\n" +
+	"\x04ROLE\x10\x03\"\xf6\x01\n" +
+	"\x0fChatCommandSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x02 \x03(\tR\aaliases\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12E\n" +
+	"\targuments\x18\x04 \x03(\v2'.fateark.proto.chatcommand.ArgumentSpecR\targuments\x12L\n" +
+	"\n" +
+	"permission\x18\x05 \x01(\v2,.fateark.proto.chatcommand.CommandPermissionR\n" +
+	"permission\"z\n" +
+	"\x18ServeChatCommandsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12F\n" +
+	"\bcommands\x18\x02 \x03(\v2*.fateark.proto.chatcommand.ChatCommandSpecR\bcommands\"\xcd\x01\n" +
+	"\fChatArgument\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\apresent\x18\x02 \x01(\bR\apresent\x12#\n" +
+	"\fstring_value\x18\x03 \x01(\tH\x00R\vstringValue\x12\x1d\n" +
+	"\tint_value\x18\x04 \x01(\x03H\x00R\bintValue\x12!\n" +
+	"\vfloat_value\x18\x05 \x01(\x01H\x00R\n" +
+	"floatValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x06 \x01(\bH\x00R\tboolValueB\a\n" +
+	"\x05value\"\xac\x02\n" +
+	"\x15ChatCommandInvocation\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12\x14\n" +
+	"\x05alias\x18\x03 \x01(\tR\x05alias\x12E\n" +
+	"\targuments\x18\x04 \x03(\v2'.fateark.proto.chatcommand.ChatArgumentR\targuments\x12\x1f\n" +
+	"\vplayer_uuid\x18\x05 \x01(\tR\n" +
+	"playerUuid\x12\x1f\n" +
+	"\vplayer_name\x18\x06 \x01(\tR\n" +
+	"playerName\x12\x1f\n" +
+	"\vraw_message\x18\a \x01(\tR\n" +
+	"rawMessage\x12!\n" +
+	"\ftimestamp_ms\x18\b \x01(\x03R\vtimestampMs\"1\n" +
+	"\x17ListChatCommandsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"\x85\x01\n" +
+	"\x15RegisteredChatCommand\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12>\n" +
+	"\x04spec\x18\x02 \x01(\v2*.fateark.proto.chatcommand.ChatCommandSpecR\x04spec\x12\x14\n" +
+	"\x05usage\x18\x03 \x01(\tR\x05usage\"h\n" +
+	"\x18ListChatCommandsResponse\x12L\n" +
+	"\bcommands\x18\x01 \x03(\v20.fateark.proto.chatcommand.RegisteredChatCommandR\bcommands\"n\n" +
+	"\x12SetChatRoleRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12!\n" +
+	"\fmember_uuids\x18\x02 \x03(\tR\vmemberUuids\x12!\n" +
+	"\fmember_names\x18\x03 \x03(\tR\vmemberNames2\xf6\x02\n" +
+	"\x12ChatCommandService\x12|\n" +
+	"\x11ServeChatCommands\x123.fateark.proto.chatcommand.ServeChatCommandsRequest\x1a0.fateark.proto.chatcommand.ChatCommandInvocation0\x01\x12{\n" +
+	"\x10ListChatCommands\x122.fateark.proto.chatcommand.ListChatCommandsRequest\x1a3.fateark.proto.chatcommand.ListChatCommandsResponse\x12e\n" +
+	"\vSetChatRole\x12-.fateark.proto.chatcommand.SetChatRoleRequest\x1a'.fateark.proto.response.GeneralResponseBGZEgithub.com/Yeah114/tempest-core/network_api/chatcommand;chatcommandpbb\x06proto3"
+
+var (
+	file_proto_chatcommand_proto_rawDescOnce sync.Once
+	file_proto_chatcommand_proto_rawDescData []byte
+)
+
+func file_proto_chatcommand_proto_rawDescGZIP() []byte {
+	file_proto_chatcommand_proto_rawDescOnce.Do(func() {
+		file_proto_chatcommand_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_chatcommand_proto_rawDesc), len(file_proto_chatcommand_proto_rawDesc)))
+	})
+	return file_proto_chatcommand_proto_rawDescData
+}
+
+var file_proto_chatcommand_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_chatcommand_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_chatcommand_proto_goTypes = []any{
+	(ArgumentSpec_Type)(0),           // 0: fateark.proto.chatcommand.ArgumentSpec.Type
+	(CommandPermission_Kind)(0),      // 1: fateark.proto.chatcommand.CommandPermission.Kind
+	(*ArgumentSpec)(nil),             // 2: fateark.proto.chatcommand.ArgumentSpec
+	(*CommandPermission)(nil),        // 3: fateark.proto.chatcommand.CommandPermission
+	(*ChatCommandSpec)(nil),          // 4: fateark.proto.chatcommand.ChatCommandSpec
+	(*ServeChatCommandsRequest)(nil), // 5: fateark.proto.chatcommand.ServeChatCommandsRequest
+	(*ChatArgument)(nil),             // 6: fateark.proto.chatcommand.ChatArgument
+	(*ChatCommandInvocation)(nil),    // 7: fateark.proto.chatcommand.ChatCommandInvocation
+	(*ListChatCommandsRequest)(nil),  // 8: fateark.proto.chatcommand.ListChatCommandsRequest
+	(*RegisteredChatCommand)(nil),    // 9: fateark.proto.chatcommand.RegisteredChatCommand
+	(*ListChatCommandsResponse)(nil), // 10: fateark.proto.chatcommand.ListChatCommandsResponse
+	(*SetChatRoleRequest)(nil),       // 11: fateark.proto.chatcommand.SetChatRoleRequest
+	(*response.GeneralResponse)(nil), // 12: fateark.proto.response.GeneralResponse
+}
+var file_proto_chatcommand_proto_depIdxs = []int32{
+	0,  // 0: fateark.proto.chatcommand.ArgumentSpec.type:type_name -> fateark.proto.chatcommand.ArgumentSpec.Type
+	1,  // 1: fateark.proto.chatcommand.CommandPermission.kind:type_name -> fateark.proto.chatcommand.CommandPermission.Kind
+	2,  // 2: fateark.proto.chatcommand.ChatCommandSpec.arguments:type_name -> fateark.proto.chatcommand.ArgumentSpec
+	3,  // 3: fateark.proto.chatcommand.ChatCommandSpec.permission:type_name -> fateark.proto.chatcommand.CommandPermission
+	4,  // 4: fateark.proto.chatcommand.ServeChatCommandsRequest.commands:type_name -> fateark.proto.chatcommand.ChatCommandSpec
+	6,  // 5: fateark.proto.chatcommand.ChatCommandInvocation.arguments:type_name -> fateark.proto.chatcommand.ChatArgument
+	4,  // 6: fateark.proto.chatcommand.RegisteredChatCommand.spec:type_name -> fateark.proto.chatcommand.ChatCommandSpec
+	9,  // 7: fateark.proto.chatcommand.ListChatCommandsResponse.commands:type_name -> fateark.proto.chatcommand.RegisteredChatCommand
+	5,  // 8: fateark.proto.chatcommand.ChatCommandService.ServeChatCommands:input_type -> fateark.proto.chatcommand.ServeChatCommandsRequest
+	8,  // 9: fateark.proto.chatcommand.ChatCommandService.ListChatCommands:input_type -> fateark.proto.chatcommand.ListChatCommandsRequest
+	11, // 10: fateark.proto.chatcommand.ChatCommandService.SetChatRole:input_type -> fateark.proto.chatcommand.SetChatRoleRequest
+	7,  // 11: fateark.proto.chatcommand.ChatCommandService.ServeChatCommands:output_type -> fateark.proto.chatcommand.ChatCommandInvocation
+	10, // 12: fateark.proto.chatcommand.ChatCommandService.ListChatCommands:output_type -> fateark.proto.chatcommand.ListChatCommandsResponse
+	12, // 13: fateark.proto.chatcommand.ChatCommandService.SetChatRole:output_type -> fateark.proto.response.GeneralResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_proto_chatcommand_proto_init() }
+func file_proto_chatcommand_proto_init() {
+	if File_proto_chatcommand_proto != nil {
+		return
+	}
+	file_proto_chatcommand_proto_msgTypes[4].OneofWrappers = []any{
+		(*ChatArgument_StringValue)(nil),
+		(*ChatArgument_IntValue)(nil),
+		(*ChatArgument_FloatValue)(nil),
+		(*ChatArgument_BoolValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_chatcommand_proto_rawDesc), len(file_proto_chatcommand_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_chatcommand_proto_goTypes,
+		DependencyIndexes: file_proto_chatcommand_proto_depIdxs,
+		EnumInfos:         file_proto_chatcommand_proto_enumTypes,
+		MessageInfos:      file_proto_chatcommand_proto_msgTypes,
+	}.Build()
+	File_proto_chatcommand_proto = out.File
+	file_proto_chatcommand_proto_goTypes = nil
+	file_proto_chatcommand_proto_depIdxs = nil
+}
@@ -22,28 +22,3441 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CommandPriority int32
+
+const (
+	CommandPriority_AUTOMATION CommandPriority = 0
+	CommandPriority_ADMIN      CommandPriority = 1
+	CommandPriority_COSMETIC   CommandPriority = 2
+)
+
+// Enum value maps for CommandPriority.
+var (
+	CommandPriority_name = map[int32]string{
+		0: "AUTOMATION",
+		1: "ADMIN",
+		2: "COSMETIC",
+	}
+	CommandPriority_value = map[string]int32{
+		"AUTOMATION": 0,
+		"ADMIN":      1,
+		"COSMETIC":   2,
+	}
+)
+
+func (x CommandPriority) Enum() *CommandPriority {
+	p := new(CommandPriority)
+	*p = x
+	return p
+}
+
+func (x CommandPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[0].Descriptor()
+}
+
+func (CommandPriority) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[0]
+}
+
+func (x CommandPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandPriority.Descriptor instead.
+func (CommandPriority) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{0}
+}
+
+type CommandMode int32
+
+const (
+	CommandMode_WS     CommandMode = 0
+	CommandMode_WO     CommandMode = 1
+	CommandMode_PLAYER CommandMode = 2
+	CommandMode_AI     CommandMode = 3
+)
+
+// Enum value maps for CommandMode.
+var (
+	CommandMode_name = map[int32]string{
+		0: "WS",
+		1: "WO",
+		2: "PLAYER",
+		3: "AI",
+	}
+	CommandMode_value = map[string]int32{
+		"WS":     0,
+		"WO":     1,
+		"PLAYER": 2,
+		"AI":     3,
+	}
+)
+
+func (x CommandMode) Enum() *CommandMode {
+	p := new(CommandMode)
+	*p = x
+	return p
+}
+
+func (x CommandMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[1].Descriptor()
+}
+
+func (CommandMode) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[1]
+}
+
+func (x CommandMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandMode.Descriptor instead.
+func (CommandMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{1}
+}
+
+type CommandOutput_Type int32
+
+const (
+	CommandOutput_NONE        CommandOutput_Type = 0
+	CommandOutput_LAST_OUTPUT CommandOutput_Type = 1
+	CommandOutput_SILENT      CommandOutput_Type = 2
+	CommandOutput_ALL_OUTPUT  CommandOutput_Type = 3
+	CommandOutput_DATA_SET    CommandOutput_Type = 4
+)
+
+// Enum value maps for CommandOutput_Type.
+var (
+	CommandOutput_Type_name = map[int32]string{
+		0: "NONE",
+		1: "LAST_OUTPUT",
+		2: "SILENT",
+		3: "ALL_OUTPUT",
+		4: "DATA_SET",
+	}
+	CommandOutput_Type_value = map[string]int32{
+		"NONE":        0,
+		"LAST_OUTPUT": 1,
+		"SILENT":      2,
+		"ALL_OUTPUT":  3,
+		"DATA_SET":    4,
+	}
+)
+
+func (x CommandOutput_Type) Enum() *CommandOutput_Type {
+	p := new(CommandOutput_Type)
+	*p = x
+	return p
+}
+
+func (x CommandOutput_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandOutput_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[2].Descriptor()
+}
+
+func (CommandOutput_Type) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[2]
+}
+
+func (x CommandOutput_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandOutput_Type.Descriptor instead.
+func (CommandOutput_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type CommandOutputResponse_Status int32
+
+const (
+	CommandOutputResponse_SUCCESS CommandOutputResponse_Status = 0
+	CommandOutputResponse_FAILED  CommandOutputResponse_Status = 1
+)
+
+// Enum value maps for CommandOutputResponse_Status.
+var (
+	CommandOutputResponse_Status_name = map[int32]string{
+		0: "SUCCESS",
+		1: "FAILED",
+	}
+	CommandOutputResponse_Status_value = map[string]int32{
+		"SUCCESS": 0,
+		"FAILED":  1,
+	}
+)
+
+func (x CommandOutputResponse_Status) Enum() *CommandOutputResponse_Status {
+	p := new(CommandOutputResponse_Status)
+	*p = x
+	return p
+}
+
+func (x CommandOutputResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandOutputResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[3].Descriptor()
+}
+
+func (CommandOutputResponse_Status) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[3]
+}
+
+func (x CommandOutputResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandOutputResponse_Status.Descriptor instead.
+func (CommandOutputResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type BatchCommandResult_Status int32
+
+const (
+	BatchCommandResult_SUCCESS BatchCommandResult_Status = 0
+	BatchCommandResult_FAILED  BatchCommandResult_Status = 1
+	BatchCommandResult_SKIPPED BatchCommandResult_Status = 2
+)
+
+// Enum value maps for BatchCommandResult_Status.
+var (
+	BatchCommandResult_Status_name = map[int32]string{
+		0: "SUCCESS",
+		1: "FAILED",
+		2: "SKIPPED",
+	}
+	BatchCommandResult_Status_value = map[string]int32{
+		"SUCCESS": 0,
+		"FAILED":  1,
+		"SKIPPED": 2,
+	}
+)
+
+func (x BatchCommandResult_Status) Enum() *BatchCommandResult_Status {
+	p := new(BatchCommandResult_Status)
+	*p = x
+	return p
+}
+
+func (x BatchCommandResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchCommandResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[4].Descriptor()
+}
+
+func (BatchCommandResult_Status) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[4]
+}
+
+func (x BatchCommandResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchCommandResult_Status.Descriptor instead.
+func (BatchCommandResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type Coordinate_Mode int32
+
+const (
+	Coordinate_ABSOLUTE Coordinate_Mode = 0
+	Coordinate_RELATIVE Coordinate_Mode = 1
+	Coordinate_LOCAL    Coordinate_Mode = 2
+)
+
+// Enum value maps for Coordinate_Mode.
+var (
+	Coordinate_Mode_name = map[int32]string{
+		0: "ABSOLUTE",
+		1: "RELATIVE",
+		2: "LOCAL",
+	}
+	Coordinate_Mode_value = map[string]int32{
+		"ABSOLUTE": 0,
+		"RELATIVE": 1,
+		"LOCAL":    2,
+	}
+)
+
+func (x Coordinate_Mode) Enum() *Coordinate_Mode {
+	p := new(Coordinate_Mode)
+	*p = x
+	return p
+}
+
+func (x Coordinate_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Coordinate_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[5].Descriptor()
+}
+
+func (Coordinate_Mode) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[5]
+}
+
+func (x Coordinate_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Coordinate_Mode.Descriptor instead.
+func (Coordinate_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type SelectorSpec_Target int32
+
+const (
+	SelectorSpec_ALL_PLAYERS    SelectorSpec_Target = 0
+	SelectorSpec_ENTITIES       SelectorSpec_Target = 1
+	SelectorSpec_NEAREST_PLAYER SelectorSpec_Target = 2
+	SelectorSpec_RANDOM_PLAYER  SelectorSpec_Target = 3
+	SelectorSpec_SELF           SelectorSpec_Target = 4
+	SelectorSpec_INITIATOR      SelectorSpec_Target = 5
+)
+
+// Enum value maps for SelectorSpec_Target.
+var (
+	SelectorSpec_Target_name = map[int32]string{
+		0: "ALL_PLAYERS",
+		1: "ENTITIES",
+		2: "NEAREST_PLAYER",
+		3: "RANDOM_PLAYER",
+		4: "SELF",
+		5: "INITIATOR",
+	}
+	SelectorSpec_Target_value = map[string]int32{
+		"ALL_PLAYERS":    0,
+		"ENTITIES":       1,
+		"NEAREST_PLAYER": 2,
+		"RANDOM_PLAYER":  3,
+		"SELF":           4,
+		"INITIATOR":      5,
+	}
+)
+
+func (x SelectorSpec_Target) Enum() *SelectorSpec_Target {
+	p := new(SelectorSpec_Target)
+	*p = x
+	return p
+}
+
+func (x SelectorSpec_Target) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelectorSpec_Target) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[6].Descriptor()
+}
+
+func (SelectorSpec_Target) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[6]
+}
+
+func (x SelectorSpec_Target) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelectorSpec_Target.Descriptor instead.
+func (SelectorSpec_Target) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type PolicyRule_Action int32
+
+const (
+	PolicyRule_ALLOW   PolicyRule_Action = 0
+	PolicyRule_DENY    PolicyRule_Action = 1
+	PolicyRule_REWRITE PolicyRule_Action = 2
+)
+
+// Enum value maps for PolicyRule_Action.
+var (
+	PolicyRule_Action_name = map[int32]string{
+		0: "ALLOW",
+		1: "DENY",
+		2: "REWRITE",
+	}
+	PolicyRule_Action_value = map[string]int32{
+		"ALLOW":   0,
+		"DENY":    1,
+		"REWRITE": 2,
+	}
+)
+
+func (x PolicyRule_Action) Enum() *PolicyRule_Action {
+	p := new(PolicyRule_Action)
+	*p = x
+	return p
+}
+
+func (x PolicyRule_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyRule_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[7].Descriptor()
+}
+
+func (PolicyRule_Action) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[7]
+}
+
+func (x PolicyRule_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyRule_Action.Descriptor instead.
+func (PolicyRule_Action) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type ScriptEvent_Kind int32
+
+const (
+	ScriptEvent_COMMAND ScriptEvent_Kind = 0
+	ScriptEvent_SET     ScriptEvent_Kind = 1
+	ScriptEvent_WAIT    ScriptEvent_Kind = 2
+	ScriptEvent_LOOP    ScriptEvent_Kind = 3
+	ScriptEvent_DONE    ScriptEvent_Kind = 4
+)
+
+// Enum value maps for ScriptEvent_Kind.
+var (
+	ScriptEvent_Kind_name = map[int32]string{
+		0: "COMMAND",
+		1: "SET",
+		2: "WAIT",
+		3: "LOOP",
+		4: "DONE",
+	}
+	ScriptEvent_Kind_value = map[string]int32{
+		"COMMAND": 0,
+		"SET":     1,
+		"WAIT":    2,
+		"LOOP":    3,
+		"DONE":    4,
+	}
+)
+
+func (x ScriptEvent_Kind) Enum() *ScriptEvent_Kind {
+	p := new(ScriptEvent_Kind)
+	*p = x
+	return p
+}
+
+func (x ScriptEvent_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScriptEvent_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_command_proto_enumTypes[8].Descriptor()
+}
+
+func (ScriptEvent_Kind) Type() protoreflect.EnumType {
+	return &file_proto_command_proto_enumTypes[8]
+}
+
+func (x ScriptEvent_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScriptEvent_Kind.Descriptor instead.
+func (ScriptEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{48, 0}
+}
+
+type CommandPacing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Priority      CommandPriority        `protobuf:"varint,1,opt,name=priority,proto3,enum=fateark.proto.command.CommandPriority" json:"priority,omitempty"`
+	FailFast      bool                   `protobuf:"varint,2,opt,name=fail_fast,json=failFast,proto3" json:"fail_fast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandPacing) Reset() {
+	*x = CommandPacing{}
+	mi := &file_proto_command_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandPacing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandPacing) ProtoMessage() {}
+
+func (x *CommandPacing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandPacing.ProtoReflect.Descriptor instead.
+func (*CommandPacing) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CommandPacing) GetPriority() CommandPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return CommandPriority_AUTOMATION
+}
+
+func (x *CommandPacing) GetFailFast() bool {
+	if x != nil {
+		return x.FailFast
+	}
+	return false
+}
+
 type SendWOCommandRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Pacing        *CommandPacing         `protobuf:"bytes,2,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendWOCommandRequest) Reset() {
+	*x = SendWOCommandRequest{}
+	mi := &file_proto_command_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendWOCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWOCommandRequest) ProtoMessage() {}
+
+func (x *SendWOCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWOCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendWOCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendWOCommandRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendWOCommandRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type SendWSCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Pacing        *CommandPacing         `protobuf:"bytes,2,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendWSCommandRequest) Reset() {
+	*x = SendWSCommandRequest{}
+	mi := &file_proto_command_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendWSCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWSCommandRequest) ProtoMessage() {}
+
+func (x *SendWSCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWSCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendWSCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendWSCommandRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendWSCommandRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type SendPlayerCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Pacing        *CommandPacing         `protobuf:"bytes,2,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPlayerCommandRequest) Reset() {
+	*x = SendPlayerCommandRequest{}
+	mi := &file_proto_command_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPlayerCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPlayerCommandRequest) ProtoMessage() {}
+
+func (x *SendPlayerCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPlayerCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendPlayerCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendPlayerCommandRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendPlayerCommandRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type SendAICommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeId     string                 `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Cmd           string                 `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Pacing        *CommandPacing         `protobuf:"bytes,3,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAICommandRequest) Reset() {
+	*x = SendAICommandRequest{}
+	mi := &file_proto_command_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAICommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAICommandRequest) ProtoMessage() {}
+
+func (x *SendAICommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAICommandRequest.ProtoReflect.Descriptor instead.
+func (*SendAICommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SendAICommandRequest) GetRuntimeId() string {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return ""
+}
+
+func (x *SendAICommandRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendAICommandRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type SendWSCommandWithResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	TimeoutMs     uint32                 `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Pacing        *CommandPacing         `protobuf:"bytes,3,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendWSCommandWithResponseRequest) Reset() {
+	*x = SendWSCommandWithResponseRequest{}
+	mi := &file_proto_command_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendWSCommandWithResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWSCommandWithResponseRequest) ProtoMessage() {}
+
+func (x *SendWSCommandWithResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWSCommandWithResponseRequest.ProtoReflect.Descriptor instead.
+func (*SendWSCommandWithResponseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendWSCommandWithResponseRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendWSCommandWithResponseRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *SendWSCommandWithResponseRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type SendPlayerCommandWithResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	TimeoutMs     uint32                 `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Pacing        *CommandPacing         `protobuf:"bytes,3,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPlayerCommandWithResponseRequest) Reset() {
+	*x = SendPlayerCommandWithResponseRequest{}
+	mi := &file_proto_command_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPlayerCommandWithResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPlayerCommandWithResponseRequest) ProtoMessage() {}
+
+func (x *SendPlayerCommandWithResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPlayerCommandWithResponseRequest.ProtoReflect.Descriptor instead.
+func (*SendPlayerCommandWithResponseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SendPlayerCommandWithResponseRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendPlayerCommandWithResponseRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *SendPlayerCommandWithResponseRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type SendAICommandWithResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeId     string                 `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Cmd           string                 `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	TimeoutMs     uint32                 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Pacing        *CommandPacing         `protobuf:"bytes,4,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAICommandWithResponseRequest) Reset() {
+	*x = SendAICommandWithResponseRequest{}
+	mi := &file_proto_command_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAICommandWithResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAICommandWithResponseRequest) ProtoMessage() {}
+
+func (x *SendAICommandWithResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAICommandWithResponseRequest.ProtoReflect.Descriptor instead.
+func (*SendAICommandWithResponseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendAICommandWithResponseRequest) GetRuntimeId() string {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return ""
+}
+
+func (x *SendAICommandWithResponseRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendAICommandWithResponseRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *SendAICommandWithResponseRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type CommandOutputMessage struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	MessageId  string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Success    bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Parameters []string               `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// rendered is a best-effort en_US rendering. Only common keys are
+	// translated; others read as "<message_id> <param>, <param>".
+	Rendered      string `protobuf:"bytes,4,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOutputMessage) Reset() {
+	*x = CommandOutputMessage{}
+	mi := &file_proto_command_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOutputMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutputMessage) ProtoMessage() {}
+
+func (x *CommandOutputMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutputMessage.ProtoReflect.Descriptor instead.
+func (*CommandOutputMessage) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CommandOutputMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *CommandOutputMessage) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommandOutputMessage) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *CommandOutputMessage) GetRendered() string {
+	if x != nil {
+		return x.Rendered
+	}
+	return ""
+}
+
+type CommandOutput struct {
+	state        protoimpl.MessageState  `protogen:"open.v1"`
+	SuccessCount uint32                  `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	OutputType   CommandOutput_Type      `protobuf:"varint,2,opt,name=output_type,json=outputType,proto3,enum=fateark.proto.command.CommandOutput_Type" json:"output_type,omitempty"`
+	Messages     []*CommandOutputMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	DataSet      string                  `protobuf:"bytes,4,opt,name=data_set,json=dataSet,proto3" json:"data_set,omitempty"`
+	// rendered joins the best-effort rendering of each message with newlines.
+	Rendered      string `protobuf:"bytes,5,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOutput) Reset() {
+	*x = CommandOutput{}
+	mi := &file_proto_command_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutput) ProtoMessage() {}
+
+func (x *CommandOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutput.ProtoReflect.Descriptor instead.
+func (*CommandOutput) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CommandOutput) GetSuccessCount() uint32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *CommandOutput) GetOutputType() CommandOutput_Type {
+	if x != nil {
+		return x.OutputType
+	}
+	return CommandOutput_NONE
+}
+
+func (x *CommandOutput) GetMessages() []*CommandOutputMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *CommandOutput) GetDataSet() string {
+	if x != nil {
+		return x.DataSet
+	}
+	return ""
+}
+
+func (x *CommandOutput) GetRendered() string {
+	if x != nil {
+		return x.Rendered
+	}
+	return ""
+}
+
+type CommandOutputResponse struct {
+	state  protoimpl.MessageState       `protogen:"open.v1"`
+	Status CommandOutputResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=fateark.proto.command.CommandOutputResponse_Status" json:"status,omitempty"`
+	// Deprecated: Marked as deprecated in proto/command.proto.
+	Payload       string         `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	ErrorMsg      string         `protobuf:"bytes,3,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	Output        *CommandOutput `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOutputResponse) Reset() {
+	*x = CommandOutputResponse{}
+	mi := &file_proto_command_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOutputResponse) ProtoMessage() {}
+
+func (x *CommandOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOutputResponse.ProtoReflect.Descriptor instead.
+func (*CommandOutputResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CommandOutputResponse) GetStatus() CommandOutputResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CommandOutputResponse_SUCCESS
+}
+
+// Deprecated: Marked as deprecated in proto/command.proto.
+func (x *CommandOutputResponse) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *CommandOutputResponse) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *CommandOutputResponse) GetOutput() *CommandOutput {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type BatchCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Mode          CommandMode            `protobuf:"varint,2,opt,name=mode,proto3,enum=fateark.proto.command.CommandMode" json:"mode,omitempty"`
+	RuntimeId     string                 `protobuf:"bytes,3,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCommand) Reset() {
+	*x = BatchCommand{}
+	mi := &file_proto_command_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCommand) ProtoMessage() {}
+
+func (x *BatchCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCommand.ProtoReflect.Descriptor instead.
+func (*BatchCommand) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchCommand) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *BatchCommand) GetMode() CommandMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CommandMode_WS
+}
+
+func (x *BatchCommand) GetRuntimeId() string {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return ""
+}
+
+type SendCommandBatchRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Commands    []*BatchCommand        `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	Concurrency uint32                 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	StopOnError bool                   `protobuf:"varint,3,opt,name=stop_on_error,json=stopOnError,proto3" json:"stop_on_error,omitempty"`
+	WantOutput  bool                   `protobuf:"varint,4,opt,name=want_output,json=wantOutput,proto3" json:"want_output,omitempty"`
+	Pacing      *CommandPacing         `protobuf:"bytes,5,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	// timeout_ms bounds the wait for each command; a command that times out
+	// is reported as FAILED.
+	TimeoutMs     uint32 `protobuf:"varint,6,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandBatchRequest) Reset() {
+	*x = SendCommandBatchRequest{}
+	mi := &file_proto_command_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandBatchRequest) ProtoMessage() {}
+
+func (x *SendCommandBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandBatchRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SendCommandBatchRequest) GetCommands() []*BatchCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *SendCommandBatchRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *SendCommandBatchRequest) GetStopOnError() bool {
+	if x != nil {
+		return x.StopOnError
+	}
+	return false
+}
+
+func (x *SendCommandBatchRequest) GetWantOutput() bool {
+	if x != nil {
+		return x.WantOutput
+	}
+	return false
+}
+
+func (x *SendCommandBatchRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+func (x *SendCommandBatchRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type BatchCommandResult struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Index         uint32                    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Cmd           string                    `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Status        BatchCommandResult_Status `protobuf:"varint,3,opt,name=status,proto3,enum=fateark.proto.command.BatchCommandResult_Status" json:"status,omitempty"`
+	ErrorMsg      string                    `protobuf:"bytes,4,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	SuccessCount  uint32                    `protobuf:"varint,5,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	Output        string                    `protobuf:"bytes,6,opt,name=output,proto3" json:"output,omitempty"`
+	StartedAtMs   int64                     `protobuf:"varint,7,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"`
+	DurationMs    int64                     `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CommandOutput *CommandOutput            `protobuf:"bytes,9,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCommandResult) Reset() {
+	*x = BatchCommandResult{}
+	mi := &file_proto_command_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCommandResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCommandResult) ProtoMessage() {}
+
+func (x *BatchCommandResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCommandResult.ProtoReflect.Descriptor instead.
+func (*BatchCommandResult) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchCommandResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchCommandResult) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *BatchCommandResult) GetStatus() BatchCommandResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return BatchCommandResult_SUCCESS
+}
+
+func (x *BatchCommandResult) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *BatchCommandResult) GetSuccessCount() uint32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchCommandResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *BatchCommandResult) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *BatchCommandResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *BatchCommandResult) GetCommandOutput() *CommandOutput {
+	if x != nil {
+		return x.CommandOutput
+	}
+	return nil
+}
+
+type SendCommandBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchCommandResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     uint32                 `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        uint32                 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       uint32                 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandBatchResponse) Reset() {
+	*x = SendCommandBatchResponse{}
+	mi := &file_proto_command_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandBatchResponse) ProtoMessage() {}
+
+func (x *SendCommandBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandBatchResponse.ProtoReflect.Descriptor instead.
+func (*SendCommandBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SendCommandBatchResponse) GetResults() []*BatchCommandResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SendCommandBatchResponse) GetSucceeded() uint32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *SendCommandBatchResponse) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SendCommandBatchResponse) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *SendCommandBatchResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type CommandLaneStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Priority      CommandPriority        `protobuf:"varint,1,opt,name=priority,proto3,enum=fateark.proto.command.CommandPriority" json:"priority,omitempty"`
+	Depth         uint32                 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	MaxDepth      uint32                 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	Dispatched    uint64                 `protobuf:"varint,4,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	Rejected      uint64                 `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Cancelled     uint64                 `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	AverageWaitMs int64                  `protobuf:"varint,7,opt,name=average_wait_ms,json=averageWaitMs,proto3" json:"average_wait_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandLaneStats) Reset() {
+	*x = CommandLaneStats{}
+	mi := &file_proto_command_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandLaneStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandLaneStats) ProtoMessage() {}
+
+func (x *CommandLaneStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandLaneStats.ProtoReflect.Descriptor instead.
+func (*CommandLaneStats) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CommandLaneStats) GetPriority() CommandPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return CommandPriority_AUTOMATION
+}
+
+func (x *CommandLaneStats) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CommandLaneStats) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *CommandLaneStats) GetDispatched() uint64 {
+	if x != nil {
+		return x.Dispatched
+	}
+	return 0
+}
+
+func (x *CommandLaneStats) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *CommandLaneStats) GetCancelled() uint64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *CommandLaneStats) GetAverageWaitMs() int64 {
+	if x != nil {
+		return x.AverageWaitMs
+	}
+	return 0
+}
+
+type CommandPacingConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PerTick       uint32                 `protobuf:"varint,1,opt,name=per_tick,json=perTick,proto3" json:"per_tick,omitempty"`
+	TickMs        uint32                 `protobuf:"varint,2,opt,name=tick_ms,json=tickMs,proto3" json:"tick_ms,omitempty"`
+	QueueSize     uint32                 `protobuf:"varint,3,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandPacingConfig) Reset() {
+	*x = CommandPacingConfig{}
+	mi := &file_proto_command_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandPacingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandPacingConfig) ProtoMessage() {}
+
+func (x *CommandPacingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandPacingConfig.ProtoReflect.Descriptor instead.
+func (*CommandPacingConfig) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CommandPacingConfig) GetPerTick() uint32 {
+	if x != nil {
+		return x.PerTick
+	}
+	return 0
+}
+
+func (x *CommandPacingConfig) GetTickMs() uint32 {
+	if x != nil {
+		return x.TickMs
+	}
+	return 0
+}
+
+func (x *CommandPacingConfig) GetQueueSize() uint32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+type GetCommandQueueStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandQueueStatsRequest) Reset() {
+	*x = GetCommandQueueStatsRequest{}
+	mi := &file_proto_command_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandQueueStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandQueueStatsRequest) ProtoMessage() {}
+
+func (x *GetCommandQueueStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandQueueStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommandQueueStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{17}
+}
+
+type GetCommandQueueStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *CommandPacingConfig   `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Lanes         []*CommandLaneStats    `protobuf:"bytes,2,rep,name=lanes,proto3" json:"lanes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandQueueStatsResponse) Reset() {
+	*x = GetCommandQueueStatsResponse{}
+	mi := &file_proto_command_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandQueueStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandQueueStatsResponse) ProtoMessage() {}
+
+func (x *GetCommandQueueStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandQueueStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommandQueueStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCommandQueueStatsResponse) GetConfig() *CommandPacingConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetCommandQueueStatsResponse) GetLanes() []*CommandLaneStats {
+	if x != nil {
+		return x.Lanes
+	}
+	return nil
+}
+
+type ConfigureCommandPacingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *CommandPacingConfig   `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureCommandPacingRequest) Reset() {
+	*x = ConfigureCommandPacingRequest{}
+	mi := &file_proto_command_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureCommandPacingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureCommandPacingRequest) ProtoMessage() {}
+
+func (x *ConfigureCommandPacingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureCommandPacingRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureCommandPacingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfigureCommandPacingRequest) GetConfig() *CommandPacingConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type Coordinate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          Coordinate_Mode        `protobuf:"varint,1,opt,name=mode,proto3,enum=fateark.proto.command.Coordinate_Mode" json:"mode,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinate) Reset() {
+	*x = Coordinate{}
+	mi := &file_proto_command_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinate) ProtoMessage() {}
+
+func (x *Coordinate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinate.ProtoReflect.Descriptor instead.
+func (*Coordinate) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Coordinate) GetMode() Coordinate_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Coordinate_ABSOLUTE
+}
+
+func (x *Coordinate) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             *Coordinate            `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             *Coordinate            `protobuf:"bytes,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             *Coordinate            `protobuf:"bytes,3,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_proto_command_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Position) GetX() *Coordinate {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *Position) GetY() *Coordinate {
+	if x != nil {
+		return x.Y
+	}
+	return nil
+}
+
+func (x *Position) GetZ() *Coordinate {
+	if x != nil {
+		return x.Z
+	}
+	return nil
+}
+
+type ScoreRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int32                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *int32                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreRange) Reset() {
+	*x = ScoreRange{}
+	mi := &file_proto_command_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreRange) ProtoMessage() {}
+
+func (x *ScoreRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreRange.ProtoReflect.Descriptor instead.
+func (*ScoreRange) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScoreRange) GetMin() int32 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *ScoreRange) GetMax() int32 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type SelectorSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        SelectorSpec_Target    `protobuf:"varint,1,opt,name=target,proto3,enum=fateark.proto.command.SelectorSpec_Target" json:"target,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExcludeNames  []string               `protobuf:"bytes,3,rep,name=exclude_names,json=excludeNames,proto3" json:"exclude_names,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExcludeTags   []string               `protobuf:"bytes,5,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	ExcludeTypes  []string               `protobuf:"bytes,7,rep,name=exclude_types,json=excludeTypes,proto3" json:"exclude_types,omitempty"`
+	Count         *int32                 `protobuf:"varint,8,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	RuntimeId     *uint64                `protobuf:"varint,9,opt,name=runtime_id,json=runtimeId,proto3,oneof" json:"runtime_id,omitempty"`
+	Origin        *Position              `protobuf:"bytes,10,opt,name=origin,proto3" json:"origin,omitempty"`
+	Radius        *float64               `protobuf:"fixed64,11,opt,name=radius,proto3,oneof" json:"radius,omitempty"`
+	MinRadius     *float64               `protobuf:"fixed64,12,opt,name=min_radius,json=minRadius,proto3,oneof" json:"min_radius,omitempty"`
+	Scores        map[string]*ScoreRange `protobuf:"bytes,13,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectorSpec) Reset() {
+	*x = SelectorSpec{}
+	mi := &file_proto_command_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectorSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorSpec) ProtoMessage() {}
+
+func (x *SelectorSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorSpec.ProtoReflect.Descriptor instead.
+func (*SelectorSpec) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SelectorSpec) GetTarget() SelectorSpec_Target {
+	if x != nil {
+		return x.Target
+	}
+	return SelectorSpec_ALL_PLAYERS
+}
+
+func (x *SelectorSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelectorSpec) GetExcludeNames() []string {
+	if x != nil {
+		return x.ExcludeNames
+	}
+	return nil
+}
+
+func (x *SelectorSpec) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SelectorSpec) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *SelectorSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SelectorSpec) GetExcludeTypes() []string {
+	if x != nil {
+		return x.ExcludeTypes
+	}
+	return nil
+}
+
+func (x *SelectorSpec) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *SelectorSpec) GetRuntimeId() uint64 {
+	if x != nil && x.RuntimeId != nil {
+		return *x.RuntimeId
+	}
+	return 0
+}
+
+func (x *SelectorSpec) GetOrigin() *Position {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *SelectorSpec) GetRadius() float64 {
+	if x != nil && x.Radius != nil {
+		return *x.Radius
+	}
+	return 0
+}
+
+func (x *SelectorSpec) GetMinRadius() float64 {
+	if x != nil && x.MinRadius != nil {
+		return *x.MinRadius
+	}
+	return 0
+}
+
+func (x *SelectorSpec) GetScores() map[string]*ScoreRange {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type RawTextComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Part:
+	//
+	//	*RawTextComponent_Text
+	//	*RawTextComponent_Translate_
+	//	*RawTextComponent_Selector
+	//	*RawTextComponent_Score_
+	Part          isRawTextComponent_Part `protobuf_oneof:"part"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RawTextComponent) Reset() {
+	*x = RawTextComponent{}
+	mi := &file_proto_command_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RawTextComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawTextComponent) ProtoMessage() {}
+
+func (x *RawTextComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawTextComponent.ProtoReflect.Descriptor instead.
+func (*RawTextComponent) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RawTextComponent) GetPart() isRawTextComponent_Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *RawTextComponent) GetText() string {
+	if x != nil {
+		if x, ok := x.Part.(*RawTextComponent_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *RawTextComponent) GetTranslate() *RawTextComponent_Translate {
+	if x != nil {
+		if x, ok := x.Part.(*RawTextComponent_Translate_); ok {
+			return x.Translate
+		}
+	}
+	return nil
+}
+
+func (x *RawTextComponent) GetSelector() *SelectorSpec {
+	if x != nil {
+		if x, ok := x.Part.(*RawTextComponent_Selector); ok {
+			return x.Selector
+		}
+	}
+	return nil
+}
+
+func (x *RawTextComponent) GetScore() *RawTextComponent_Score {
+	if x != nil {
+		if x, ok := x.Part.(*RawTextComponent_Score_); ok {
+			return x.Score
+		}
+	}
+	return nil
+}
+
+type isRawTextComponent_Part interface {
+	isRawTextComponent_Part()
+}
+
+type RawTextComponent_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type RawTextComponent_Translate_ struct {
+	Translate *RawTextComponent_Translate `protobuf:"bytes,2,opt,name=translate,proto3,oneof"`
+}
+
+type RawTextComponent_Selector struct {
+	Selector *SelectorSpec `protobuf:"bytes,3,opt,name=selector,proto3,oneof"`
+}
+
+type RawTextComponent_Score_ struct {
+	Score *RawTextComponent_Score `protobuf:"bytes,4,opt,name=score,proto3,oneof"`
+}
+
+func (*RawTextComponent_Text) isRawTextComponent_Part() {}
+
+func (*RawTextComponent_Translate_) isRawTextComponent_Part() {}
+
+func (*RawTextComponent_Selector) isRawTextComponent_Part() {}
+
+func (*RawTextComponent_Score_) isRawTextComponent_Part() {}
+
+type RawText struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*RawTextComponent    `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RawText) Reset() {
+	*x = RawText{}
+	mi := &file_proto_command_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RawText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawText) ProtoMessage() {}
+
+func (x *RawText) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawText.ProtoReflect.Descriptor instead.
+func (*RawText) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RawText) GetComponents() []*RawTextComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type ExecuteSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	As            *SelectorSpec          `protobuf:"bytes,1,opt,name=as,proto3" json:"as,omitempty"`
+	Cmd           string                 `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteSpec) Reset() {
+	*x = ExecuteSpec{}
+	mi := &file_proto_command_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSpec) ProtoMessage() {}
+
+func (x *ExecuteSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSpec.ProtoReflect.Descriptor instead.
+func (*ExecuteSpec) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExecuteSpec) GetAs() *SelectorSpec {
+	if x != nil {
+		return x.As
+	}
+	return nil
+}
+
+func (x *ExecuteSpec) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+type BuildCommandFragmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Fragment:
+	//
+	//	*BuildCommandFragmentRequest_Quoted
+	//	*BuildCommandFragmentRequest_Selector
+	//	*BuildCommandFragmentRequest_Rawtext
+	//	*BuildCommandFragmentRequest_Position
+	//	*BuildCommandFragmentRequest_Execute
+	Fragment      isBuildCommandFragmentRequest_Fragment `protobuf_oneof:"fragment"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildCommandFragmentRequest) Reset() {
+	*x = BuildCommandFragmentRequest{}
+	mi := &file_proto_command_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildCommandFragmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildCommandFragmentRequest) ProtoMessage() {}
+
+func (x *BuildCommandFragmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildCommandFragmentRequest.ProtoReflect.Descriptor instead.
+func (*BuildCommandFragmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BuildCommandFragmentRequest) GetFragment() isBuildCommandFragmentRequest_Fragment {
+	if x != nil {
+		return x.Fragment
+	}
+	return nil
+}
+
+func (x *BuildCommandFragmentRequest) GetQuoted() string {
+	if x != nil {
+		if x, ok := x.Fragment.(*BuildCommandFragmentRequest_Quoted); ok {
+			return x.Quoted
+		}
+	}
+	return ""
+}
+
+func (x *BuildCommandFragmentRequest) GetSelector() *SelectorSpec {
+	if x != nil {
+		if x, ok := x.Fragment.(*BuildCommandFragmentRequest_Selector); ok {
+			return x.Selector
+		}
+	}
+	return nil
+}
+
+func (x *BuildCommandFragmentRequest) GetRawtext() *RawText {
+	if x != nil {
+		if x, ok := x.Fragment.(*BuildCommandFragmentRequest_Rawtext); ok {
+			return x.Rawtext
+		}
+	}
+	return nil
+}
+
+func (x *BuildCommandFragmentRequest) GetPosition() *Position {
+	if x != nil {
+		if x, ok := x.Fragment.(*BuildCommandFragmentRequest_Position); ok {
+			return x.Position
+		}
+	}
+	return nil
+}
+
+func (x *BuildCommandFragmentRequest) GetExecute() *ExecuteSpec {
+	if x != nil {
+		if x, ok := x.Fragment.(*BuildCommandFragmentRequest_Execute); ok {
+			return x.Execute
+		}
+	}
+	return nil
+}
+
+type isBuildCommandFragmentRequest_Fragment interface {
+	isBuildCommandFragmentRequest_Fragment()
+}
+
+type BuildCommandFragmentRequest_Quoted struct {
+	Quoted string `protobuf:"bytes,1,opt,name=quoted,proto3,oneof"`
+}
+
+type BuildCommandFragmentRequest_Selector struct {
+	Selector *SelectorSpec `protobuf:"bytes,2,opt,name=selector,proto3,oneof"`
+}
+
+type BuildCommandFragmentRequest_Rawtext struct {
+	Rawtext *RawText `protobuf:"bytes,3,opt,name=rawtext,proto3,oneof"`
+}
+
+type BuildCommandFragmentRequest_Position struct {
+	Position *Position `protobuf:"bytes,4,opt,name=position,proto3,oneof"`
+}
+
+type BuildCommandFragmentRequest_Execute struct {
+	Execute *ExecuteSpec `protobuf:"bytes,5,opt,name=execute,proto3,oneof"`
+}
+
+func (*BuildCommandFragmentRequest_Quoted) isBuildCommandFragmentRequest_Fragment() {}
+
+func (*BuildCommandFragmentRequest_Selector) isBuildCommandFragmentRequest_Fragment() {}
+
+func (*BuildCommandFragmentRequest_Rawtext) isBuildCommandFragmentRequest_Fragment() {}
+
+func (*BuildCommandFragmentRequest_Position) isBuildCommandFragmentRequest_Fragment() {}
+
+func (*BuildCommandFragmentRequest_Execute) isBuildCommandFragmentRequest_Fragment() {}
+
+type BuildCommandFragmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fragment      string                 `protobuf:"bytes,1,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildCommandFragmentResponse) Reset() {
+	*x = BuildCommandFragmentResponse{}
+	mi := &file_proto_command_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildCommandFragmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildCommandFragmentResponse) ProtoMessage() {}
+
+func (x *BuildCommandFragmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildCommandFragmentResponse.ProtoReflect.Descriptor instead.
+func (*BuildCommandFragmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BuildCommandFragmentResponse) GetFragment() string {
+	if x != nil {
+		return x.Fragment
+	}
+	return ""
+}
+
+type SendCommandAsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*SendCommandAsRequest_PlayerUuid
+	//	*SendCommandAsRequest_Username
+	//	*SendCommandAsRequest_EntityUniqueId
+	//	*SendCommandAsRequest_Selector
+	Target        isSendCommandAsRequest_Target `protobuf_oneof:"target"`
+	Cmd           string                        `protobuf:"bytes,5,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	TimeoutMs     uint32                        `protobuf:"varint,6,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Pacing        *CommandPacing                `protobuf:"bytes,7,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandAsRequest) Reset() {
+	*x = SendCommandAsRequest{}
+	mi := &file_proto_command_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandAsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandAsRequest) ProtoMessage() {}
+
+func (x *SendCommandAsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandAsRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandAsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SendCommandAsRequest) GetTarget() isSendCommandAsRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SendCommandAsRequest) GetPlayerUuid() string {
+	if x != nil {
+		if x, ok := x.Target.(*SendCommandAsRequest_PlayerUuid); ok {
+			return x.PlayerUuid
+		}
+	}
+	return ""
+}
+
+func (x *SendCommandAsRequest) GetUsername() string {
+	if x != nil {
+		if x, ok := x.Target.(*SendCommandAsRequest_Username); ok {
+			return x.Username
+		}
+	}
+	return ""
+}
+
+func (x *SendCommandAsRequest) GetEntityUniqueId() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*SendCommandAsRequest_EntityUniqueId); ok {
+			return x.EntityUniqueId
+		}
+	}
+	return 0
+}
+
+func (x *SendCommandAsRequest) GetSelector() *SelectorSpec {
+	if x != nil {
+		if x, ok := x.Target.(*SendCommandAsRequest_Selector); ok {
+			return x.Selector
+		}
+	}
+	return nil
+}
+
+func (x *SendCommandAsRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *SendCommandAsRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *SendCommandAsRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type isSendCommandAsRequest_Target interface {
+	isSendCommandAsRequest_Target()
+}
+
+type SendCommandAsRequest_PlayerUuid struct {
+	PlayerUuid string `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3,oneof"`
+}
+
+type SendCommandAsRequest_Username struct {
+	Username string `protobuf:"bytes,2,opt,name=username,proto3,oneof"`
+}
+
+type SendCommandAsRequest_EntityUniqueId struct {
+	EntityUniqueId int64 `protobuf:"varint,3,opt,name=entity_unique_id,json=entityUniqueId,proto3,oneof"`
+}
+
+type SendCommandAsRequest_Selector struct {
+	Selector *SelectorSpec `protobuf:"bytes,4,opt,name=selector,proto3,oneof"`
+}
+
+func (*SendCommandAsRequest_PlayerUuid) isSendCommandAsRequest_Target() {}
+
+func (*SendCommandAsRequest_Username) isSendCommandAsRequest_Target() {}
+
+func (*SendCommandAsRequest_EntityUniqueId) isSendCommandAsRequest_Target() {}
+
+func (*SendCommandAsRequest_Selector) isSendCommandAsRequest_Target() {}
+
+type PolicyRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pattern            string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Action             PolicyRule_Action      `protobuf:"varint,3,opt,name=action,proto3,enum=fateark.proto.command.PolicyRule_Action" json:"action,omitempty"`
+	Replacement        string                 `protobuf:"bytes,4,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	UnboundedSelectors []string               `protobuf:"bytes,5,rep,name=unbounded_selectors,json=unboundedSelectors,proto3" json:"unbounded_selectors,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PolicyRule) Reset() {
+	*x = PolicyRule{}
+	mi := &file_proto_command_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyRule) ProtoMessage() {}
+
+func (x *PolicyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyRule.ProtoReflect.Descriptor instead.
+func (*PolicyRule) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PolicyRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *PolicyRule) GetAction() PolicyRule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return PolicyRule_ALLOW
+}
+
+func (x *PolicyRule) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *PolicyRule) GetUnboundedSelectors() []string {
+	if x != nil {
+		return x.UnboundedSelectors
+	}
+	return nil
+}
+
+type PolicyRoleOverride struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Role         string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Token        string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Rules        []*PolicyRule          `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	DefaultDeny  bool                   `protobuf:"varint,4,opt,name=default_deny,json=defaultDeny,proto3" json:"default_deny,omitempty"`
+	ManagePolicy bool                   `protobuf:"varint,5,opt,name=manage_policy,json=managePolicy,proto3" json:"manage_policy,omitempty"`
+	// manage_permissions lets the role change permission roles and their
+	// assignments.
+	ManagePermissions bool `protobuf:"varint,6,opt,name=manage_permissions,json=managePermissions,proto3" json:"manage_permissions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PolicyRoleOverride) Reset() {
+	*x = PolicyRoleOverride{}
+	mi := &file_proto_command_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyRoleOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyRoleOverride) ProtoMessage() {}
+
+func (x *PolicyRoleOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyRoleOverride.ProtoReflect.Descriptor instead.
+func (*PolicyRoleOverride) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PolicyRoleOverride) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PolicyRoleOverride) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PolicyRoleOverride) GetRules() []*PolicyRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *PolicyRoleOverride) GetDefaultDeny() bool {
+	if x != nil {
+		return x.DefaultDeny
+	}
+	return false
+}
+
+func (x *PolicyRoleOverride) GetManagePolicy() bool {
+	if x != nil {
+		return x.ManagePolicy
+	}
+	return false
+}
+
+func (x *PolicyRoleOverride) GetManagePermissions() bool {
+	if x != nil {
+		return x.ManagePermissions
+	}
+	return false
+}
+
+type CommandPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*PolicyRule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	DefaultDeny   bool                   `protobuf:"varint,2,opt,name=default_deny,json=defaultDeny,proto3" json:"default_deny,omitempty"`
+	Overrides     []*PolicyRoleOverride  `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandPolicy) Reset() {
+	*x = CommandPolicy{}
+	mi := &file_proto_command_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandPolicy) ProtoMessage() {}
+
+func (x *CommandPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandPolicy.ProtoReflect.Descriptor instead.
+func (*CommandPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CommandPolicy) GetRules() []*PolicyRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CommandPolicy) GetDefaultDeny() bool {
+	if x != nil {
+		return x.DefaultDeny
+	}
+	return false
+}
+
+func (x *CommandPolicy) GetOverrides() []*PolicyRoleOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type GetCommandPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandPolicyRequest) Reset() {
+	*x = GetCommandPolicyRequest{}
+	mi := &file_proto_command_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandPolicyRequest) ProtoMessage() {}
+
+func (x *GetCommandPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetCommandPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{33}
+}
+
+type SetCommandPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *CommandPolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommandPolicyRequest) Reset() {
+	*x = SetCommandPolicyRequest{}
+	mi := &file_proto_command_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommandPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommandPolicyRequest) ProtoMessage() {}
+
+func (x *SetCommandPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommandPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetCommandPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetCommandPolicyRequest) GetPolicy() *CommandPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type CheckCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCommandRequest) Reset() {
+	*x = CheckCommandRequest{}
+	mi := &file_proto_command_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCommandRequest) ProtoMessage() {}
+
+func (x *CheckCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCommandRequest.ProtoReflect.Descriptor instead.
+func (*CheckCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CheckCommandRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+type CheckCommandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Rule          string                 `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCommandResponse) Reset() {
+	*x = CheckCommandResponse{}
+	mi := &file_proto_command_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCommandResponse) ProtoMessage() {}
+
+func (x *CheckCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCommandResponse.ProtoReflect.Descriptor instead.
+func (*CheckCommandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckCommandResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckCommandResponse) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *CheckCommandResponse) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *CheckCommandResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CommandParameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Optional      bool                   `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	EnumName      string                 `protobuf:"bytes,4,opt,name=enum_name,json=enumName,proto3" json:"enum_name,omitempty"`
+	EnumValues    []string               `protobuf:"bytes,5,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	Suffix        string                 `protobuf:"bytes,6,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandParameter) Reset() {
+	*x = CommandParameter{}
+	mi := &file_proto_command_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandParameter) ProtoMessage() {}
+
+func (x *CommandParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandParameter.ProtoReflect.Descriptor instead.
+func (*CommandParameter) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CommandParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommandParameter) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CommandParameter) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *CommandParameter) GetEnumName() string {
+	if x != nil {
+		return x.EnumName
+	}
+	return ""
+}
+
+func (x *CommandParameter) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *CommandParameter) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+type CommandOverload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parameters    []*CommandParameter    `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandOverload) Reset() {
+	*x = CommandOverload{}
+	mi := &file_proto_command_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandOverload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOverload) ProtoMessage() {}
+
+func (x *CommandOverload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOverload.ProtoReflect.Descriptor instead.
+func (*CommandOverload) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CommandOverload) GetParameters() []*CommandParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type CommandSpec struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Aliases         []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	PermissionLevel uint32                 `protobuf:"varint,4,opt,name=permission_level,json=permissionLevel,proto3" json:"permission_level,omitempty"`
+	Overloads       []*CommandOverload     `protobuf:"bytes,5,rep,name=overloads,proto3" json:"overloads,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CommandSpec) Reset() {
+	*x = CommandSpec{}
+	mi := &file_proto_command_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandSpec) ProtoMessage() {}
+
+func (x *CommandSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandSpec.ProtoReflect.Descriptor instead.
+func (*CommandSpec) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CommandSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommandSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CommandSpec) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CommandSpec) GetPermissionLevel() uint32 {
+	if x != nil {
+		return x.PermissionLevel
+	}
+	return 0
+}
+
+func (x *CommandSpec) GetOverloads() []*CommandOverload {
+	if x != nil {
+		return x.Overloads
+	}
+	return nil
+}
+
+type GetCommandTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandTreeRequest) Reset() {
+	*x = GetCommandTreeRequest{}
+	mi := &file_proto_command_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandTreeRequest) ProtoMessage() {}
+
+func (x *GetCommandTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCommandTreeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetCommandTreeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCommandTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commands      []*CommandSpec         `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommandTreeResponse) Reset() {
+	*x = GetCommandTreeResponse{}
+	mi := &file_proto_command_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommandTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandTreeResponse) ProtoMessage() {}
+
+func (x *GetCommandTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCommandTreeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCommandTreeResponse) GetCommands() []*CommandSpec {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type ValidateCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCommandRequest) Reset() {
+	*x = ValidateCommandRequest{}
+	mi := &file_proto_command_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCommandRequest) ProtoMessage() {}
+
+func (x *ValidateCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCommandRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ValidateCommandRequest) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+type ValidateCommandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Command       string                 `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Overload      int32                  `protobuf:"varint,3,opt,name=overload,proto3" json:"overload,omitempty"`
+	ErrorOffset   int32                  `protobuf:"varint,4,opt,name=error_offset,json=errorOffset,proto3" json:"error_offset,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Expected      []string               `protobuf:"bytes,6,rep,name=expected,proto3" json:"expected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateCommandResponse) Reset() {
+	*x = ValidateCommandResponse{}
+	mi := &file_proto_command_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCommandResponse) ProtoMessage() {}
+
+func (x *ValidateCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCommandResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCommandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ValidateCommandResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateCommandResponse) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ValidateCommandResponse) GetOverload() int32 {
+	if x != nil {
+		return x.Overload
+	}
+	return 0
+}
+
+func (x *ValidateCommandResponse) GetErrorOffset() int32 {
+	if x != nil {
+		return x.ErrorOffset
+	}
+	return 0
+}
+
+func (x *ValidateCommandResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateCommandResponse) GetExpected() []string {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+type CompleteCommandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Cmd   string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	// cursor is the byte offset in cmd to complete at. Unset, negative or
+	// past the end completes at the end of cmd.
+	Cursor        *int32 `protobuf:"varint,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendWOCommandRequest) Reset() {
-	*x = SendWOCommandRequest{}
-	mi := &file_proto_command_proto_msgTypes[0]
+func (x *CompleteCommandRequest) Reset() {
+	*x = CompleteCommandRequest{}
+	mi := &file_proto_command_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendWOCommandRequest) String() string {
+func (x *CompleteCommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendWOCommandRequest) ProtoMessage() {}
+func (*CompleteCommandRequest) ProtoMessage() {}
 
-func (x *SendWOCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_command_proto_msgTypes[0]
+func (x *CompleteCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,40 +3467,56 @@ func (x *SendWOCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendWOCommandRequest.ProtoReflect.Descriptor instead.
-func (*SendWOCommandRequest) Descriptor() ([]byte, []int) {
-	return file_proto_command_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CompleteCommandRequest.ProtoReflect.Descriptor instead.
+func (*CompleteCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SendWOCommandRequest) GetCmd() string {
+func (x *CompleteCommandRequest) GetCmd() string {
 	if x != nil {
 		return x.Cmd
 	}
 	return ""
 }
 
-type SendWSCommandRequest struct {
+func (x *CompleteCommandRequest) GetCursor() int32 {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return 0
+}
+
+func (x *CompleteCommandRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CommandCompletion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Detail        string                 `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	ReplaceFrom   int32                  `protobuf:"varint,3,opt,name=replace_from,json=replaceFrom,proto3" json:"replace_from,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendWSCommandRequest) Reset() {
-	*x = SendWSCommandRequest{}
-	mi := &file_proto_command_proto_msgTypes[1]
+func (x *CommandCompletion) Reset() {
+	*x = CommandCompletion{}
+	mi := &file_proto_command_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendWSCommandRequest) String() string {
+func (x *CommandCompletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendWSCommandRequest) ProtoMessage() {}
+func (*CommandCompletion) ProtoMessage() {}
 
-func (x *SendWSCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_command_proto_msgTypes[1]
+func (x *CommandCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -98,40 +3527,54 @@ func (x *SendWSCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendWSCommandRequest.ProtoReflect.Descriptor instead.
-func (*SendWSCommandRequest) Descriptor() ([]byte, []int) {
-	return file_proto_command_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CommandCompletion.ProtoReflect.Descriptor instead.
+func (*CommandCompletion) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SendWSCommandRequest) GetCmd() string {
+func (x *CommandCompletion) GetText() string {
 	if x != nil {
-		return x.Cmd
+		return x.Text
 	}
 	return ""
 }
 
-type SendPlayerCommandRequest struct {
+func (x *CommandCompletion) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *CommandCompletion) GetReplaceFrom() int32 {
+	if x != nil {
+		return x.ReplaceFrom
+	}
+	return 0
+}
+
+type CompleteCommandResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Completions   []*CommandCompletion   `protobuf:"bytes,1,rep,name=completions,proto3" json:"completions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendPlayerCommandRequest) Reset() {
-	*x = SendPlayerCommandRequest{}
-	mi := &file_proto_command_proto_msgTypes[2]
+func (x *CompleteCommandResponse) Reset() {
+	*x = CompleteCommandResponse{}
+	mi := &file_proto_command_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendPlayerCommandRequest) String() string {
+func (x *CompleteCommandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPlayerCommandRequest) ProtoMessage() {}
+func (*CompleteCommandResponse) ProtoMessage() {}
 
-func (x *SendPlayerCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_command_proto_msgTypes[2]
+func (x *CompleteCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -142,41 +3585,47 @@ func (x *SendPlayerCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPlayerCommandRequest.ProtoReflect.Descriptor instead.
-func (*SendPlayerCommandRequest) Descriptor() ([]byte, []int) {
-	return file_proto_command_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CompleteCommandResponse.ProtoReflect.Descriptor instead.
+func (*CompleteCommandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SendPlayerCommandRequest) GetCmd() string {
+func (x *CompleteCommandResponse) GetCompletions() []*CommandCompletion {
 	if x != nil {
-		return x.Cmd
+		return x.Completions
 	}
-	return ""
+	return nil
 }
 
-type SendAICommandRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuntimeId     string                 `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	Cmd           string                 `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+type RunScriptRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Script string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	// variables seed the script's ${name} references.
+	Variables   map[string]string `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Pacing      *CommandPacing    `protobuf:"bytes,3,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	StopOnError bool              `protobuf:"varint,4,opt,name=stop_on_error,json=stopOnError,proto3" json:"stop_on_error,omitempty"`
+	MaxCommands uint32            `protobuf:"varint,5,opt,name=max_commands,json=maxCommands,proto3" json:"max_commands,omitempty"`
+	// timeout_ms bounds the wait for each command's output.
+	TimeoutMs     uint32 `protobuf:"varint,6,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendAICommandRequest) Reset() {
-	*x = SendAICommandRequest{}
-	mi := &file_proto_command_proto_msgTypes[3]
+func (x *RunScriptRequest) Reset() {
+	*x = RunScriptRequest{}
+	mi := &file_proto_command_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendAICommandRequest) String() string {
+func (x *RunScriptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAICommandRequest) ProtoMessage() {}
+func (*RunScriptRequest) ProtoMessage() {}
 
-func (x *SendAICommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_command_proto_msgTypes[3]
+func (x *RunScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,47 +3636,86 @@ func (x *SendAICommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAICommandRequest.ProtoReflect.Descriptor instead.
-func (*SendAICommandRequest) Descriptor() ([]byte, []int) {
-	return file_proto_command_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RunScriptRequest.ProtoReflect.Descriptor instead.
+func (*RunScriptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SendAICommandRequest) GetRuntimeId() string {
+func (x *RunScriptRequest) GetScript() string {
 	if x != nil {
-		return x.RuntimeId
+		return x.Script
 	}
 	return ""
 }
 
-func (x *SendAICommandRequest) GetCmd() string {
+func (x *RunScriptRequest) GetVariables() map[string]string {
 	if x != nil {
-		return x.Cmd
+		return x.Variables
 	}
-	return ""
+	return nil
 }
 
-type SendWSCommandWithResponseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+func (x *RunScriptRequest) GetPacing() *CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+func (x *RunScriptRequest) GetStopOnError() bool {
+	if x != nil {
+		return x.StopOnError
+	}
+	return false
+}
+
+func (x *RunScriptRequest) GetMaxCommands() uint32 {
+	if x != nil {
+		return x.MaxCommands
+	}
+	return 0
+}
+
+func (x *RunScriptRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ScriptEvent struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Line         uint32                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Kind         ScriptEvent_Kind       `protobuf:"varint,2,opt,name=kind,proto3,enum=fateark.proto.command.ScriptEvent_Kind" json:"kind,omitempty"`
+	Text         string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Success      bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	SuccessCount uint32                 `protobuf:"varint,5,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	Output       string                 `protobuf:"bytes,6,opt,name=output,proto3" json:"output,omitempty"`
+	ErrorMsg     string                 `protobuf:"bytes,7,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	DurationMs   int64                  `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// commands, failed and stopped summarise the run on the final DONE event.
+	Commands      uint32 `protobuf:"varint,9,opt,name=commands,proto3" json:"commands,omitempty"`
+	Failed        uint32 `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	Stopped       bool   `protobuf:"varint,11,opt,name=stopped,proto3" json:"stopped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendWSCommandWithResponseRequest) Reset() {
-	*x = SendWSCommandWithResponseRequest{}
-	mi := &file_proto_command_proto_msgTypes[4]
+func (x *ScriptEvent) Reset() {
+	*x = ScriptEvent{}
+	mi := &file_proto_command_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendWSCommandWithResponseRequest) String() string {
+func (x *ScriptEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendWSCommandWithResponseRequest) ProtoMessage() {}
+func (*ScriptEvent) ProtoMessage() {}
 
-func (x *SendWSCommandWithResponseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_command_proto_msgTypes[4]
+func (x *ScriptEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,40 +3726,111 @@ func (x *SendWSCommandWithResponseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendWSCommandWithResponseRequest.ProtoReflect.Descriptor instead.
-func (*SendWSCommandWithResponseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_command_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ScriptEvent.ProtoReflect.Descriptor instead.
+func (*ScriptEvent) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SendWSCommandWithResponseRequest) GetCmd() string {
+func (x *ScriptEvent) GetLine() uint32 {
 	if x != nil {
-		return x.Cmd
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ScriptEvent) GetKind() ScriptEvent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return ScriptEvent_COMMAND
+}
+
+func (x *ScriptEvent) GetText() string {
+	if x != nil {
+		return x.Text
 	}
 	return ""
 }
 
-type SendPlayerCommandWithResponseRequest struct {
+func (x *ScriptEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ScriptEvent) GetSuccessCount() uint32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ScriptEvent) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ScriptEvent) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *ScriptEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ScriptEvent) GetCommands() uint32 {
+	if x != nil {
+		return x.Commands
+	}
+	return 0
+}
+
+func (x *ScriptEvent) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ScriptEvent) GetStopped() bool {
+	if x != nil {
+		return x.Stopped
+	}
+	return false
+}
+
+type RawTextComponent_Translate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cmd           string                 `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	With          []*RawTextComponent    `protobuf:"bytes,2,rep,name=with,proto3" json:"with,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendPlayerCommandWithResponseRequest) Reset() {
-	*x = SendPlayerCommandWithResponseRequest{}
-	mi := &file_proto_command_proto_msgTypes[5]
+func (x *RawTextComponent_Translate) Reset() {
+	*x = RawTextComponent_Translate{}
+	mi := &file_proto_command_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendPlayerCommandWithResponseRequest) String() string {
+func (x *RawTextComponent_Translate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPlayerCommandWithResponseRequest) ProtoMessage() {}
+func (*RawTextComponent_Translate) ProtoMessage() {}
 
-func (x *SendPlayerCommandWithResponseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_command_proto_msgTypes[5]
+func (x *RawTextComponent_Translate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,41 +3841,48 @@ func (x *SendPlayerCommandWithResponseRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPlayerCommandWithResponseRequest.ProtoReflect.Descriptor instead.
-func (*SendPlayerCommandWithResponseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_command_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RawTextComponent_Translate.ProtoReflect.Descriptor instead.
+func (*RawTextComponent_Translate) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *SendPlayerCommandWithResponseRequest) GetCmd() string {
+func (x *RawTextComponent_Translate) GetKey() string {
 	if x != nil {
-		return x.Cmd
+		return x.Key
 	}
 	return ""
 }
 
-type SendAICommandWithResponseRequest struct {
+func (x *RawTextComponent_Translate) GetWith() []*RawTextComponent {
+	if x != nil {
+		return x.With
+	}
+	return nil
+}
+
+type RawTextComponent_Score struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuntimeId     string                 `protobuf:"bytes,1,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
-	Cmd           string                 `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Objective     string                 `protobuf:"bytes,2,opt,name=objective,proto3" json:"objective,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendAICommandWithResponseRequest) Reset() {
-	*x = SendAICommandWithResponseRequest{}
-	mi := &file_proto_command_proto_msgTypes[6]
+func (x *RawTextComponent_Score) Reset() {
+	*x = RawTextComponent_Score{}
+	mi := &file_proto_command_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendAICommandWithResponseRequest) String() string {
+func (x *RawTextComponent_Score) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAICommandWithResponseRequest) ProtoMessage() {}
+func (*RawTextComponent_Score) ProtoMessage() {}
 
-func (x *SendAICommandWithResponseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_command_proto_msgTypes[6]
+func (x *RawTextComponent_Score) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_command_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,21 +3893,21 @@ func (x *SendAICommandWithResponseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAICommandWithResponseRequest.ProtoReflect.Descriptor instead.
-func (*SendAICommandWithResponseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_command_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RawTextComponent_Score.ProtoReflect.Descriptor instead.
+func (*RawTextComponent_Score) Descriptor() ([]byte, []int) {
+	return file_proto_command_proto_rawDescGZIP(), []int{24, 1}
 }
 
-func (x *SendAICommandWithResponseRequest) GetRuntimeId() string {
+func (x *RawTextComponent_Score) GetName() string {
 	if x != nil {
-		return x.RuntimeId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SendAICommandWithResponseRequest) GetCmd() string {
+func (x *RawTextComponent_Score) GetObjective() string {
 	if x != nil {
-		return x.Cmd
+		return x.Objective
 	}
 	return ""
 }
@@ -350,33 +3916,358 @@ var File_proto_command_proto protoreflect.FileDescriptor
 
 const file_proto_command_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/command.proto\x12\x15fateark.proto.command\x1a\x14proto/response.proto\"(\n" +
+	"\x13proto/command.proto\x12\x15fateark.proto.command\x1a\x14proto/response.proto\"p\n" +
+	"\rCommandPacing\x12B\n" +
+	"\bpriority\x18\x01 \x01(\x0e2&.fateark.proto.command.CommandPriorityR\bpriority\x12\x1b\n" +
+	"\tfail_fast\x18\x02 \x01(\bR\bfailFast\"f\n" +
 	"\x14SendWOCommandRequest\x12\x10\n" +
-	"\x03cmd\x18\x01 \x01(\tR\x03cmd\"(\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x12<\n" +
+	"\x06pacing\x18\x02 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"f\n" +
 	"\x14SendWSCommandRequest\x12\x10\n" +
-	"\x03cmd\x18\x01 \x01(\tR\x03cmd\",\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x12<\n" +
+	"\x06pacing\x18\x02 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"j\n" +
 	"\x18SendPlayerCommandRequest\x12\x10\n" +
-	"\x03cmd\x18\x01 \x01(\tR\x03cmd\"G\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x12<\n" +
+	"\x06pacing\x18\x02 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"\x85\x01\n" +
 	"\x14SendAICommandRequest\x12\x1d\n" +
 	"\n" +
 	"runtime_id\x18\x01 \x01(\tR\truntimeId\x12\x10\n" +
-	"\x03cmd\x18\x02 \x01(\tR\x03cmd\"4\n" +
+	"\x03cmd\x18\x02 \x01(\tR\x03cmd\x12<\n" +
+	"\x06pacing\x18\x03 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"\x91\x01\n" +
 	" SendWSCommandWithResponseRequest\x12\x10\n" +
-	"\x03cmd\x18\x01 \x01(\tR\x03cmd\"8\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\rR\ttimeoutMs\x12<\n" +
+	"\x06pacing\x18\x03 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"\x95\x01\n" +
 	"$SendPlayerCommandWithResponseRequest\x12\x10\n" +
-	"\x03cmd\x18\x01 \x01(\tR\x03cmd\"S\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\rR\ttimeoutMs\x12<\n" +
+	"\x06pacing\x18\x03 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"\xb0\x01\n" +
 	" SendAICommandWithResponseRequest\x12\x1d\n" +
 	"\n" +
 	"runtime_id\x18\x01 \x01(\tR\truntimeId\x12\x10\n" +
-	"\x03cmd\x18\x02 \x01(\tR\x03cmd2\xba\x06\n" +
+	"\x03cmd\x18\x02 \x01(\tR\x03cmd\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x03 \x01(\rR\ttimeoutMs\x12<\n" +
+	"\x06pacing\x18\x04 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"\x8b\x01\n" +
+	"\x14CommandOutputMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\tR\n" +
+	"parameters\x12\x1a\n" +
+	"\brendered\x18\x04 \x01(\tR\brendered\"\xcd\x02\n" +
+	"\rCommandOutput\x12#\n" +
+	"\rsuccess_count\x18\x01 \x01(\rR\fsuccessCount\x12J\n" +
+	"\voutput_type\x18\x02 \x01(\x0e2).fateark.proto.command.CommandOutput.TypeR\n" +
+	"outputType\x12G\n" +
+	"\bmessages\x18\x03 \x03(\v2+.fateark.proto.command.CommandOutputMessageR\bmessages\x12\x19\n" +
+	"\bdata_set\x18\x04 \x01(\tR\adataSet\x12\x1a\n" +
+	"\brendered\x18\x05 \x01(\tR\brendered\"K\n" +
+	"\x04Type\x12\b\n" +
+	"\x04NONE\x10\x00\x12\x0f\n" +
+	"\vLAST_OUTPUT\x10\x01\x12\n" +
+	"\n" +
+	"\x06SILENT\x10\x02\x12\x0e\n" +
+	"\n" +
+	"ALL_OUTPUT\x10\x03\x12\f\n" +
+	"\bDATA_SET\x10\x04\"\x80\x02\n" +
+	"\x15CommandOutputResponse\x12K\n" +
+	"\x06status\x18\x01 \x01(\x0e23.fateark.proto.command.CommandOutputResponse.StatusR\x06status\x12\x1c\n" +
+	"\apayload\x18\x02 \x01(\tB\x02\x18\x01R\apayload\x12\x1b\n" +
+	"\terror_msg\x18\x03 \x01(\tR\berrorMsg\x12<\n" +
+	"\x06output\x18\x04 \x01(\v2$.fateark.proto.command.CommandOutputR\x06output\"!\n" +
+	"\x06Status\x12\v\n" +
+	"\aSUCCESS\x10\x00\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x01\"w\n" +
+	"\fBatchCommand\x12\x10\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x126\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\".fateark.proto.command.CommandModeR\x04mode\x12\x1d\n" +
+	"\n" +
+	"runtime_id\x18\x03 \x01(\tR\truntimeId\"\x9e\x02\n" +
+	"\x17SendCommandBatchRequest\x12?\n" +
+	"\bcommands\x18\x01 \x03(\v2#.fateark.proto.command.BatchCommandR\bcommands\x12 \n" +
+	"\vconcurrency\x18\x02 \x01(\rR\vconcurrency\x12\"\n" +
+	"\rstop_on_error\x18\x03 \x01(\bR\vstopOnError\x12\x1f\n" +
+	"\vwant_output\x18\x04 \x01(\bR\n" +
+	"wantOutput\x12<\n" +
+	"\x06pacing\x18\x05 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x06 \x01(\rR\ttimeoutMs\"\xa2\x03\n" +
+	"\x12BatchCommandResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12\x10\n" +
+	"\x03cmd\x18\x02 \x01(\tR\x03cmd\x12H\n" +
+	"\x06status\x18\x03 \x01(\x0e20.fateark.proto.command.BatchCommandResult.StatusR\x06status\x12\x1b\n" +
+	"\terror_msg\x18\x04 \x01(\tR\berrorMsg\x12#\n" +
+	"\rsuccess_count\x18\x05 \x01(\rR\fsuccessCount\x12\x16\n" +
+	"\x06output\x18\x06 \x01(\tR\x06output\x12\"\n" +
+	"\rstarted_at_ms\x18\a \x01(\x03R\vstartedAtMs\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\x12K\n" +
+	"\x0ecommand_output\x18\t \x01(\v2$.fateark.proto.command.CommandOutputR\rcommandOutput\".\n" +
+	"\x06Status\x12\v\n" +
+	"\aSUCCESS\x10\x00\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x01\x12\v\n" +
+	"\aSKIPPED\x10\x02\"\xd0\x01\n" +
+	"\x18SendCommandBatchResponse\x12C\n" +
+	"\aresults\x18\x01 \x03(\v2).fateark.proto.command.BatchCommandResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\rR\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\rR\x06failed\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\rR\askipped\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\"\x8b\x02\n" +
+	"\x10CommandLaneStats\x12B\n" +
+	"\bpriority\x18\x01 \x01(\x0e2&.fateark.proto.command.CommandPriorityR\bpriority\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\rR\x05depth\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\rR\bmaxDepth\x12\x1e\n" +
+	"\n" +
+	"dispatched\x18\x04 \x01(\x04R\n" +
+	"dispatched\x12\x1a\n" +
+	"\brejected\x18\x05 \x01(\x04R\brejected\x12\x1c\n" +
+	"\tcancelled\x18\x06 \x01(\x04R\tcancelled\x12&\n" +
+	"\x0faverage_wait_ms\x18\a \x01(\x03R\raverageWaitMs\"h\n" +
+	"\x13CommandPacingConfig\x12\x19\n" +
+	"\bper_tick\x18\x01 \x01(\rR\aperTick\x12\x17\n" +
+	"\atick_ms\x18\x02 \x01(\rR\x06tickMs\x12\x1d\n" +
+	"\n" +
+	"queue_size\x18\x03 \x01(\rR\tqueueSize\"\x1d\n" +
+	"\x1bGetCommandQueueStatsRequest\"\xa1\x01\n" +
+	"\x1cGetCommandQueueStatsResponse\x12B\n" +
+	"\x06config\x18\x01 \x01(\v2*.fateark.proto.command.CommandPacingConfigR\x06config\x12=\n" +
+	"\x05lanes\x18\x02 \x03(\v2'.fateark.proto.command.CommandLaneStatsR\x05lanes\"c\n" +
+	"\x1dConfigureCommandPacingRequest\x12B\n" +
+	"\x06config\x18\x01 \x01(\v2*.fateark.proto.command.CommandPacingConfigR\x06config\"\x8d\x01\n" +
+	"\n" +
+	"Coordinate\x12:\n" +
+	"\x04mode\x18\x01 \x01(\x0e2&.fateark.proto.command.Coordinate.ModeR\x04mode\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"-\n" +
+	"\x04Mode\x12\f\n" +
+	"\bABSOLUTE\x10\x00\x12\f\n" +
+	"\bRELATIVE\x10\x01\x12\t\n" +
+	"\x05LOCAL\x10\x02\"\x9d\x01\n" +
+	"\bPosition\x12/\n" +
+	"\x01x\x18\x01 \x01(\v2!.fateark.proto.command.CoordinateR\x01x\x12/\n" +
+	"\x01y\x18\x02 \x01(\v2!.fateark.proto.command.CoordinateR\x01y\x12/\n" +
+	"\x01z\x18\x03 \x01(\v2!.fateark.proto.command.CoordinateR\x01z\"J\n" +
+	"\n" +
+	"ScoreRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x05H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x05H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xf7\x05\n" +
+	"\fSelectorSpec\x12B\n" +
+	"\x06target\x18\x01 \x01(\x0e2*.fateark.proto.command.SelectorSpec.TargetR\x06target\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rexclude_names\x18\x03 \x03(\tR\fexcludeNames\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12!\n" +
+	"\fexclude_tags\x18\x05 \x03(\tR\vexcludeTags\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12#\n" +
+	"\rexclude_types\x18\a \x03(\tR\fexcludeTypes\x12\x19\n" +
+	"\x05count\x18\b \x01(\x05H\x00R\x05count\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"runtime_id\x18\t \x01(\x04H\x01R\truntimeId\x88\x01\x01\x127\n" +
+	"\x06origin\x18\n" +
+	" \x01(\v2\x1f.fateark.proto.command.PositionR\x06origin\x12\x1b\n" +
+	"\x06radius\x18\v \x01(\x01H\x02R\x06radius\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"min_radius\x18\f \x01(\x01H\x03R\tminRadius\x88\x01\x01\x12G\n" +
+	"\x06scores\x18\r \x03(\v2/.fateark.proto.command.SelectorSpec.ScoresEntryR\x06scores\x1a\\\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.fateark.proto.command.ScoreRangeR\x05value:\x028\x01\"g\n" +
+	"\x06Target\x12\x0f\n" +
+	"\vALL_PLAYERS\x10\x00\x12\f\n" +
+	"\bENTITIES\x10\x01\x12\x12\n" +
+	"\x0eNEAREST_PLAYER\x10\x02\x12\x11\n" +
+	"\rRANDOM_PLAYER\x10\x03\x12\b\n" +
+	"\x04SELF\x10\x04\x12\r\n" +
+	"\tINITIATOR\x10\x05B\b\n" +
+	"\x06_countB\r\n" +
+	"\v_runtime_idB\t\n" +
+	"\a_radiusB\r\n" +
+	"\v_min_radius\"\xa4\x03\n" +
+	"\x10RawTextComponent\x12\x14\n" +
+	"\x04text\x18\x01 \x01(\tH\x00R\x04text\x12Q\n" +
+	"\ttranslate\x18\x02 \x01(\v21.fateark.proto.command.RawTextComponent.TranslateH\x00R\ttranslate\x12A\n" +
+	"\bselector\x18\x03 \x01(\v2#.fateark.proto.command.SelectorSpecH\x00R\bselector\x12E\n" +
+	"\x05score\x18\x04 \x01(\v2-.fateark.proto.command.RawTextComponent.ScoreH\x00R\x05score\x1aZ\n" +
+	"\tTranslate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12;\n" +
+	"\x04with\x18\x02 \x03(\v2'.fateark.proto.command.RawTextComponentR\x04with\x1a9\n" +
+	"\x05Score\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tobjective\x18\x02 \x01(\tR\tobjectiveB\x06\n" +
+	"\x04part\"R\n" +
+	"\aRawText\x12G\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2'.fateark.proto.command.RawTextComponentR\n" +
+	"components\"T\n" +
+	"\vExecuteSpec\x123\n" +
+	"\x02as\x18\x01 \x01(\v2#.fateark.proto.command.SelectorSpecR\x02as\x12\x10\n" +
+	"\x03cmd\x18\x02 \x01(\tR\x03cmd\"\xc1\x02\n" +
+	"\x1bBuildCommandFragmentRequest\x12\x18\n" +
+	"\x06quoted\x18\x01 \x01(\tH\x00R\x06quoted\x12A\n" +
+	"\bselector\x18\x02 \x01(\v2#.fateark.proto.command.SelectorSpecH\x00R\bselector\x12:\n" +
+	"\arawtext\x18\x03 \x01(\v2\x1e.fateark.proto.command.RawTextH\x00R\arawtext\x12=\n" +
+	"\bposition\x18\x04 \x01(\v2\x1f.fateark.proto.command.PositionH\x00R\bposition\x12>\n" +
+	"\aexecute\x18\x05 \x01(\v2\".fateark.proto.command.ExecuteSpecH\x00R\aexecuteB\n" +
+	"\n" +
+	"\bfragment\":\n" +
+	"\x1cBuildCommandFragmentResponse\x12\x1a\n" +
+	"\bfragment\x18\x01 \x01(\tR\bfragment\"\xbf\x02\n" +
+	"\x14SendCommandAsRequest\x12!\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tH\x00R\n" +
+	"playerUuid\x12\x1c\n" +
+	"\busername\x18\x02 \x01(\tH\x00R\busername\x12*\n" +
+	"\x10entity_unique_id\x18\x03 \x01(\x03H\x00R\x0eentityUniqueId\x12A\n" +
+	"\bselector\x18\x04 \x01(\v2#.fateark.proto.command.SelectorSpecH\x00R\bselector\x12\x10\n" +
+	"\x03cmd\x18\x05 \x01(\tR\x03cmd\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x06 \x01(\rR\ttimeoutMs\x12<\n" +
+	"\x06pacing\x18\a \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacingB\b\n" +
+	"\x06target\"\xfb\x01\n" +
+	"\n" +
+	"PolicyRule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12@\n" +
+	"\x06action\x18\x03 \x01(\x0e2(.fateark.proto.command.PolicyRule.ActionR\x06action\x12 \n" +
+	"\vreplacement\x18\x04 \x01(\tR\vreplacement\x12/\n" +
+	"\x13unbounded_selectors\x18\x05 \x03(\tR\x12unboundedSelectors\"*\n" +
+	"\x06Action\x12\t\n" +
+	"\x05ALLOW\x10\x00\x12\b\n" +
+	"\x04DENY\x10\x01\x12\v\n" +
+	"\aREWRITE\x10\x02\"\xee\x01\n" +
+	"\x12PolicyRoleOverride\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x127\n" +
+	"\x05rules\x18\x03 \x03(\v2!.fateark.proto.command.PolicyRuleR\x05rules\x12!\n" +
+	"\fdefault_deny\x18\x04 \x01(\bR\vdefaultDeny\x12#\n" +
+	"\rmanage_policy\x18\x05 \x01(\bR\fmanagePolicy\x12-\n" +
+	"\x12manage_permissions\x18\x06 \x01(\bR\x11managePermissions\"\xb4\x01\n" +
+	"\rCommandPolicy\x127\n" +
+	"\x05rules\x18\x01 \x03(\v2!.fateark.proto.command.PolicyRuleR\x05rules\x12!\n" +
+	"\fdefault_deny\x18\x02 \x01(\bR\vdefaultDeny\x12G\n" +
+	"\toverrides\x18\x03 \x03(\v2).fateark.proto.command.PolicyRoleOverrideR\toverrides\"\x19\n" +
+	"\x17GetCommandPolicyRequest\"W\n" +
+	"\x17SetCommandPolicyRequest\x12<\n" +
+	"\x06policy\x18\x01 \x01(\v2$.fateark.proto.command.CommandPolicyR\x06policy\"'\n" +
+	"\x13CheckCommandRequest\x12\x10\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\"r\n" +
+	"\x14CheckCommandResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\xac\x01\n" +
+	"\x10CommandParameter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\boptional\x18\x03 \x01(\bR\boptional\x12\x1b\n" +
+	"\tenum_name\x18\x04 \x01(\tR\benumName\x12\x1f\n" +
+	"\venum_values\x18\x05 \x03(\tR\n" +
+	"enumValues\x12\x16\n" +
+	"\x06suffix\x18\x06 \x01(\tR\x06suffix\"Z\n" +
+	"\x0fCommandOverload\x12G\n" +
+	"\n" +
+	"parameters\x18\x01 \x03(\v2'.fateark.proto.command.CommandParameterR\n" +
+	"parameters\"\xce\x01\n" +
+	"\vCommandSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12)\n" +
+	"\x10permission_level\x18\x04 \x01(\rR\x0fpermissionLevel\x12D\n" +
+	"\toverloads\x18\x05 \x03(\v2&.fateark.proto.command.CommandOverloadR\toverloads\"+\n" +
+	"\x15GetCommandTreeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"X\n" +
+	"\x16GetCommandTreeResponse\x12>\n" +
+	"\bcommands\x18\x01 \x03(\v2\".fateark.proto.command.CommandSpecR\bcommands\"*\n" +
+	"\x16ValidateCommandRequest\x12\x10\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\"\xbe\x01\n" +
+	"\x17ValidateCommandResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\acommand\x18\x02 \x01(\tR\acommand\x12\x1a\n" +
+	"\boverload\x18\x03 \x01(\x05R\boverload\x12!\n" +
+	"\ferror_offset\x18\x04 \x01(\x05R\verrorOffset\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1a\n" +
+	"\bexpected\x18\x06 \x03(\tR\bexpected\"h\n" +
+	"\x16CompleteCommandRequest\x12\x10\n" +
+	"\x03cmd\x18\x01 \x01(\tR\x03cmd\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\x05H\x00R\x06cursor\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limitB\t\n" +
+	"\a_cursor\"b\n" +
+	"\x11CommandCompletion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06detail\x18\x02 \x01(\tR\x06detail\x12!\n" +
+	"\freplace_from\x18\x03 \x01(\x05R\vreplaceFrom\"e\n" +
+	"\x17CompleteCommandResponse\x12J\n" +
+	"\vcompletions\x18\x01 \x03(\v2(.fateark.proto.command.CommandCompletionR\vcompletions\"\xe2\x02\n" +
+	"\x10RunScriptRequest\x12\x16\n" +
+	"\x06script\x18\x01 \x01(\tR\x06script\x12T\n" +
+	"\tvariables\x18\x02 \x03(\v26.fateark.proto.command.RunScriptRequest.VariablesEntryR\tvariables\x12<\n" +
+	"\x06pacing\x18\x03 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\x12\"\n" +
+	"\rstop_on_error\x18\x04 \x01(\bR\vstopOnError\x12!\n" +
+	"\fmax_commands\x18\x05 \x01(\rR\vmaxCommands\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x06 \x01(\rR\ttimeoutMs\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x03\n" +
+	"\vScriptEvent\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\rR\x04line\x12;\n" +
+	"\x04kind\x18\x02 \x01(\x0e2'.fateark.proto.command.ScriptEvent.KindR\x04kind\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12#\n" +
+	"\rsuccess_count\x18\x05 \x01(\rR\fsuccessCount\x12\x16\n" +
+	"\x06output\x18\x06 \x01(\tR\x06output\x12\x1b\n" +
+	"\terror_msg\x18\a \x01(\tR\berrorMsg\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\x12\x1a\n" +
+	"\bcommands\x18\t \x01(\rR\bcommands\x12\x16\n" +
+	"\x06failed\x18\n" +
+	" \x01(\rR\x06failed\x12\x18\n" +
+	"\astopped\x18\v \x01(\bR\astopped\":\n" +
+	"\x04Kind\x12\v\n" +
+	"\aCOMMAND\x10\x00\x12\a\n" +
+	"\x03SET\x10\x01\x12\b\n" +
+	"\x04WAIT\x10\x02\x12\b\n" +
+	"\x04LOOP\x10\x03\x12\b\n" +
+	"\x04DONE\x10\x04*:\n" +
+	"\x0fCommandPriority\x12\x0e\n" +
+	"\n" +
+	"AUTOMATION\x10\x00\x12\t\n" +
+	"\x05ADMIN\x10\x01\x12\f\n" +
+	"\bCOSMETIC\x10\x02*1\n" +
+	"\vCommandMode\x12\x06\n" +
+	"\x02WS\x10\x00\x12\x06\n" +
+	"\x02WO\x10\x01\x12\n" +
+	"\n" +
+	"\x06PLAYER\x10\x02\x12\x06\n" +
+	"\x02AI\x10\x032\xfc\x12\n" +
 	"\x0eCommandService\x12e\n" +
 	"\rSendWOCommand\x12+.fateark.proto.command.SendWOCommandRequest\x1a'.fateark.proto.response.GeneralResponse\x12e\n" +
 	"\rSendWSCommand\x12+.fateark.proto.command.SendWSCommandRequest\x1a'.fateark.proto.response.GeneralResponse\x12m\n" +
 	"\x11SendPlayerCommand\x12/.fateark.proto.command.SendPlayerCommandRequest\x1a'.fateark.proto.response.GeneralResponse\x12e\n" +
-	"\rSendAICommand\x12+.fateark.proto.command.SendAICommandRequest\x1a'.fateark.proto.response.GeneralResponse\x12}\n" +
-	"\x19SendWSCommandWithResponse\x127.fateark.proto.command.SendWSCommandWithResponseRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x85\x01\n" +
-	"\x1dSendPlayerCommandWithResponse\x12;.fateark.proto.command.SendPlayerCommandWithResponseRequest\x1a'.fateark.proto.response.GeneralResponse\x12}\n" +
-	"\x19SendAICommandWithResponse\x127.fateark.proto.command.SendAICommandWithResponseRequest\x1a'.fateark.proto.response.GeneralResponseB?Z=github.com/Yeah114/tempest-core/network_api/command;commandpbb\x06proto3"
+	"\rSendAICommand\x12+.fateark.proto.command.SendAICommandRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x82\x01\n" +
+	"\x19SendWSCommandWithResponse\x127.fateark.proto.command.SendWSCommandWithResponseRequest\x1a,.fateark.proto.command.CommandOutputResponse\x12\x8a\x01\n" +
+	"\x1dSendPlayerCommandWithResponse\x12;.fateark.proto.command.SendPlayerCommandWithResponseRequest\x1a,.fateark.proto.command.CommandOutputResponse\x12\x82\x01\n" +
+	"\x19SendAICommandWithResponse\x127.fateark.proto.command.SendAICommandWithResponseRequest\x1a,.fateark.proto.command.CommandOutputResponse\x12s\n" +
+	"\x10SendCommandBatch\x12..fateark.proto.command.SendCommandBatchRequest\x1a/.fateark.proto.command.SendCommandBatchResponse\x12q\n" +
+	"\x12StreamCommandBatch\x12..fateark.proto.command.SendCommandBatchRequest\x1a).fateark.proto.command.BatchCommandResult0\x01\x12\x7f\n" +
+	"\x14GetCommandQueueStats\x122.fateark.proto.command.GetCommandQueueStatsRequest\x1a3.fateark.proto.command.GetCommandQueueStatsResponse\x12z\n" +
+	"\x16ConfigureCommandPacing\x124.fateark.proto.command.ConfigureCommandPacingRequest\x1a*.fateark.proto.command.CommandPacingConfig\x12e\n" +
+	"\rSendCommandAs\x12+.fateark.proto.command.SendCommandAsRequest\x1a'.fateark.proto.response.GeneralResponse\x12v\n" +
+	"\x19SendCommandAsWithResponse\x12+.fateark.proto.command.SendCommandAsRequest\x1a,.fateark.proto.command.CommandOutputResponse\x12\x7f\n" +
+	"\x14BuildCommandFragment\x122.fateark.proto.command.BuildCommandFragmentRequest\x1a3.fateark.proto.command.BuildCommandFragmentResponse\x12h\n" +
+	"\x10GetCommandPolicy\x12..fateark.proto.command.GetCommandPolicyRequest\x1a$.fateark.proto.command.CommandPolicy\x12h\n" +
+	"\x10SetCommandPolicy\x12..fateark.proto.command.SetCommandPolicyRequest\x1a$.fateark.proto.command.CommandPolicy\x12g\n" +
+	"\fCheckCommand\x12*.fateark.proto.command.CheckCommandRequest\x1a+.fateark.proto.command.CheckCommandResponse\x12m\n" +
+	"\x0eGetCommandTree\x12,.fateark.proto.command.GetCommandTreeRequest\x1a-.fateark.proto.command.GetCommandTreeResponse\x12p\n" +
+	"\x0fValidateCommand\x12-.fateark.proto.command.ValidateCommandRequest\x1a..fateark.proto.command.ValidateCommandResponse\x12p\n" +
+	"\x0fCompleteCommand\x12-.fateark.proto.command.CompleteCommandRequest\x1a..fateark.proto.command.CompleteCommandResponse\x12Z\n" +
+	"\tRunScript\x12'.fateark.proto.command.RunScriptRequest\x1a\".fateark.proto.command.ScriptEvent0\x01B?Z=github.com/Yeah114/tempest-core/network_api/command;commandpbb\x06proto3"
 
 var (
 	file_proto_command_proto_rawDescOnce sync.Once
@@ -390,37 +4281,175 @@ func file_proto_command_proto_rawDescGZIP() []byte {
 	return file_proto_command_proto_rawDescData
 }
 
-var file_proto_command_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_command_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_proto_command_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_proto_command_proto_goTypes = []any{
-	(*SendWOCommandRequest)(nil),                 // 0: fateark.proto.command.SendWOCommandRequest
-	(*SendWSCommandRequest)(nil),                 // 1: fateark.proto.command.SendWSCommandRequest
-	(*SendPlayerCommandRequest)(nil),             // 2: fateark.proto.command.SendPlayerCommandRequest
-	(*SendAICommandRequest)(nil),                 // 3: fateark.proto.command.SendAICommandRequest
-	(*SendWSCommandWithResponseRequest)(nil),     // 4: fateark.proto.command.SendWSCommandWithResponseRequest
-	(*SendPlayerCommandWithResponseRequest)(nil), // 5: fateark.proto.command.SendPlayerCommandWithResponseRequest
-	(*SendAICommandWithResponseRequest)(nil),     // 6: fateark.proto.command.SendAICommandWithResponseRequest
-	(*response.GeneralResponse)(nil),             // 7: fateark.proto.response.GeneralResponse
+	(CommandPriority)(0),                         // 0: fateark.proto.command.CommandPriority
+	(CommandMode)(0),                             // 1: fateark.proto.command.CommandMode
+	(CommandOutput_Type)(0),                      // 2: fateark.proto.command.CommandOutput.Type
+	(CommandOutputResponse_Status)(0),            // 3: fateark.proto.command.CommandOutputResponse.Status
+	(BatchCommandResult_Status)(0),               // 4: fateark.proto.command.BatchCommandResult.Status
+	(Coordinate_Mode)(0),                         // 5: fateark.proto.command.Coordinate.Mode
+	(SelectorSpec_Target)(0),                     // 6: fateark.proto.command.SelectorSpec.Target
+	(PolicyRule_Action)(0),                       // 7: fateark.proto.command.PolicyRule.Action
+	(ScriptEvent_Kind)(0),                        // 8: fateark.proto.command.ScriptEvent.Kind
+	(*CommandPacing)(nil),                        // 9: fateark.proto.command.CommandPacing
+	(*SendWOCommandRequest)(nil),                 // 10: fateark.proto.command.SendWOCommandRequest
+	(*SendWSCommandRequest)(nil),                 // 11: fateark.proto.command.SendWSCommandRequest
+	(*SendPlayerCommandRequest)(nil),             // 12: fateark.proto.command.SendPlayerCommandRequest
+	(*SendAICommandRequest)(nil),                 // 13: fateark.proto.command.SendAICommandRequest
+	(*SendWSCommandWithResponseRequest)(nil),     // 14: fateark.proto.command.SendWSCommandWithResponseRequest
+	(*SendPlayerCommandWithResponseRequest)(nil), // 15: fateark.proto.command.SendPlayerCommandWithResponseRequest
+	(*SendAICommandWithResponseRequest)(nil),     // 16: fateark.proto.command.SendAICommandWithResponseRequest
+	(*CommandOutputMessage)(nil),                 // 17: fateark.proto.command.CommandOutputMessage
+	(*CommandOutput)(nil),                        // 18: fateark.proto.command.CommandOutput
+	(*CommandOutputResponse)(nil),                // 19: fateark.proto.command.CommandOutputResponse
+	(*BatchCommand)(nil),                         // 20: fateark.proto.command.BatchCommand
+	(*SendCommandBatchRequest)(nil),              // 21: fateark.proto.command.SendCommandBatchRequest
+	(*BatchCommandResult)(nil),                   // 22: fateark.proto.command.BatchCommandResult
+	(*SendCommandBatchResponse)(nil),             // 23: fateark.proto.command.SendCommandBatchResponse
+	(*CommandLaneStats)(nil),                     // 24: fateark.proto.command.CommandLaneStats
+	(*CommandPacingConfig)(nil),                  // 25: fateark.proto.command.CommandPacingConfig
+	(*GetCommandQueueStatsRequest)(nil),          // 26: fateark.proto.command.GetCommandQueueStatsRequest
+	(*GetCommandQueueStatsResponse)(nil),         // 27: fateark.proto.command.GetCommandQueueStatsResponse
+	(*ConfigureCommandPacingRequest)(nil),        // 28: fateark.proto.command.ConfigureCommandPacingRequest
+	(*Coordinate)(nil),                           // 29: fateark.proto.command.Coordinate
+	(*Position)(nil),                             // 30: fateark.proto.command.Position
+	(*ScoreRange)(nil),                           // 31: fateark.proto.command.ScoreRange
+	(*SelectorSpec)(nil),                         // 32: fateark.proto.command.SelectorSpec
+	(*RawTextComponent)(nil),                     // 33: fateark.proto.command.RawTextComponent
+	(*RawText)(nil),                              // 34: fateark.proto.command.RawText
+	(*ExecuteSpec)(nil),                          // 35: fateark.proto.command.ExecuteSpec
+	(*BuildCommandFragmentRequest)(nil),          // 36: fateark.proto.command.BuildCommandFragmentRequest
+	(*BuildCommandFragmentResponse)(nil),         // 37: fateark.proto.command.BuildCommandFragmentResponse
+	(*SendCommandAsRequest)(nil),                 // 38: fateark.proto.command.SendCommandAsRequest
+	(*PolicyRule)(nil),                           // 39: fateark.proto.command.PolicyRule
+	(*PolicyRoleOverride)(nil),                   // 40: fateark.proto.command.PolicyRoleOverride
+	(*CommandPolicy)(nil),                        // 41: fateark.proto.command.CommandPolicy
+	(*GetCommandPolicyRequest)(nil),              // 42: fateark.proto.command.GetCommandPolicyRequest
+	(*SetCommandPolicyRequest)(nil),              // 43: fateark.proto.command.SetCommandPolicyRequest
+	(*CheckCommandRequest)(nil),                  // 44: fateark.proto.command.CheckCommandRequest
+	(*CheckCommandResponse)(nil),                 // 45: fateark.proto.command.CheckCommandResponse
+	(*CommandParameter)(nil),                     // 46: fateark.proto.command.CommandParameter
+	(*CommandOverload)(nil),                      // 47: fateark.proto.command.CommandOverload
+	(*CommandSpec)(nil),                          // 48: fateark.proto.command.CommandSpec
+	(*GetCommandTreeRequest)(nil),                // 49: fateark.proto.command.GetCommandTreeRequest
+	(*GetCommandTreeResponse)(nil),               // 50: fateark.proto.command.GetCommandTreeResponse
+	(*ValidateCommandRequest)(nil),               // 51: fateark.proto.command.ValidateCommandRequest
+	(*ValidateCommandResponse)(nil),              // 52: fateark.proto.command.ValidateCommandResponse
+	(*CompleteCommandRequest)(nil),               // 53: fateark.proto.command.CompleteCommandRequest
+	(*CommandCompletion)(nil),                    // 54: fateark.proto.command.CommandCompletion
+	(*CompleteCommandResponse)(nil),              // 55: fateark.proto.command.CompleteCommandResponse
+	(*RunScriptRequest)(nil),                     // 56: fateark.proto.command.RunScriptRequest
+	(*ScriptEvent)(nil),                          // 57: fateark.proto.command.ScriptEvent
+	nil,                                          // 58: fateark.proto.command.SelectorSpec.ScoresEntry
+	(*RawTextComponent_Translate)(nil),           // 59: fateark.proto.command.RawTextComponent.Translate
+	(*RawTextComponent_Score)(nil),               // 60: fateark.proto.command.RawTextComponent.Score
+	nil,                                          // 61: fateark.proto.command.RunScriptRequest.VariablesEntry
+	(*response.GeneralResponse)(nil),             // 62: fateark.proto.response.GeneralResponse
 }
 var file_proto_command_proto_depIdxs = []int32{
-	0, // 0: fateark.proto.command.CommandService.SendWOCommand:input_type -> fateark.proto.command.SendWOCommandRequest
-	1, // 1: fateark.proto.command.CommandService.SendWSCommand:input_type -> fateark.proto.command.SendWSCommandRequest
-	2, // 2: fateark.proto.command.CommandService.SendPlayerCommand:input_type -> fateark.proto.command.SendPlayerCommandRequest
-	3, // 3: fateark.proto.command.CommandService.SendAICommand:input_type -> fateark.proto.command.SendAICommandRequest
-	4, // 4: fateark.proto.command.CommandService.SendWSCommandWithResponse:input_type -> fateark.proto.command.SendWSCommandWithResponseRequest
-	5, // 5: fateark.proto.command.CommandService.SendPlayerCommandWithResponse:input_type -> fateark.proto.command.SendPlayerCommandWithResponseRequest
-	6, // 6: fateark.proto.command.CommandService.SendAICommandWithResponse:input_type -> fateark.proto.command.SendAICommandWithResponseRequest
-	7, // 7: fateark.proto.command.CommandService.SendWOCommand:output_type -> fateark.proto.response.GeneralResponse
-	7, // 8: fateark.proto.command.CommandService.SendWSCommand:output_type -> fateark.proto.response.GeneralResponse
-	7, // 9: fateark.proto.command.CommandService.SendPlayerCommand:output_type -> fateark.proto.response.GeneralResponse
-	7, // 10: fateark.proto.command.CommandService.SendAICommand:output_type -> fateark.proto.response.GeneralResponse
-	7, // 11: fateark.proto.command.CommandService.SendWSCommandWithResponse:output_type -> fateark.proto.response.GeneralResponse
-	7, // 12: fateark.proto.command.CommandService.SendPlayerCommandWithResponse:output_type -> fateark.proto.response.GeneralResponse
-	7, // 13: fateark.proto.command.CommandService.SendAICommandWithResponse:output_type -> fateark.proto.response.GeneralResponse
-	7, // [7:14] is the sub-list for method output_type
-	0, // [0:7] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: fateark.proto.command.CommandPacing.priority:type_name -> fateark.proto.command.CommandPriority
+	9,  // 1: fateark.proto.command.SendWOCommandRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	9,  // 2: fateark.proto.command.SendWSCommandRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	9,  // 3: fateark.proto.command.SendPlayerCommandRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	9,  // 4: fateark.proto.command.SendAICommandRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	9,  // 5: fateark.proto.command.SendWSCommandWithResponseRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	9,  // 6: fateark.proto.command.SendPlayerCommandWithResponseRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	9,  // 7: fateark.proto.command.SendAICommandWithResponseRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	2,  // 8: fateark.proto.command.CommandOutput.output_type:type_name -> fateark.proto.command.CommandOutput.Type
+	17, // 9: fateark.proto.command.CommandOutput.messages:type_name -> fateark.proto.command.CommandOutputMessage
+	3,  // 10: fateark.proto.command.CommandOutputResponse.status:type_name -> fateark.proto.command.CommandOutputResponse.Status
+	18, // 11: fateark.proto.command.CommandOutputResponse.output:type_name -> fateark.proto.command.CommandOutput
+	1,  // 12: fateark.proto.command.BatchCommand.mode:type_name -> fateark.proto.command.CommandMode
+	20, // 13: fateark.proto.command.SendCommandBatchRequest.commands:type_name -> fateark.proto.command.BatchCommand
+	9,  // 14: fateark.proto.command.SendCommandBatchRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	4,  // 15: fateark.proto.command.BatchCommandResult.status:type_name -> fateark.proto.command.BatchCommandResult.Status
+	18, // 16: fateark.proto.command.BatchCommandResult.command_output:type_name -> fateark.proto.command.CommandOutput
+	22, // 17: fateark.proto.command.SendCommandBatchResponse.results:type_name -> fateark.proto.command.BatchCommandResult
+	0,  // 18: fateark.proto.command.CommandLaneStats.priority:type_name -> fateark.proto.command.CommandPriority
+	25, // 19: fateark.proto.command.GetCommandQueueStatsResponse.config:type_name -> fateark.proto.command.CommandPacingConfig
+	24, // 20: fateark.proto.command.GetCommandQueueStatsResponse.lanes:type_name -> fateark.proto.command.CommandLaneStats
+	25, // 21: fateark.proto.command.ConfigureCommandPacingRequest.config:type_name -> fateark.proto.command.CommandPacingConfig
+	5,  // 22: fateark.proto.command.Coordinate.mode:type_name -> fateark.proto.command.Coordinate.Mode
+	29, // 23: fateark.proto.command.Position.x:type_name -> fateark.proto.command.Coordinate
+	29, // 24: fateark.proto.command.Position.y:type_name -> fateark.proto.command.Coordinate
+	29, // 25: fateark.proto.command.Position.z:type_name -> fateark.proto.command.Coordinate
+	6,  // 26: fateark.proto.command.SelectorSpec.target:type_name -> fateark.proto.command.SelectorSpec.Target
+	30, // 27: fateark.proto.command.SelectorSpec.origin:type_name -> fateark.proto.command.Position
+	58, // 28: fateark.proto.command.SelectorSpec.scores:type_name -> fateark.proto.command.SelectorSpec.ScoresEntry
+	59, // 29: fateark.proto.command.RawTextComponent.translate:type_name -> fateark.proto.command.RawTextComponent.Translate
+	32, // 30: fateark.proto.command.RawTextComponent.selector:type_name -> fateark.proto.command.SelectorSpec
+	60, // 31: fateark.proto.command.RawTextComponent.score:type_name -> fateark.proto.command.RawTextComponent.Score
+	33, // 32: fateark.proto.command.RawText.components:type_name -> fateark.proto.command.RawTextComponent
+	32, // 33: fateark.proto.command.ExecuteSpec.as:type_name -> fateark.proto.command.SelectorSpec
+	32, // 34: fateark.proto.command.BuildCommandFragmentRequest.selector:type_name -> fateark.proto.command.SelectorSpec
+	34, // 35: fateark.proto.command.BuildCommandFragmentRequest.rawtext:type_name -> fateark.proto.command.RawText
+	30, // 36: fateark.proto.command.BuildCommandFragmentRequest.position:type_name -> fateark.proto.command.Position
+	35, // 37: fateark.proto.command.BuildCommandFragmentRequest.execute:type_name -> fateark.proto.command.ExecuteSpec
+	32, // 38: fateark.proto.command.SendCommandAsRequest.selector:type_name -> fateark.proto.command.SelectorSpec
+	9,  // 39: fateark.proto.command.SendCommandAsRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	7,  // 40: fateark.proto.command.PolicyRule.action:type_name -> fateark.proto.command.PolicyRule.Action
+	39, // 41: fateark.proto.command.PolicyRoleOverride.rules:type_name -> fateark.proto.command.PolicyRule
+	39, // 42: fateark.proto.command.CommandPolicy.rules:type_name -> fateark.proto.command.PolicyRule
+	40, // 43: fateark.proto.command.CommandPolicy.overrides:type_name -> fateark.proto.command.PolicyRoleOverride
+	41, // 44: fateark.proto.command.SetCommandPolicyRequest.policy:type_name -> fateark.proto.command.CommandPolicy
+	46, // 45: fateark.proto.command.CommandOverload.parameters:type_name -> fateark.proto.command.CommandParameter
+	47, // 46: fateark.proto.command.CommandSpec.overloads:type_name -> fateark.proto.command.CommandOverload
+	48, // 47: fateark.proto.command.GetCommandTreeResponse.commands:type_name -> fateark.proto.command.CommandSpec
+	54, // 48: fateark.proto.command.CompleteCommandResponse.completions:type_name -> fateark.proto.command.CommandCompletion
+	61, // 49: fateark.proto.command.RunScriptRequest.variables:type_name -> fateark.proto.command.RunScriptRequest.VariablesEntry
+	9,  // 50: fateark.proto.command.RunScriptRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	8,  // 51: fateark.proto.command.ScriptEvent.kind:type_name -> fateark.proto.command.ScriptEvent.Kind
+	31, // 52: fateark.proto.command.SelectorSpec.ScoresEntry.value:type_name -> fateark.proto.command.ScoreRange
+	33, // 53: fateark.proto.command.RawTextComponent.Translate.with:type_name -> fateark.proto.command.RawTextComponent
+	10, // 54: fateark.proto.command.CommandService.SendWOCommand:input_type -> fateark.proto.command.SendWOCommandRequest
+	11, // 55: fateark.proto.command.CommandService.SendWSCommand:input_type -> fateark.proto.command.SendWSCommandRequest
+	12, // 56: fateark.proto.command.CommandService.SendPlayerCommand:input_type -> fateark.proto.command.SendPlayerCommandRequest
+	13, // 57: fateark.proto.command.CommandService.SendAICommand:input_type -> fateark.proto.command.SendAICommandRequest
+	14, // 58: fateark.proto.command.CommandService.SendWSCommandWithResponse:input_type -> fateark.proto.command.SendWSCommandWithResponseRequest
+	15, // 59: fateark.proto.command.CommandService.SendPlayerCommandWithResponse:input_type -> fateark.proto.command.SendPlayerCommandWithResponseRequest
+	16, // 60: fateark.proto.command.CommandService.SendAICommandWithResponse:input_type -> fateark.proto.command.SendAICommandWithResponseRequest
+	21, // 61: fateark.proto.command.CommandService.SendCommandBatch:input_type -> fateark.proto.command.SendCommandBatchRequest
+	21, // 62: fateark.proto.command.CommandService.StreamCommandBatch:input_type -> fateark.proto.command.SendCommandBatchRequest
+	26, // 63: fateark.proto.command.CommandService.GetCommandQueueStats:input_type -> fateark.proto.command.GetCommandQueueStatsRequest
+	28, // 64: fateark.proto.command.CommandService.ConfigureCommandPacing:input_type -> fateark.proto.command.ConfigureCommandPacingRequest
+	38, // 65: fateark.proto.command.CommandService.SendCommandAs:input_type -> fateark.proto.command.SendCommandAsRequest
+	38, // 66: fateark.proto.command.CommandService.SendCommandAsWithResponse:input_type -> fateark.proto.command.SendCommandAsRequest
+	36, // 67: fateark.proto.command.CommandService.BuildCommandFragment:input_type -> fateark.proto.command.BuildCommandFragmentRequest
+	42, // 68: fateark.proto.command.CommandService.GetCommandPolicy:input_type -> fateark.proto.command.GetCommandPolicyRequest
+	43, // 69: fateark.proto.command.CommandService.SetCommandPolicy:input_type -> fateark.proto.command.SetCommandPolicyRequest
+	44, // 70: fateark.proto.command.CommandService.CheckCommand:input_type -> fateark.proto.command.CheckCommandRequest
+	49, // 71: fateark.proto.command.CommandService.GetCommandTree:input_type -> fateark.proto.command.GetCommandTreeRequest
+	51, // 72: fateark.proto.command.CommandService.ValidateCommand:input_type -> fateark.proto.command.ValidateCommandRequest
+	53, // 73: fateark.proto.command.CommandService.CompleteCommand:input_type -> fateark.proto.command.CompleteCommandRequest
+	56, // 74: fateark.proto.command.CommandService.RunScript:input_type -> fateark.proto.command.RunScriptRequest
+	62, // 75: fateark.proto.command.CommandService.SendWOCommand:output_type -> fateark.proto.response.GeneralResponse
+	62, // 76: fateark.proto.command.CommandService.SendWSCommand:output_type -> fateark.proto.response.GeneralResponse
+	62, // 77: fateark.proto.command.CommandService.SendPlayerCommand:output_type -> fateark.proto.response.GeneralResponse
+	62, // 78: fateark.proto.command.CommandService.SendAICommand:output_type -> fateark.proto.response.GeneralResponse
+	19, // 79: fateark.proto.command.CommandService.SendWSCommandWithResponse:output_type -> fateark.proto.command.CommandOutputResponse
+	19, // 80: fateark.proto.command.CommandService.SendPlayerCommandWithResponse:output_type -> fateark.proto.command.CommandOutputResponse
+	19, // 81: fateark.proto.command.CommandService.SendAICommandWithResponse:output_type -> fateark.proto.command.CommandOutputResponse
+	23, // 82: fateark.proto.command.CommandService.SendCommandBatch:output_type -> fateark.proto.command.SendCommandBatchResponse
+	22, // 83: fateark.proto.command.CommandService.StreamCommandBatch:output_type -> fateark.proto.command.BatchCommandResult
+	27, // 84: fateark.proto.command.CommandService.GetCommandQueueStats:output_type -> fateark.proto.command.GetCommandQueueStatsResponse
+	25, // 85: fateark.proto.command.CommandService.ConfigureCommandPacing:output_type -> fateark.proto.command.CommandPacingConfig
+	62, // 86: fateark.proto.command.CommandService.SendCommandAs:output_type -> fateark.proto.response.GeneralResponse
+	19, // 87: fateark.proto.command.CommandService.SendCommandAsWithResponse:output_type -> fateark.proto.command.CommandOutputResponse
+	37, // 88: fateark.proto.command.CommandService.BuildCommandFragment:output_type -> fateark.proto.command.BuildCommandFragmentResponse
+	41, // 89: fateark.proto.command.CommandService.GetCommandPolicy:output_type -> fateark.proto.command.CommandPolicy
+	41, // 90: fateark.proto.command.CommandService.SetCommandPolicy:output_type -> fateark.proto.command.CommandPolicy
+	45, // 91: fateark.proto.command.CommandService.CheckCommand:output_type -> fateark.proto.command.CheckCommandResponse
+	50, // 92: fateark.proto.command.CommandService.GetCommandTree:output_type -> fateark.proto.command.GetCommandTreeResponse
+	52, // 93: fateark.proto.command.CommandService.ValidateCommand:output_type -> fateark.proto.command.ValidateCommandResponse
+	55, // 94: fateark.proto.command.CommandService.CompleteCommand:output_type -> fateark.proto.command.CompleteCommandResponse
+	57, // 95: fateark.proto.command.CommandService.RunScript:output_type -> fateark.proto.command.ScriptEvent
+	75, // [75:96] is the sub-list for method output_type
+	54, // [54:75] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_proto_command_proto_init() }
@@ -428,18 +4457,41 @@ func file_proto_command_proto_init() {
 	if File_proto_command_proto != nil {
 		return
 	}
+	file_proto_command_proto_msgTypes[22].OneofWrappers = []any{}
+	file_proto_command_proto_msgTypes[23].OneofWrappers = []any{}
+	file_proto_command_proto_msgTypes[24].OneofWrappers = []any{
+		(*RawTextComponent_Text)(nil),
+		(*RawTextComponent_Translate_)(nil),
+		(*RawTextComponent_Selector)(nil),
+		(*RawTextComponent_Score_)(nil),
+	}
+	file_proto_command_proto_msgTypes[27].OneofWrappers = []any{
+		(*BuildCommandFragmentRequest_Quoted)(nil),
+		(*BuildCommandFragmentRequest_Selector)(nil),
+		(*BuildCommandFragmentRequest_Rawtext)(nil),
+		(*BuildCommandFragmentRequest_Position)(nil),
+		(*BuildCommandFragmentRequest_Execute)(nil),
+	}
+	file_proto_command_proto_msgTypes[29].OneofWrappers = []any{
+		(*SendCommandAsRequest_PlayerUuid)(nil),
+		(*SendCommandAsRequest_Username)(nil),
+		(*SendCommandAsRequest_EntityUniqueId)(nil),
+		(*SendCommandAsRequest_Selector)(nil),
+	}
+	file_proto_command_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_command_proto_rawDesc), len(file_proto_command_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      9,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_command_proto_goTypes,
 		DependencyIndexes: file_proto_command_proto_depIdxs,
+		EnumInfos:         file_proto_command_proto_enumTypes,
 		MessageInfos:      file_proto_command_proto_msgTypes,
 	}.Build()
 	File_proto_command_proto = out.File
@@ -27,6 +27,20 @@ const (
 	CommandService_SendWSCommandWithResponse_FullMethodName     = "/fateark.proto.command.CommandService/SendWSCommandWithResponse"
 	CommandService_SendPlayerCommandWithResponse_FullMethodName = "/fateark.proto.command.CommandService/SendPlayerCommandWithResponse"
 	CommandService_SendAICommandWithResponse_FullMethodName     = "/fateark.proto.command.CommandService/SendAICommandWithResponse"
+	CommandService_SendCommandBatch_FullMethodName              = "/fateark.proto.command.CommandService/SendCommandBatch"
+	CommandService_StreamCommandBatch_FullMethodName            = "/fateark.proto.command.CommandService/StreamCommandBatch"
+	CommandService_GetCommandQueueStats_FullMethodName          = "/fateark.proto.command.CommandService/GetCommandQueueStats"
+	CommandService_ConfigureCommandPacing_FullMethodName        = "/fateark.proto.command.CommandService/ConfigureCommandPacing"
+	CommandService_SendCommandAs_FullMethodName                 = "/fateark.proto.command.CommandService/SendCommandAs"
+	CommandService_SendCommandAsWithResponse_FullMethodName     = "/fateark.proto.command.CommandService/SendCommandAsWithResponse"
+	CommandService_BuildCommandFragment_FullMethodName          = "/fateark.proto.command.CommandService/BuildCommandFragment"
+	CommandService_GetCommandPolicy_FullMethodName              = "/fateark.proto.command.CommandService/GetCommandPolicy"
+	CommandService_SetCommandPolicy_FullMethodName              = "/fateark.proto.command.CommandService/SetCommandPolicy"
+	CommandService_CheckCommand_FullMethodName                  = "/fateark.proto.command.CommandService/CheckCommand"
+	CommandService_GetCommandTree_FullMethodName                = "/fateark.proto.command.CommandService/GetCommandTree"
+	CommandService_ValidateCommand_FullMethodName               = "/fateark.proto.command.CommandService/ValidateCommand"
+	CommandService_CompleteCommand_FullMethodName               = "/fateark.proto.command.CommandService/CompleteCommand"
+	CommandService_RunScript_FullMethodName                     = "/fateark.proto.command.CommandService/RunScript"
 )
 
 // CommandServiceClient is the client API for CommandService service.
@@ -37,9 +51,23 @@ type CommandServiceClient interface {
 	SendWSCommand(ctx context.Context, in *SendWSCommandRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
 	SendPlayerCommand(ctx context.Context, in *SendPlayerCommandRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
 	SendAICommand(ctx context.Context, in *SendAICommandRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
-	SendWSCommandWithResponse(ctx context.Context, in *SendWSCommandWithResponseRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
-	SendPlayerCommandWithResponse(ctx context.Context, in *SendPlayerCommandWithResponseRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
-	SendAICommandWithResponse(ctx context.Context, in *SendAICommandWithResponseRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	SendWSCommandWithResponse(ctx context.Context, in *SendWSCommandWithResponseRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error)
+	SendPlayerCommandWithResponse(ctx context.Context, in *SendPlayerCommandWithResponseRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error)
+	SendAICommandWithResponse(ctx context.Context, in *SendAICommandWithResponseRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error)
+	SendCommandBatch(ctx context.Context, in *SendCommandBatchRequest, opts ...grpc.CallOption) (*SendCommandBatchResponse, error)
+	StreamCommandBatch(ctx context.Context, in *SendCommandBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchCommandResult], error)
+	GetCommandQueueStats(ctx context.Context, in *GetCommandQueueStatsRequest, opts ...grpc.CallOption) (*GetCommandQueueStatsResponse, error)
+	ConfigureCommandPacing(ctx context.Context, in *ConfigureCommandPacingRequest, opts ...grpc.CallOption) (*CommandPacingConfig, error)
+	SendCommandAs(ctx context.Context, in *SendCommandAsRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	SendCommandAsWithResponse(ctx context.Context, in *SendCommandAsRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error)
+	BuildCommandFragment(ctx context.Context, in *BuildCommandFragmentRequest, opts ...grpc.CallOption) (*BuildCommandFragmentResponse, error)
+	GetCommandPolicy(ctx context.Context, in *GetCommandPolicyRequest, opts ...grpc.CallOption) (*CommandPolicy, error)
+	SetCommandPolicy(ctx context.Context, in *SetCommandPolicyRequest, opts ...grpc.CallOption) (*CommandPolicy, error)
+	CheckCommand(ctx context.Context, in *CheckCommandRequest, opts ...grpc.CallOption) (*CheckCommandResponse, error)
+	GetCommandTree(ctx context.Context, in *GetCommandTreeRequest, opts ...grpc.CallOption) (*GetCommandTreeResponse, error)
+	ValidateCommand(ctx context.Context, in *ValidateCommandRequest, opts ...grpc.CallOption) (*ValidateCommandResponse, error)
+	CompleteCommand(ctx context.Context, in *CompleteCommandRequest, opts ...grpc.CallOption) (*CompleteCommandResponse, error)
+	RunScript(ctx context.Context, in *RunScriptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScriptEvent], error)
 }
 
 type commandServiceClient struct {
@@ -90,9 +118,9 @@ func (c *commandServiceClient) SendAICommand(ctx context.Context, in *SendAIComm
 	return out, nil
 }
 
-func (c *commandServiceClient) SendWSCommandWithResponse(ctx context.Context, in *SendWSCommandWithResponseRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+func (c *commandServiceClient) SendWSCommandWithResponse(ctx context.Context, in *SendWSCommandWithResponseRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(response.GeneralResponse)
+	out := new(CommandOutputResponse)
 	err := c.cc.Invoke(ctx, CommandService_SendWSCommandWithResponse_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -100,9 +128,9 @@ func (c *commandServiceClient) SendWSCommandWithResponse(ctx context.Context, in
 	return out, nil
 }
 
-func (c *commandServiceClient) SendPlayerCommandWithResponse(ctx context.Context, in *SendPlayerCommandWithResponseRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+func (c *commandServiceClient) SendPlayerCommandWithResponse(ctx context.Context, in *SendPlayerCommandWithResponseRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(response.GeneralResponse)
+	out := new(CommandOutputResponse)
 	err := c.cc.Invoke(ctx, CommandService_SendPlayerCommandWithResponse_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -110,9 +138,9 @@ func (c *commandServiceClient) SendPlayerCommandWithResponse(ctx context.Context
 	return out, nil
 }
 
-func (c *commandServiceClient) SendAICommandWithResponse(ctx context.Context, in *SendAICommandWithResponseRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+func (c *commandServiceClient) SendAICommandWithResponse(ctx context.Context, in *SendAICommandWithResponseRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(response.GeneralResponse)
+	out := new(CommandOutputResponse)
 	err := c.cc.Invoke(ctx, CommandService_SendAICommandWithResponse_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -120,6 +148,164 @@ func (c *commandServiceClient) SendAICommandWithResponse(ctx context.Context, in
 	return out, nil
 }
 
+func (c *commandServiceClient) SendCommandBatch(ctx context.Context, in *SendCommandBatchRequest, opts ...grpc.CallOption) (*SendCommandBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendCommandBatchResponse)
+	err := c.cc.Invoke(ctx, CommandService_SendCommandBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) StreamCommandBatch(ctx context.Context, in *SendCommandBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchCommandResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CommandService_ServiceDesc.Streams[0], CommandService_StreamCommandBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SendCommandBatchRequest, BatchCommandResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommandService_StreamCommandBatchClient = grpc.ServerStreamingClient[BatchCommandResult]
+
+func (c *commandServiceClient) GetCommandQueueStats(ctx context.Context, in *GetCommandQueueStatsRequest, opts ...grpc.CallOption) (*GetCommandQueueStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommandQueueStatsResponse)
+	err := c.cc.Invoke(ctx, CommandService_GetCommandQueueStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) ConfigureCommandPacing(ctx context.Context, in *ConfigureCommandPacingRequest, opts ...grpc.CallOption) (*CommandPacingConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandPacingConfig)
+	err := c.cc.Invoke(ctx, CommandService_ConfigureCommandPacing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) SendCommandAs(ctx context.Context, in *SendCommandAsRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(response.GeneralResponse)
+	err := c.cc.Invoke(ctx, CommandService_SendCommandAs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) SendCommandAsWithResponse(ctx context.Context, in *SendCommandAsRequest, opts ...grpc.CallOption) (*CommandOutputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandOutputResponse)
+	err := c.cc.Invoke(ctx, CommandService_SendCommandAsWithResponse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) BuildCommandFragment(ctx context.Context, in *BuildCommandFragmentRequest, opts ...grpc.CallOption) (*BuildCommandFragmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildCommandFragmentResponse)
+	err := c.cc.Invoke(ctx, CommandService_BuildCommandFragment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) GetCommandPolicy(ctx context.Context, in *GetCommandPolicyRequest, opts ...grpc.CallOption) (*CommandPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandPolicy)
+	err := c.cc.Invoke(ctx, CommandService_GetCommandPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) SetCommandPolicy(ctx context.Context, in *SetCommandPolicyRequest, opts ...grpc.CallOption) (*CommandPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandPolicy)
+	err := c.cc.Invoke(ctx, CommandService_SetCommandPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) CheckCommand(ctx context.Context, in *CheckCommandRequest, opts ...grpc.CallOption) (*CheckCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckCommandResponse)
+	err := c.cc.Invoke(ctx, CommandService_CheckCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) GetCommandTree(ctx context.Context, in *GetCommandTreeRequest, opts ...grpc.CallOption) (*GetCommandTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommandTreeResponse)
+	err := c.cc.Invoke(ctx, CommandService_GetCommandTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) ValidateCommand(ctx context.Context, in *ValidateCommandRequest, opts ...grpc.CallOption) (*ValidateCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCommandResponse)
+	err := c.cc.Invoke(ctx, CommandService_ValidateCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) CompleteCommand(ctx context.Context, in *CompleteCommandRequest, opts ...grpc.CallOption) (*CompleteCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteCommandResponse)
+	err := c.cc.Invoke(ctx, CommandService_CompleteCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandServiceClient) RunScript(ctx context.Context, in *RunScriptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScriptEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CommandService_ServiceDesc.Streams[1], CommandService_RunScript_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RunScriptRequest, ScriptEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommandService_RunScriptClient = grpc.ServerStreamingClient[ScriptEvent]
+
 // CommandServiceServer is the server API for CommandService service.
 // All implementations must embed UnimplementedCommandServiceServer
 // for forward compatibility.
@@ -128,9 +314,23 @@ type CommandServiceServer interface {
 	SendWSCommand(context.Context, *SendWSCommandRequest) (*response.GeneralResponse, error)
 	SendPlayerCommand(context.Context, *SendPlayerCommandRequest) (*response.GeneralResponse, error)
 	SendAICommand(context.Context, *SendAICommandRequest) (*response.GeneralResponse, error)
-	SendWSCommandWithResponse(context.Context, *SendWSCommandWithResponseRequest) (*response.GeneralResponse, error)
-	SendPlayerCommandWithResponse(context.Context, *SendPlayerCommandWithResponseRequest) (*response.GeneralResponse, error)
-	SendAICommandWithResponse(context.Context, *SendAICommandWithResponseRequest) (*response.GeneralResponse, error)
+	SendWSCommandWithResponse(context.Context, *SendWSCommandWithResponseRequest) (*CommandOutputResponse, error)
+	SendPlayerCommandWithResponse(context.Context, *SendPlayerCommandWithResponseRequest) (*CommandOutputResponse, error)
+	SendAICommandWithResponse(context.Context, *SendAICommandWithResponseRequest) (*CommandOutputResponse, error)
+	SendCommandBatch(context.Context, *SendCommandBatchRequest) (*SendCommandBatchResponse, error)
+	StreamCommandBatch(*SendCommandBatchRequest, grpc.ServerStreamingServer[BatchCommandResult]) error
+	GetCommandQueueStats(context.Context, *GetCommandQueueStatsRequest) (*GetCommandQueueStatsResponse, error)
+	ConfigureCommandPacing(context.Context, *ConfigureCommandPacingRequest) (*CommandPacingConfig, error)
+	SendCommandAs(context.Context, *SendCommandAsRequest) (*response.GeneralResponse, error)
+	SendCommandAsWithResponse(context.Context, *SendCommandAsRequest) (*CommandOutputResponse, error)
+	BuildCommandFragment(context.Context, *BuildCommandFragmentRequest) (*BuildCommandFragmentResponse, error)
+	GetCommandPolicy(context.Context, *GetCommandPolicyRequest) (*CommandPolicy, error)
+	SetCommandPolicy(context.Context, *SetCommandPolicyRequest) (*CommandPolicy, error)
+	CheckCommand(context.Context, *CheckCommandRequest) (*CheckCommandResponse, error)
+	GetCommandTree(context.Context, *GetCommandTreeRequest) (*GetCommandTreeResponse, error)
+	ValidateCommand(context.Context, *ValidateCommandRequest) (*ValidateCommandResponse, error)
+	CompleteCommand(context.Context, *CompleteCommandRequest) (*CompleteCommandResponse, error)
+	RunScript(*RunScriptRequest, grpc.ServerStreamingServer[ScriptEvent]) error
 	mustEmbedUnimplementedCommandServiceServer()
 }
 
@@ -153,15 +353,57 @@ func (UnimplementedCommandServiceServer) SendPlayerCommand(context.Context, *Sen
 func (UnimplementedCommandServiceServer) SendAICommand(context.Context, *SendAICommandRequest) (*response.GeneralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAICommand not implemented")
 }
-func (UnimplementedCommandServiceServer) SendWSCommandWithResponse(context.Context, *SendWSCommandWithResponseRequest) (*response.GeneralResponse, error) {
+func (UnimplementedCommandServiceServer) SendWSCommandWithResponse(context.Context, *SendWSCommandWithResponseRequest) (*CommandOutputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendWSCommandWithResponse not implemented")
 }
-func (UnimplementedCommandServiceServer) SendPlayerCommandWithResponse(context.Context, *SendPlayerCommandWithResponseRequest) (*response.GeneralResponse, error) {
+func (UnimplementedCommandServiceServer) SendPlayerCommandWithResponse(context.Context, *SendPlayerCommandWithResponseRequest) (*CommandOutputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendPlayerCommandWithResponse not implemented")
 }
-func (UnimplementedCommandServiceServer) SendAICommandWithResponse(context.Context, *SendAICommandWithResponseRequest) (*response.GeneralResponse, error) {
+func (UnimplementedCommandServiceServer) SendAICommandWithResponse(context.Context, *SendAICommandWithResponseRequest) (*CommandOutputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendAICommandWithResponse not implemented")
 }
+func (UnimplementedCommandServiceServer) SendCommandBatch(context.Context, *SendCommandBatchRequest) (*SendCommandBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommandBatch not implemented")
+}
+func (UnimplementedCommandServiceServer) StreamCommandBatch(*SendCommandBatchRequest, grpc.ServerStreamingServer[BatchCommandResult]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCommandBatch not implemented")
+}
+func (UnimplementedCommandServiceServer) GetCommandQueueStats(context.Context, *GetCommandQueueStatsRequest) (*GetCommandQueueStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommandQueueStats not implemented")
+}
+func (UnimplementedCommandServiceServer) ConfigureCommandPacing(context.Context, *ConfigureCommandPacingRequest) (*CommandPacingConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureCommandPacing not implemented")
+}
+func (UnimplementedCommandServiceServer) SendCommandAs(context.Context, *SendCommandAsRequest) (*response.GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommandAs not implemented")
+}
+func (UnimplementedCommandServiceServer) SendCommandAsWithResponse(context.Context, *SendCommandAsRequest) (*CommandOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommandAsWithResponse not implemented")
+}
+func (UnimplementedCommandServiceServer) BuildCommandFragment(context.Context, *BuildCommandFragmentRequest) (*BuildCommandFragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildCommandFragment not implemented")
+}
+func (UnimplementedCommandServiceServer) GetCommandPolicy(context.Context, *GetCommandPolicyRequest) (*CommandPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommandPolicy not implemented")
+}
+func (UnimplementedCommandServiceServer) SetCommandPolicy(context.Context, *SetCommandPolicyRequest) (*CommandPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommandPolicy not implemented")
+}
+func (UnimplementedCommandServiceServer) CheckCommand(context.Context, *CheckCommandRequest) (*CheckCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCommand not implemented")
+}
+func (UnimplementedCommandServiceServer) GetCommandTree(context.Context, *GetCommandTreeRequest) (*GetCommandTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommandTree not implemented")
+}
+func (UnimplementedCommandServiceServer) ValidateCommand(context.Context, *ValidateCommandRequest) (*ValidateCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCommand not implemented")
+}
+func (UnimplementedCommandServiceServer) CompleteCommand(context.Context, *CompleteCommandRequest) (*CompleteCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCommand not implemented")
+}
+func (UnimplementedCommandServiceServer) RunScript(*RunScriptRequest, grpc.ServerStreamingServer[ScriptEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method RunScript not implemented")
+}
 func (UnimplementedCommandServiceServer) mustEmbedUnimplementedCommandServiceServer() {}
 func (UnimplementedCommandServiceServer) testEmbeddedByValue()                        {}
 
@@ -309,6 +551,244 @@ func _CommandService_SendAICommandWithResponse_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommandService_SendCommandBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).SendCommandBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_SendCommandBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).SendCommandBatch(ctx, req.(*SendCommandBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_StreamCommandBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SendCommandBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommandServiceServer).StreamCommandBatch(m, &grpc.GenericServerStream[SendCommandBatchRequest, BatchCommandResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommandService_StreamCommandBatchServer = grpc.ServerStreamingServer[BatchCommandResult]
+
+func _CommandService_GetCommandQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandQueueStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).GetCommandQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_GetCommandQueueStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).GetCommandQueueStats(ctx, req.(*GetCommandQueueStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_ConfigureCommandPacing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureCommandPacingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).ConfigureCommandPacing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_ConfigureCommandPacing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).ConfigureCommandPacing(ctx, req.(*ConfigureCommandPacingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_SendCommandAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).SendCommandAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_SendCommandAs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).SendCommandAs(ctx, req.(*SendCommandAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_SendCommandAsWithResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCommandAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).SendCommandAsWithResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_SendCommandAsWithResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).SendCommandAsWithResponse(ctx, req.(*SendCommandAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_BuildCommandFragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildCommandFragmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).BuildCommandFragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_BuildCommandFragment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).BuildCommandFragment(ctx, req.(*BuildCommandFragmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_GetCommandPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).GetCommandPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_GetCommandPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).GetCommandPolicy(ctx, req.(*GetCommandPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_SetCommandPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommandPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).SetCommandPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_SetCommandPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).SetCommandPolicy(ctx, req.(*SetCommandPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_CheckCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).CheckCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_CheckCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).CheckCommand(ctx, req.(*CheckCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_GetCommandTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).GetCommandTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_GetCommandTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).GetCommandTree(ctx, req.(*GetCommandTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_ValidateCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).ValidateCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_ValidateCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).ValidateCommand(ctx, req.(*ValidateCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_CompleteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServiceServer).CompleteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommandService_CompleteCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServiceServer).CompleteCommand(ctx, req.(*CompleteCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommandService_RunScript_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunScriptRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommandServiceServer).RunScript(m, &grpc.GenericServerStream[RunScriptRequest, ScriptEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommandService_RunScriptServer = grpc.ServerStreamingServer[ScriptEvent]
+
 // CommandService_ServiceDesc is the grpc.ServiceDesc for CommandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,7 +824,66 @@ var CommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendAICommandWithResponse",
 			Handler:    _CommandService_SendAICommandWithResponse_Handler,
 		},
+		{
+			MethodName: "SendCommandBatch",
+			Handler:    _CommandService_SendCommandBatch_Handler,
+		},
+		{
+			MethodName: "GetCommandQueueStats",
+			Handler:    _CommandService_GetCommandQueueStats_Handler,
+		},
+		{
+			MethodName: "ConfigureCommandPacing",
+			Handler:    _CommandService_ConfigureCommandPacing_Handler,
+		},
+		{
+			MethodName: "SendCommandAs",
+			Handler:    _CommandService_SendCommandAs_Handler,
+		},
+		{
+			MethodName: "SendCommandAsWithResponse",
+			Handler:    _CommandService_SendCommandAsWithResponse_Handler,
+		},
+		{
+			MethodName: "BuildCommandFragment",
+			Handler:    _CommandService_BuildCommandFragment_Handler,
+		},
+		{
+			MethodName: "GetCommandPolicy",
+			Handler:    _CommandService_GetCommandPolicy_Handler,
+		},
+		{
+			MethodName: "SetCommandPolicy",
+			Handler:    _CommandService_SetCommandPolicy_Handler,
+		},
+		{
+			MethodName: "CheckCommand",
+			Handler:    _CommandService_CheckCommand_Handler,
+		},
+		{
+			MethodName: "GetCommandTree",
+			Handler:    _CommandService_GetCommandTree_Handler,
+		},
+		{
+			MethodName: "ValidateCommand",
+			Handler:    _CommandService_ValidateCommand_Handler,
+		},
+		{
+			MethodName: "CompleteCommand",
+			Handler:    _CommandService_CompleteCommand_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCommandBatch",
+			Handler:       _CommandService_StreamCommandBatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RunScript",
+			Handler:       _CommandService_RunScript_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/command.proto",
 }
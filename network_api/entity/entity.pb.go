@@ -0,0 +1,1147 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/entity.proto
+
+package entitypb
+
+import (
+	command "github.com/Yeah114/tempest-core/network_api/command"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EntityEvent_Type int32
+
+const (
+	EntityEvent_SPAWN   EntityEvent_Type = 0
+	EntityEvent_DESPAWN EntityEvent_Type = 1
+	EntityEvent_MOVE    EntityEvent_Type = 2
+	EntityEvent_UPDATE  EntityEvent_Type = 3
+)
+
+// Enum value maps for EntityEvent_Type.
+var (
+	EntityEvent_Type_name = map[int32]string{
+		0: "SPAWN",
+		1: "DESPAWN",
+		2: "MOVE",
+		3: "UPDATE",
+	}
+	EntityEvent_Type_value = map[string]int32{
+		"SPAWN":   0,
+		"DESPAWN": 1,
+		"MOVE":    2,
+		"UPDATE":  3,
+	}
+)
+
+func (x EntityEvent_Type) Enum() *EntityEvent_Type {
+	p := new(EntityEvent_Type)
+	*p = x
+	return p
+}
+
+func (x EntityEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entity_proto_enumTypes[0].Descriptor()
+}
+
+func (EntityEvent_Type) Type() protoreflect.EnumType {
+	return &file_proto_entity_proto_enumTypes[0]
+}
+
+func (x EntityEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityEvent_Type.Descriptor instead.
+func (EntityEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type Vec3 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float32                `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vec3) Reset() {
+	*x = Vec3{}
+	mi := &file_proto_entity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vec3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vec3) ProtoMessage() {}
+
+func (x *Vec3) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vec3.ProtoReflect.Descriptor instead.
+func (*Vec3) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vec3) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vec3) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Vec3) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type Entity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UniqueId      int64                  `protobuf:"varint,1,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	RuntimeId     uint64                 `protobuf:"varint,2,opt,name=runtime_id,json=runtimeId,proto3" json:"runtime_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Position      *Vec3                  `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Rotation      *Vec3                  `protobuf:"bytes,5,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Metadata      string                 `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SpawnedAtMs   int64                  `protobuf:"varint,7,opt,name=spawned_at_ms,json=spawnedAtMs,proto3" json:"spawned_at_ms,omitempty"`
+	UpdatedAtMs   int64                  `protobuf:"varint,8,opt,name=updated_at_ms,json=updatedAtMs,proto3" json:"updated_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	mi := &file_proto_entity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Entity) GetUniqueId() int64 {
+	if x != nil {
+		return x.UniqueId
+	}
+	return 0
+}
+
+func (x *Entity) GetRuntimeId() uint64 {
+	if x != nil {
+		return x.RuntimeId
+	}
+	return 0
+}
+
+func (x *Entity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Entity) GetPosition() *Vec3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Entity) GetRotation() *Vec3 {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *Entity) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Entity) GetSpawnedAtMs() int64 {
+	if x != nil {
+		return x.SpawnedAtMs
+	}
+	return 0
+}
+
+func (x *Entity) GetUpdatedAtMs() int64 {
+	if x != nil {
+		return x.UpdatedAtMs
+	}
+	return 0
+}
+
+type EntityFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	Center        *Vec3                  `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Radius        float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	AroundBot     bool                   `protobuf:"varint,4,opt,name=around_bot,json=aroundBot,proto3" json:"around_bot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityFilter) Reset() {
+	*x = EntityFilter{}
+	mi := &file_proto_entity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityFilter) ProtoMessage() {}
+
+func (x *EntityFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityFilter.ProtoReflect.Descriptor instead.
+func (*EntityFilter) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EntityFilter) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *EntityFilter) GetCenter() *Vec3 {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *EntityFilter) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *EntityFilter) GetAroundBot() bool {
+	if x != nil {
+		return x.AroundBot
+	}
+	return false
+}
+
+type QueryEntitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *EntityFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryEntitiesRequest) Reset() {
+	*x = QueryEntitiesRequest{}
+	mi := &file_proto_entity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEntitiesRequest) ProtoMessage() {}
+
+func (x *QueryEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*QueryEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryEntitiesRequest) GetFilter() *EntityFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryEntitiesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueryEntitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entities      []*Entity              `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryEntitiesResponse) Reset() {
+	*x = QueryEntitiesResponse{}
+	mi := &file_proto_entity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEntitiesResponse) ProtoMessage() {}
+
+func (x *QueryEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*QueryEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryEntitiesResponse) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type GetEntityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Id:
+	//
+	//	*GetEntityRequest_UniqueId
+	//	*GetEntityRequest_RuntimeId
+	Id            isGetEntityRequest_Id `protobuf_oneof:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntityRequest) Reset() {
+	*x = GetEntityRequest{}
+	mi := &file_proto_entity_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityRequest) ProtoMessage() {}
+
+func (x *GetEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetEntityRequest) GetId() isGetEntityRequest_Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GetEntityRequest) GetUniqueId() int64 {
+	if x != nil {
+		if x, ok := x.Id.(*GetEntityRequest_UniqueId); ok {
+			return x.UniqueId
+		}
+	}
+	return 0
+}
+
+func (x *GetEntityRequest) GetRuntimeId() uint64 {
+	if x != nil {
+		if x, ok := x.Id.(*GetEntityRequest_RuntimeId); ok {
+			return x.RuntimeId
+		}
+	}
+	return 0
+}
+
+type isGetEntityRequest_Id interface {
+	isGetEntityRequest_Id()
+}
+
+type GetEntityRequest_UniqueId struct {
+	UniqueId int64 `protobuf:"varint,1,opt,name=unique_id,json=uniqueId,proto3,oneof"`
+}
+
+type GetEntityRequest_RuntimeId struct {
+	RuntimeId uint64 `protobuf:"varint,2,opt,name=runtime_id,json=runtimeId,proto3,oneof"`
+}
+
+func (*GetEntityRequest_UniqueId) isGetEntityRequest_Id() {}
+
+func (*GetEntityRequest_RuntimeId) isGetEntityRequest_Id() {}
+
+type EntityEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          EntityEvent_Type       `protobuf:"varint,1,opt,name=type,proto3,enum=fateark.proto.entity.EntityEvent_Type" json:"type,omitempty"`
+	Entity        *Entity                `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	TimestampMs   int64                  `protobuf:"varint,3,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityEvent) Reset() {
+	*x = EntityEvent{}
+	mi := &file_proto_entity_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityEvent) ProtoMessage() {}
+
+func (x *EntityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityEvent.ProtoReflect.Descriptor instead.
+func (*EntityEvent) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EntityEvent) GetType() EntityEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EntityEvent_SPAWN
+}
+
+func (x *EntityEvent) GetEntity() *Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *EntityEvent) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+type ListenEntitiesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Filter          *EntityFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Events          []EntityEvent_Type     `protobuf:"varint,2,rep,packed,name=events,proto3,enum=fateark.proto.entity.EntityEvent_Type" json:"events,omitempty"`
+	IncludeExisting bool                   `protobuf:"varint,3,opt,name=include_existing,json=includeExisting,proto3" json:"include_existing,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListenEntitiesRequest) Reset() {
+	*x = ListenEntitiesRequest{}
+	mi := &file_proto_entity_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListenEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenEntitiesRequest) ProtoMessage() {}
+
+func (x *ListenEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListenEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListenEntitiesRequest) GetFilter() *EntityFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListenEntitiesRequest) GetEvents() []EntityEvent_Type {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListenEntitiesRequest) GetIncludeExisting() bool {
+	if x != nil {
+		return x.IncludeExisting
+	}
+	return false
+}
+
+type TargetRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UniqueId      int64                  `protobuf:"varint,1,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Dimension     int32                  `protobuf:"varint,3,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	Position      *Vec3                  `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	YRot          float32                `protobuf:"fixed32,5,opt,name=y_rot,json=yRot,proto3" json:"y_rot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetRecord) Reset() {
+	*x = TargetRecord{}
+	mi := &file_proto_entity_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetRecord) ProtoMessage() {}
+
+func (x *TargetRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetRecord.ProtoReflect.Descriptor instead.
+func (*TargetRecord) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TargetRecord) GetUniqueId() int64 {
+	if x != nil {
+		return x.UniqueId
+	}
+	return 0
+}
+
+func (x *TargetRecord) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TargetRecord) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+func (x *TargetRecord) GetPosition() *Vec3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *TargetRecord) GetYRot() float32 {
+	if x != nil {
+		return x.YRot
+	}
+	return 0
+}
+
+type QueryTargetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*QueryTargetsRequest_Selector
+	//	*QueryTargetsRequest_Spec
+	Target        isQueryTargetsRequest_Target `protobuf_oneof:"target"`
+	PageSize      uint32                       `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Limit         uint32                       `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTargetsRequest) Reset() {
+	*x = QueryTargetsRequest{}
+	mi := &file_proto_entity_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTargetsRequest) ProtoMessage() {}
+
+func (x *QueryTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTargetsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryTargetsRequest) GetTarget() isQueryTargetsRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *QueryTargetsRequest) GetSelector() string {
+	if x != nil {
+		if x, ok := x.Target.(*QueryTargetsRequest_Selector); ok {
+			return x.Selector
+		}
+	}
+	return ""
+}
+
+func (x *QueryTargetsRequest) GetSpec() *command.SelectorSpec {
+	if x != nil {
+		if x, ok := x.Target.(*QueryTargetsRequest_Spec); ok {
+			return x.Spec
+		}
+	}
+	return nil
+}
+
+func (x *QueryTargetsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *QueryTargetsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type isQueryTargetsRequest_Target interface {
+	isQueryTargetsRequest_Target()
+}
+
+type QueryTargetsRequest_Selector struct {
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3,oneof"`
+}
+
+type QueryTargetsRequest_Spec struct {
+	Spec *command.SelectorSpec `protobuf:"bytes,2,opt,name=spec,proto3,oneof"`
+}
+
+func (*QueryTargetsRequest_Selector) isQueryTargetsRequest_Target() {}
+
+func (*QueryTargetsRequest_Spec) isQueryTargetsRequest_Target() {}
+
+type QueryTargetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Targets       []*TargetRecord        `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	Pages         uint32                 `protobuf:"varint,2,opt,name=pages,proto3" json:"pages,omitempty"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTargetsResponse) Reset() {
+	*x = QueryTargetsResponse{}
+	mi := &file_proto_entity_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTargetsResponse) ProtoMessage() {}
+
+func (x *QueryTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTargetsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryTargetsResponse) GetTargets() []*TargetRecord {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *QueryTargetsResponse) GetPages() uint32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *QueryTargetsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type TestForTargetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*TestForTargetsRequest_Selector
+	//	*TestForTargetsRequest_Spec
+	Target        isTestForTargetsRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestForTargetsRequest) Reset() {
+	*x = TestForTargetsRequest{}
+	mi := &file_proto_entity_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestForTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestForTargetsRequest) ProtoMessage() {}
+
+func (x *TestForTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestForTargetsRequest.ProtoReflect.Descriptor instead.
+func (*TestForTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TestForTargetsRequest) GetTarget() isTestForTargetsRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *TestForTargetsRequest) GetSelector() string {
+	if x != nil {
+		if x, ok := x.Target.(*TestForTargetsRequest_Selector); ok {
+			return x.Selector
+		}
+	}
+	return ""
+}
+
+func (x *TestForTargetsRequest) GetSpec() *command.SelectorSpec {
+	if x != nil {
+		if x, ok := x.Target.(*TestForTargetsRequest_Spec); ok {
+			return x.Spec
+		}
+	}
+	return nil
+}
+
+type isTestForTargetsRequest_Target interface {
+	isTestForTargetsRequest_Target()
+}
+
+type TestForTargetsRequest_Selector struct {
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3,oneof"`
+}
+
+type TestForTargetsRequest_Spec struct {
+	Spec *command.SelectorSpec `protobuf:"bytes,2,opt,name=spec,proto3,oneof"`
+}
+
+func (*TestForTargetsRequest_Selector) isTestForTargetsRequest_Target() {}
+
+func (*TestForTargetsRequest_Spec) isTestForTargetsRequest_Target() {}
+
+type TestForTargetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestForTargetsResponse) Reset() {
+	*x = TestForTargetsResponse{}
+	mi := &file_proto_entity_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestForTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestForTargetsResponse) ProtoMessage() {}
+
+func (x *TestForTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entity_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestForTargetsResponse.ProtoReflect.Descriptor instead.
+func (*TestForTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entity_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TestForTargetsResponse) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *TestForTargetsResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+var File_proto_entity_proto protoreflect.FileDescriptor
+
+const file_proto_entity_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/entity.proto\x12\x14fateark.proto.entity\x1a\x13proto/command.proto\"0\n" +
+	"\x04Vec3\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x02R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x02R\x01y\x12\f\n" +
+	"\x01z\x18\x03 \x01(\x02R\x01z\"\xac\x02\n" +
+	"\x06Entity\x12\x1b\n" +
+	"\tunique_id\x18\x01 \x01(\x03R\buniqueId\x12\x1d\n" +
+	"\n" +
+	"runtime_id\x18\x02 \x01(\x04R\truntimeId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x126\n" +
+	"\bposition\x18\x04 \x01(\v2\x1a.fateark.proto.entity.Vec3R\bposition\x126\n" +
+	"\brotation\x18\x05 \x01(\v2\x1a.fateark.proto.entity.Vec3R\brotation\x12\x1a\n" +
+	"\bmetadata\x18\x06 \x01(\tR\bmetadata\x12\"\n" +
+	"\rspawned_at_ms\x18\a \x01(\x03R\vspawnedAtMs\x12\"\n" +
+	"\rupdated_at_ms\x18\b \x01(\x03R\vupdatedAtMs\"\x8f\x01\n" +
+	"\fEntityFilter\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x122\n" +
+	"\x06center\x18\x02 \x01(\v2\x1a.fateark.proto.entity.Vec3R\x06center\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12\x1d\n" +
+	"\n" +
+	"around_bot\x18\x04 \x01(\bR\taroundBot\"h\n" +
+	"\x14QueryEntitiesRequest\x12:\n" +
+	"\x06filter\x18\x01 \x01(\v2\".fateark.proto.entity.EntityFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"Q\n" +
+	"\x15QueryEntitiesResponse\x128\n" +
+	"\bentities\x18\x01 \x03(\v2\x1c.fateark.proto.entity.EntityR\bentities\"X\n" +
+	"\x10GetEntityRequest\x12\x1d\n" +
+	"\tunique_id\x18\x01 \x01(\x03H\x00R\buniqueId\x12\x1f\n" +
+	"\n" +
+	"runtime_id\x18\x02 \x01(\x04H\x00R\truntimeIdB\x04\n" +
+	"\x02id\"\xd8\x01\n" +
+	"\vEntityEvent\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.fateark.proto.entity.EntityEvent.TypeR\x04type\x124\n" +
+	"\x06entity\x18\x02 \x01(\v2\x1c.fateark.proto.entity.EntityR\x06entity\x12!\n" +
+	"\ftimestamp_ms\x18\x03 \x01(\x03R\vtimestampMs\"4\n" +
+	"\x04Type\x12\t\n" +
+	"\x05SPAWN\x10\x00\x12\v\n" +
+	"\aDESPAWN\x10\x01\x12\b\n" +
+	"\x04MOVE\x10\x02\x12\n" +
+	"\n" +
+	"\x06UPDATE\x10\x03\"\xbe\x01\n" +
+	"\x15ListenEntitiesRequest\x12:\n" +
+	"\x06filter\x18\x01 \x01(\v2\".fateark.proto.entity.EntityFilterR\x06filter\x12>\n" +
+	"\x06events\x18\x02 \x03(\x0e2&.fateark.proto.entity.EntityEvent.TypeR\x06events\x12)\n" +
+	"\x10include_existing\x18\x03 \x01(\bR\x0fincludeExisting\"\xaa\x01\n" +
+	"\fTargetRecord\x12\x1b\n" +
+	"\tunique_id\x18\x01 \x01(\x03R\buniqueId\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x1c\n" +
+	"\tdimension\x18\x03 \x01(\x05R\tdimension\x126\n" +
+	"\bposition\x18\x04 \x01(\v2\x1a.fateark.proto.entity.Vec3R\bposition\x12\x13\n" +
+	"\x05y_rot\x18\x05 \x01(\x02R\x04yRot\"\xab\x01\n" +
+	"\x13QueryTargetsRequest\x12\x1c\n" +
+	"\bselector\x18\x01 \x01(\tH\x00R\bselector\x129\n" +
+	"\x04spec\x18\x02 \x01(\v2#.fateark.proto.command.SelectorSpecH\x00R\x04spec\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limitB\b\n" +
+	"\x06target\"\x88\x01\n" +
+	"\x14QueryTargetsResponse\x12<\n" +
+	"\atargets\x18\x01 \x03(\v2\".fateark.proto.entity.TargetRecordR\atargets\x12\x14\n" +
+	"\x05pages\x18\x02 \x01(\rR\x05pages\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"z\n" +
+	"\x15TestForTargetsRequest\x12\x1c\n" +
+	"\bselector\x18\x01 \x01(\tH\x00R\bselector\x129\n" +
+	"\x04spec\x18\x02 \x01(\v2#.fateark.proto.command.SelectorSpecH\x00R\x04specB\b\n" +
+	"\x06target\"H\n" +
+	"\x16TestForTargetsResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\bR\amatched\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names2\x84\x04\n" +
+	"\rEntityService\x12h\n" +
+	"\rQueryEntities\x12*.fateark.proto.entity.QueryEntitiesRequest\x1a+.fateark.proto.entity.QueryEntitiesResponse\x12Q\n" +
+	"\tGetEntity\x12&.fateark.proto.entity.GetEntityRequest\x1a\x1c.fateark.proto.entity.Entity\x12b\n" +
+	"\x0eListenEntities\x12+.fateark.proto.entity.ListenEntitiesRequest\x1a!.fateark.proto.entity.EntityEvent0\x01\x12e\n" +
+	"\fQueryTargets\x12).fateark.proto.entity.QueryTargetsRequest\x1a*.fateark.proto.entity.QueryTargetsResponse\x12k\n" +
+	"\x0eTestForTargets\x12+.fateark.proto.entity.TestForTargetsRequest\x1a,.fateark.proto.entity.TestForTargetsResponseB=Z;github.com/Yeah114/tempest-core/network_api/entity;entitypbb\x06proto3"
+
+var (
+	file_proto_entity_proto_rawDescOnce sync.Once
+	file_proto_entity_proto_rawDescData []byte
+)
+
+func file_proto_entity_proto_rawDescGZIP() []byte {
+	file_proto_entity_proto_rawDescOnce.Do(func() {
+		file_proto_entity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_entity_proto_rawDesc), len(file_proto_entity_proto_rawDesc)))
+	})
+	return file_proto_entity_proto_rawDescData
+}
+
+var file_proto_entity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_entity_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_entity_proto_goTypes = []any{
+	(EntityEvent_Type)(0),          // 0: fateark.proto.entity.EntityEvent.Type
+	(*Vec3)(nil),                   // 1: fateark.proto.entity.Vec3
+	(*Entity)(nil),                 // 2: fateark.proto.entity.Entity
+	(*EntityFilter)(nil),           // 3: fateark.proto.entity.EntityFilter
+	(*QueryEntitiesRequest)(nil),   // 4: fateark.proto.entity.QueryEntitiesRequest
+	(*QueryEntitiesResponse)(nil),  // 5: fateark.proto.entity.QueryEntitiesResponse
+	(*GetEntityRequest)(nil),       // 6: fateark.proto.entity.GetEntityRequest
+	(*EntityEvent)(nil),            // 7: fateark.proto.entity.EntityEvent
+	(*ListenEntitiesRequest)(nil),  // 8: fateark.proto.entity.ListenEntitiesRequest
+	(*TargetRecord)(nil),           // 9: fateark.proto.entity.TargetRecord
+	(*QueryTargetsRequest)(nil),    // 10: fateark.proto.entity.QueryTargetsRequest
+	(*QueryTargetsResponse)(nil),   // 11: fateark.proto.entity.QueryTargetsResponse
+	(*TestForTargetsRequest)(nil),  // 12: fateark.proto.entity.TestForTargetsRequest
+	(*TestForTargetsResponse)(nil), // 13: fateark.proto.entity.TestForTargetsResponse
+	(*command.SelectorSpec)(nil),   // 14: fateark.proto.command.SelectorSpec
+}
+var file_proto_entity_proto_depIdxs = []int32{
+	1,  // 0: fateark.proto.entity.Entity.position:type_name -> fateark.proto.entity.Vec3
+	1,  // 1: fateark.proto.entity.Entity.rotation:type_name -> fateark.proto.entity.Vec3
+	1,  // 2: fateark.proto.entity.EntityFilter.center:type_name -> fateark.proto.entity.Vec3
+	3,  // 3: fateark.proto.entity.QueryEntitiesRequest.filter:type_name -> fateark.proto.entity.EntityFilter
+	2,  // 4: fateark.proto.entity.QueryEntitiesResponse.entities:type_name -> fateark.proto.entity.Entity
+	0,  // 5: fateark.proto.entity.EntityEvent.type:type_name -> fateark.proto.entity.EntityEvent.Type
+	2,  // 6: fateark.proto.entity.EntityEvent.entity:type_name -> fateark.proto.entity.Entity
+	3,  // 7: fateark.proto.entity.ListenEntitiesRequest.filter:type_name -> fateark.proto.entity.EntityFilter
+	0,  // 8: fateark.proto.entity.ListenEntitiesRequest.events:type_name -> fateark.proto.entity.EntityEvent.Type
+	1,  // 9: fateark.proto.entity.TargetRecord.position:type_name -> fateark.proto.entity.Vec3
+	14, // 10: fateark.proto.entity.QueryTargetsRequest.spec:type_name -> fateark.proto.command.SelectorSpec
+	9,  // 11: fateark.proto.entity.QueryTargetsResponse.targets:type_name -> fateark.proto.entity.TargetRecord
+	14, // 12: fateark.proto.entity.TestForTargetsRequest.spec:type_name -> fateark.proto.command.SelectorSpec
+	4,  // 13: fateark.proto.entity.EntityService.QueryEntities:input_type -> fateark.proto.entity.QueryEntitiesRequest
+	6,  // 14: fateark.proto.entity.EntityService.GetEntity:input_type -> fateark.proto.entity.GetEntityRequest
+	8,  // 15: fateark.proto.entity.EntityService.ListenEntities:input_type -> fateark.proto.entity.ListenEntitiesRequest
+	10, // 16: fateark.proto.entity.EntityService.QueryTargets:input_type -> fateark.proto.entity.QueryTargetsRequest
+	12, // 17: fateark.proto.entity.EntityService.TestForTargets:input_type -> fateark.proto.entity.TestForTargetsRequest
+	5,  // 18: fateark.proto.entity.EntityService.QueryEntities:output_type -> fateark.proto.entity.QueryEntitiesResponse
+	2,  // 19: fateark.proto.entity.EntityService.GetEntity:output_type -> fateark.proto.entity.Entity
+	7,  // 20: fateark.proto.entity.EntityService.ListenEntities:output_type -> fateark.proto.entity.EntityEvent
+	11, // 21: fateark.proto.entity.EntityService.QueryTargets:output_type -> fateark.proto.entity.QueryTargetsResponse
+	13, // 22: fateark.proto.entity.EntityService.TestForTargets:output_type -> fateark.proto.entity.TestForTargetsResponse
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_proto_entity_proto_init() }
+func file_proto_entity_proto_init() {
+	if File_proto_entity_proto != nil {
+		return
+	}
+	file_proto_entity_proto_msgTypes[5].OneofWrappers = []any{
+		(*GetEntityRequest_UniqueId)(nil),
+		(*GetEntityRequest_RuntimeId)(nil),
+	}
+	file_proto_entity_proto_msgTypes[9].OneofWrappers = []any{
+		(*QueryTargetsRequest_Selector)(nil),
+		(*QueryTargetsRequest_Spec)(nil),
+	}
+	file_proto_entity_proto_msgTypes[11].OneofWrappers = []any{
+		(*TestForTargetsRequest_Selector)(nil),
+		(*TestForTargetsRequest_Spec)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_entity_proto_rawDesc), len(file_proto_entity_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_entity_proto_goTypes,
+		DependencyIndexes: file_proto_entity_proto_depIdxs,
+		EnumInfos:         file_proto_entity_proto_enumTypes,
+		MessageInfos:      file_proto_entity_proto_msgTypes,
+	}.Build()
+	File_proto_entity_proto = out.File
+	file_proto_entity_proto_goTypes = nil
+	file_proto_entity_proto_depIdxs = nil
+}
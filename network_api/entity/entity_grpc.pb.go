@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: proto/entity.proto
+
+package entitypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EntityService_QueryEntities_FullMethodName  = "/fateark.proto.entity.EntityService/QueryEntities"
+	EntityService_GetEntity_FullMethodName      = "/fateark.proto.entity.EntityService/GetEntity"
+	EntityService_ListenEntities_FullMethodName = "/fateark.proto.entity.EntityService/ListenEntities"
+	EntityService_QueryTargets_FullMethodName   = "/fateark.proto.entity.EntityService/QueryTargets"
+	EntityService_TestForTargets_FullMethodName = "/fateark.proto.entity.EntityService/TestForTargets"
+)
+
+// EntityServiceClient is the client API for EntityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EntityServiceClient interface {
+	QueryEntities(ctx context.Context, in *QueryEntitiesRequest, opts ...grpc.CallOption) (*QueryEntitiesResponse, error)
+	GetEntity(ctx context.Context, in *GetEntityRequest, opts ...grpc.CallOption) (*Entity, error)
+	ListenEntities(ctx context.Context, in *ListenEntitiesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EntityEvent], error)
+	QueryTargets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error)
+	TestForTargets(ctx context.Context, in *TestForTargetsRequest, opts ...grpc.CallOption) (*TestForTargetsResponse, error)
+}
+
+type entityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEntityServiceClient(cc grpc.ClientConnInterface) EntityServiceClient {
+	return &entityServiceClient{cc}
+}
+
+func (c *entityServiceClient) QueryEntities(ctx context.Context, in *QueryEntitiesRequest, opts ...grpc.CallOption) (*QueryEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEntitiesResponse)
+	err := c.cc.Invoke(ctx, EntityService_QueryEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) GetEntity(ctx context.Context, in *GetEntityRequest, opts ...grpc.CallOption) (*Entity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, EntityService_GetEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) ListenEntities(ctx context.Context, in *ListenEntitiesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EntityEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EntityService_ServiceDesc.Streams[0], EntityService_ListenEntities_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListenEntitiesRequest, EntityEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EntityService_ListenEntitiesClient = grpc.ServerStreamingClient[EntityEvent]
+
+func (c *entityServiceClient) QueryTargets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTargetsResponse)
+	err := c.cc.Invoke(ctx, EntityService_QueryTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityServiceClient) TestForTargets(ctx context.Context, in *TestForTargetsRequest, opts ...grpc.CallOption) (*TestForTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestForTargetsResponse)
+	err := c.cc.Invoke(ctx, EntityService_TestForTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EntityServiceServer is the server API for EntityService service.
+// All implementations must embed UnimplementedEntityServiceServer
+// for forward compatibility.
+type EntityServiceServer interface {
+	QueryEntities(context.Context, *QueryEntitiesRequest) (*QueryEntitiesResponse, error)
+	GetEntity(context.Context, *GetEntityRequest) (*Entity, error)
+	ListenEntities(*ListenEntitiesRequest, grpc.ServerStreamingServer[EntityEvent]) error
+	QueryTargets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error)
+	TestForTargets(context.Context, *TestForTargetsRequest) (*TestForTargetsResponse, error)
+	mustEmbedUnimplementedEntityServiceServer()
+}
+
+// UnimplementedEntityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEntityServiceServer struct{}
+
+func (UnimplementedEntityServiceServer) QueryEntities(context.Context, *QueryEntitiesRequest) (*QueryEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEntities not implemented")
+}
+func (UnimplementedEntityServiceServer) GetEntity(context.Context, *GetEntityRequest) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntity not implemented")
+}
+func (UnimplementedEntityServiceServer) ListenEntities(*ListenEntitiesRequest, grpc.ServerStreamingServer[EntityEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method ListenEntities not implemented")
+}
+func (UnimplementedEntityServiceServer) QueryTargets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTargets not implemented")
+}
+func (UnimplementedEntityServiceServer) TestForTargets(context.Context, *TestForTargetsRequest) (*TestForTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestForTargets not implemented")
+}
+func (UnimplementedEntityServiceServer) mustEmbedUnimplementedEntityServiceServer() {}
+func (UnimplementedEntityServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeEntityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EntityServiceServer will
+// result in compilation errors.
+type UnsafeEntityServiceServer interface {
+	mustEmbedUnimplementedEntityServiceServer()
+}
+
+func RegisterEntityServiceServer(s grpc.ServiceRegistrar, srv EntityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEntityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EntityService_ServiceDesc, srv)
+}
+
+func _EntityService_QueryEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).QueryEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_QueryEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).QueryEntities(ctx, req.(*QueryEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_GetEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).GetEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_GetEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).GetEntity(ctx, req.(*GetEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_ListenEntities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListenEntitiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EntityServiceServer).ListenEntities(m, &grpc.GenericServerStream[ListenEntitiesRequest, EntityEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EntityService_ListenEntitiesServer = grpc.ServerStreamingServer[EntityEvent]
+
+func _EntityService_QueryTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).QueryTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_QueryTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).QueryTargets(ctx, req.(*QueryTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityService_TestForTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestForTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityServiceServer).TestForTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityService_TestForTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityServiceServer).TestForTargets(ctx, req.(*TestForTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EntityService_ServiceDesc is the grpc.ServiceDesc for EntityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EntityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fateark.proto.entity.EntityService",
+	HandlerType: (*EntityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryEntities",
+			Handler:    _EntityService_QueryEntities_Handler,
+		},
+		{
+			MethodName: "GetEntity",
+			Handler:    _EntityService_GetEntity_Handler,
+		},
+		{
+			MethodName: "QueryTargets",
+			Handler:    _EntityService_QueryTargets_Handler,
+		},
+		{
+			MethodName: "TestForTargets",
+			Handler:    _EntityService_TestForTargets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListenEntities",
+			Handler:       _EntityService_ListenEntities_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/entity.proto",
+}
@@ -256,6 +256,11 @@ func (*ListenPlayerChangeRequest) Descriptor() ([]byte, []int) {
 
 type ListenChatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TextTypes     []uint32               `protobuf:"varint,1,rep,packed,name=text_types,json=textTypes,proto3" json:"text_types,omitempty"`
+	SenderNames   []string               `protobuf:"bytes,2,rep,name=sender_names,json=senderNames,proto3" json:"sender_names,omitempty"`
+	SenderUuids   []string               `protobuf:"bytes,3,rep,name=sender_uuids,json=senderUuids,proto3" json:"sender_uuids,omitempty"`
+	MessageRegex  string                 `protobuf:"bytes,4,opt,name=message_regex,json=messageRegex,proto3" json:"message_regex,omitempty"`
+	ExcludeSelf   bool                   `protobuf:"varint,5,opt,name=exclude_self,json=excludeSelf,proto3" json:"exclude_self,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -290,6 +295,41 @@ func (*ListenChatRequest) Descriptor() ([]byte, []int) {
 	return file_proto_listener_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *ListenChatRequest) GetTextTypes() []uint32 {
+	if x != nil {
+		return x.TextTypes
+	}
+	return nil
+}
+
+func (x *ListenChatRequest) GetSenderNames() []string {
+	if x != nil {
+		return x.SenderNames
+	}
+	return nil
+}
+
+func (x *ListenChatRequest) GetSenderUuids() []string {
+	if x != nil {
+		return x.SenderUuids
+	}
+	return nil
+}
+
+func (x *ListenChatRequest) GetMessageRegex() string {
+	if x != nil {
+		return x.MessageRegex
+	}
+	return ""
+}
+
+func (x *ListenChatRequest) GetExcludeSelf() bool {
+	if x != nil {
+		return x.ExcludeSelf
+	}
+	return false
+}
+
 type ListenCommandBlockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -499,10 +539,19 @@ func (x *BytesPacket) GetPayload() []byte {
 }
 
 type PlayerAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Action   string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Uuid     string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// xuid comes from PlayerList packets. It is empty for players who were
+	// already online when the session started, until they rejoin.
+	Xuid             string `protobuf:"bytes,4,opt,name=xuid,proto3" json:"xuid,omitempty"`
+	EntityUniqueId   int64  `protobuf:"varint,5,opt,name=entity_unique_id,json=entityUniqueId,proto3" json:"entity_unique_id,omitempty"`
+	BuildPlatform    int32  `protobuf:"varint,6,opt,name=build_platform,json=buildPlatform,proto3" json:"build_platform,omitempty"`
+	TimestampMs      int64  `protobuf:"varint,7,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	SnapshotFinished bool   `protobuf:"varint,8,opt,name=snapshot_finished,json=snapshotFinished,proto3" json:"snapshot_finished,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *PlayerAction) Reset() {
@@ -542,11 +591,71 @@ func (x *PlayerAction) GetAction() string {
 	return ""
 }
 
+func (x *PlayerAction) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerAction) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PlayerAction) GetXuid() string {
+	if x != nil {
+		return x.Xuid
+	}
+	return ""
+}
+
+func (x *PlayerAction) GetEntityUniqueId() int64 {
+	if x != nil {
+		return x.EntityUniqueId
+	}
+	return 0
+}
+
+func (x *PlayerAction) GetBuildPlatform() int32 {
+	if x != nil {
+		return x.BuildPlatform
+	}
+	return 0
+}
+
+func (x *PlayerAction) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *PlayerAction) GetSnapshotFinished() bool {
+	if x != nil {
+		return x.SnapshotFinished
+	}
+	return false
+}
+
 type Chat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payload       string                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/listener.proto.
+	Payload        string   `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	TextType       uint32   `protobuf:"varint,2,opt,name=text_type,json=textType,proto3" json:"text_type,omitempty"`
+	Name           string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RawName        string   `protobuf:"bytes,4,opt,name=raw_name,json=rawName,proto3" json:"raw_name,omitempty"`
+	Message        string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Parameters     []string `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Words          []string `protobuf:"bytes,7,rep,name=words,proto3" json:"words,omitempty"`
+	SenderUuid     string   `protobuf:"bytes,8,opt,name=sender_uuid,json=senderUuid,proto3" json:"sender_uuid,omitempty"`
+	Xuid           string   `protobuf:"bytes,9,opt,name=xuid,proto3" json:"xuid,omitempty"`
+	PlatformChatId string   `protobuf:"bytes,10,opt,name=platform_chat_id,json=platformChatId,proto3" json:"platform_chat_id,omitempty"`
+	TimestampMs    int64    `protobuf:"varint,11,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Chat) Reset() {
@@ -579,6 +688,7 @@ func (*Chat) Descriptor() ([]byte, []int) {
 	return file_proto_listener_proto_rawDescGZIP(), []int{12}
 }
 
+// Deprecated: Marked as deprecated in proto/listener.proto.
 func (x *Chat) GetPayload() string {
 	if x != nil {
 		return x.Payload
@@ -586,6 +696,76 @@ func (x *Chat) GetPayload() string {
 	return ""
 }
 
+func (x *Chat) GetTextType() uint32 {
+	if x != nil {
+		return x.TextType
+	}
+	return 0
+}
+
+func (x *Chat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Chat) GetRawName() string {
+	if x != nil {
+		return x.RawName
+	}
+	return ""
+}
+
+func (x *Chat) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Chat) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Chat) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *Chat) GetSenderUuid() string {
+	if x != nil {
+		return x.SenderUuid
+	}
+	return ""
+}
+
+func (x *Chat) GetXuid() string {
+	if x != nil {
+		return x.Xuid
+	}
+	return ""
+}
+
+func (x *Chat) GetPlatformChatId() string {
+	if x != nil {
+		return x.PlatformChatId
+	}
+	return ""
+}
+
+func (x *Chat) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
 var File_proto_listener_proto protoreflect.FileDescriptor
 
 const file_proto_listener_proto_rawDesc = "" +
@@ -598,8 +778,14 @@ const file_proto_listener_proto_rawDesc = "" +
 	"\tpacket_id\x18\x01 \x01(\rR\bpacketId\"<\n" +
 	"\x1dListenTypedBytesPacketRequest\x12\x1b\n" +
 	"\tpacket_id\x18\x01 \x01(\rR\bpacketId\"\x1b\n" +
-	"\x19ListenPlayerChangeRequest\"\x13\n" +
-	"\x11ListenChatRequest\"/\n" +
+	"\x19ListenPlayerChangeRequest\"\xc0\x01\n" +
+	"\x11ListenChatRequest\x12\x1d\n" +
+	"\n" +
+	"text_types\x18\x01 \x03(\rR\ttextTypes\x12!\n" +
+	"\fsender_names\x18\x02 \x03(\tR\vsenderNames\x12!\n" +
+	"\fsender_uuids\x18\x03 \x03(\tR\vsenderUuids\x12#\n" +
+	"\rmessage_regex\x18\x04 \x01(\tR\fmessageRegex\x12!\n" +
+	"\fexclude_self\x18\x05 \x01(\bR\vexcludeSelf\"/\n" +
 	"\x19ListenCommandBlockRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"N\n" +
 	"\x06Output\x12\x19\n" +
@@ -611,11 +797,32 @@ const file_proto_listener_proto_rawDesc = "" +
 	"\apayload\x18\x02 \x01(\tR\apayload\"7\n" +
 	"\vBytesPacket\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\fR\apayload\"&\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"\x8b\x02\n" +
 	"\fPlayerAction\x12\x16\n" +
-	"\x06action\x18\x01 \x01(\tR\x06action\" \n" +
-	"\x04Chat\x12\x18\n" +
-	"\apayload\x18\x01 \x01(\tR\apayload2\xe0\x06\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x12\n" +
+	"\x04xuid\x18\x04 \x01(\tR\x04xuid\x12(\n" +
+	"\x10entity_unique_id\x18\x05 \x01(\x03R\x0eentityUniqueId\x12%\n" +
+	"\x0ebuild_platform\x18\x06 \x01(\x05R\rbuildPlatform\x12!\n" +
+	"\ftimestamp_ms\x18\a \x01(\x03R\vtimestampMs\x12+\n" +
+	"\x11snapshot_finished\x18\b \x01(\bR\x10snapshotFinished\"\xc2\x02\n" +
+	"\x04Chat\x12\x1c\n" +
+	"\apayload\x18\x01 \x01(\tB\x02\x18\x01R\apayload\x12\x1b\n" +
+	"\ttext_type\x18\x02 \x01(\rR\btextType\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\braw_name\x18\x04 \x01(\tR\arawName\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"parameters\x18\x06 \x03(\tR\n" +
+	"parameters\x12\x14\n" +
+	"\x05words\x18\a \x03(\tR\x05words\x12\x1f\n" +
+	"\vsender_uuid\x18\b \x01(\tR\n" +
+	"senderUuid\x12\x12\n" +
+	"\x04xuid\x18\t \x01(\tR\x04xuid\x12(\n" +
+	"\x10platform_chat_id\x18\n" +
+	" \x01(\tR\x0eplatformChatId\x12!\n" +
+	"\ftimestamp_ms\x18\v \x01(\x03R\vtimestampMs2\xe0\x06\n" +
 	"\x0fListenerService\x12_\n" +
 	"\rListenFateArk\x12,.fateark.proto.listener.ListenFateArkRequest\x1a\x1e.fateark.proto.listener.Output0\x01\x12_\n" +
 	"\rListenPackets\x12,.fateark.proto.listener.ListenPacketsRequest\x1a\x1e.fateark.proto.listener.Packet0\x01\x12n\n" +
@@ -0,0 +1,1241 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/permission.proto
+
+package permissionpb
+
+import (
+	response "github.com/Yeah114/tempest-core/network_api/response"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoleAssignment_Kind int32
+
+const (
+	RoleAssignment_UUID    RoleAssignment_Kind = 0
+	RoleAssignment_NAME    RoleAssignment_Kind = 1
+	RoleAssignment_DEFAULT RoleAssignment_Kind = 2
+)
+
+// Enum value maps for RoleAssignment_Kind.
+var (
+	RoleAssignment_Kind_name = map[int32]string{
+		0: "UUID",
+		1: "NAME",
+		2: "DEFAULT",
+	}
+	RoleAssignment_Kind_value = map[string]int32{
+		"UUID":    0,
+		"NAME":    1,
+		"DEFAULT": 2,
+	}
+)
+
+func (x RoleAssignment_Kind) Enum() *RoleAssignment_Kind {
+	p := new(RoleAssignment_Kind)
+	*p = x
+	return p
+}
+
+func (x RoleAssignment_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoleAssignment_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_permission_proto_enumTypes[0].Descriptor()
+}
+
+func (RoleAssignment_Kind) Type() protoreflect.EnumType {
+	return &file_proto_permission_proto_enumTypes[0]
+}
+
+func (x RoleAssignment_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoleAssignment_Kind.Descriptor instead.
+func (RoleAssignment_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type RoleAbilities struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Build            bool                   `protobuf:"varint,1,opt,name=build,proto3" json:"build,omitempty"`
+	Mine             bool                   `protobuf:"varint,2,opt,name=mine,proto3" json:"mine,omitempty"`
+	DoorsAndSwitches bool                   `protobuf:"varint,3,opt,name=doors_and_switches,json=doorsAndSwitches,proto3" json:"doors_and_switches,omitempty"`
+	OpenContainers   bool                   `protobuf:"varint,4,opt,name=open_containers,json=openContainers,proto3" json:"open_containers,omitempty"`
+	AttackPlayers    bool                   `protobuf:"varint,5,opt,name=attack_players,json=attackPlayers,proto3" json:"attack_players,omitempty"`
+	AttackMobs       bool                   `protobuf:"varint,6,opt,name=attack_mobs,json=attackMobs,proto3" json:"attack_mobs,omitempty"`
+	OperatorCommands bool                   `protobuf:"varint,7,opt,name=operator_commands,json=operatorCommands,proto3" json:"operator_commands,omitempty"`
+	Teleport         bool                   `protobuf:"varint,8,opt,name=teleport,proto3" json:"teleport,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RoleAbilities) Reset() {
+	*x = RoleAbilities{}
+	mi := &file_proto_permission_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleAbilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAbilities) ProtoMessage() {}
+
+func (x *RoleAbilities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleAbilities.ProtoReflect.Descriptor instead.
+func (*RoleAbilities) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoleAbilities) GetBuild() bool {
+	if x != nil {
+		return x.Build
+	}
+	return false
+}
+
+func (x *RoleAbilities) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+func (x *RoleAbilities) GetDoorsAndSwitches() bool {
+	if x != nil {
+		return x.DoorsAndSwitches
+	}
+	return false
+}
+
+func (x *RoleAbilities) GetOpenContainers() bool {
+	if x != nil {
+		return x.OpenContainers
+	}
+	return false
+}
+
+func (x *RoleAbilities) GetAttackPlayers() bool {
+	if x != nil {
+		return x.AttackPlayers
+	}
+	return false
+}
+
+func (x *RoleAbilities) GetAttackMobs() bool {
+	if x != nil {
+		return x.AttackMobs
+	}
+	return false
+}
+
+func (x *RoleAbilities) GetOperatorCommands() bool {
+	if x != nil {
+		return x.OperatorCommands
+	}
+	return false
+}
+
+func (x *RoleAbilities) GetTeleport() bool {
+	if x != nil {
+		return x.Teleport
+	}
+	return false
+}
+
+type PermissionRole struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Abilities *RoleAbilities         `protobuf:"bytes,2,opt,name=abilities,proto3" json:"abilities,omitempty"`
+	// op grants (true) or revokes (false) operator status; unset leaves it.
+	Op *bool `protobuf:"varint,3,opt,name=op,proto3,oneof" json:"op,omitempty"`
+	// tags are added to the player when the role is applied.
+	Tags          []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	UpdatedAtMs   int64    `protobuf:"varint,6,opt,name=updated_at_ms,json=updatedAtMs,proto3" json:"updated_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionRole) Reset() {
+	*x = PermissionRole{}
+	mi := &file_proto_permission_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionRole) ProtoMessage() {}
+
+func (x *PermissionRole) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionRole.ProtoReflect.Descriptor instead.
+func (*PermissionRole) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PermissionRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PermissionRole) GetAbilities() *RoleAbilities {
+	if x != nil {
+		return x.Abilities
+	}
+	return nil
+}
+
+func (x *PermissionRole) GetOp() bool {
+	if x != nil && x.Op != nil {
+		return *x.Op
+	}
+	return false
+}
+
+func (x *PermissionRole) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PermissionRole) GetUpdatedAtMs() int64 {
+	if x != nil {
+		return x.UpdatedAtMs
+	}
+	return 0
+}
+
+type RoleAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  RoleAssignment_Kind    `protobuf:"varint,1,opt,name=kind,proto3,enum=fateark.proto.permission.RoleAssignment_Kind" json:"kind,omitempty"`
+	// pattern is a player UUID, a case-insensitive name glob using * and ?,
+	// or empty for DEFAULT.
+	Pattern       string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAtMs   int64  `protobuf:"varint,4,opt,name=created_at_ms,json=createdAtMs,proto3" json:"created_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleAssignment) Reset() {
+	*x = RoleAssignment{}
+	mi := &file_proto_permission_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleAssignment) ProtoMessage() {}
+
+func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
+func (*RoleAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoleAssignment) GetKind() RoleAssignment_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RoleAssignment_UUID
+}
+
+func (x *RoleAssignment) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleAssignment) GetCreatedAtMs() int64 {
+	if x != nil {
+		return x.CreatedAtMs
+	}
+	return 0
+}
+
+type PutRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *PermissionRole        `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRoleRequest) Reset() {
+	*x = PutRoleRequest{}
+	mi := &file_proto_permission_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRoleRequest) ProtoMessage() {}
+
+func (x *PutRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRoleRequest.ProtoReflect.Descriptor instead.
+func (*PutRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PutRoleRequest) GetRole() *PermissionRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type GetRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleRequest) Reset() {
+	*x = GetRoleRequest{}
+	mi := &file_proto_permission_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleRequest) ProtoMessage() {}
+
+func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_proto_permission_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{5}
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*PermissionRole      `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_proto_permission_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRolesResponse) GetRoles() []*PermissionRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_proto_permission_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AssignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *RoleAssignment        `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_proto_permission_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssignRoleRequest) GetAssignment() *RoleAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type UnassignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          RoleAssignment_Kind    `protobuf:"varint,1,opt,name=kind,proto3,enum=fateark.proto.permission.RoleAssignment_Kind" json:"kind,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignRoleRequest) Reset() {
+	*x = UnassignRoleRequest{}
+	mi := &file_proto_permission_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignRoleRequest) ProtoMessage() {}
+
+func (x *UnassignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignRoleRequest.ProtoReflect.Descriptor instead.
+func (*UnassignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnassignRoleRequest) GetKind() RoleAssignment_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RoleAssignment_UUID
+}
+
+func (x *UnassignRoleRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type ListAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsRequest) Reset() {
+	*x = ListAssignmentsRequest{}
+	mi := &file_proto_permission_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{10}
+}
+
+type ListAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*RoleAssignment      `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsResponse) Reset() {
+	*x = ListAssignmentsResponse{}
+	mi := &file_proto_permission_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAssignmentsResponse) GetAssignments() []*RoleAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type ApplyRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UuidStr       string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRoleRequest) Reset() {
+	*x = ApplyRoleRequest{}
+	mi := &file_proto_permission_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRoleRequest) ProtoMessage() {}
+
+func (x *ApplyRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRoleRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApplyRoleRequest) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+type RoleApplication struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UuidStr       string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Errors        []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	AppliedAtMs   int64                  `protobuf:"varint,6,opt,name=applied_at_ms,json=appliedAtMs,proto3" json:"applied_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleApplication) Reset() {
+	*x = RoleApplication{}
+	mi := &file_proto_permission_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleApplication) ProtoMessage() {}
+
+func (x *RoleApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleApplication.ProtoReflect.Descriptor instead.
+func (*RoleApplication) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RoleApplication) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+func (x *RoleApplication) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleApplication) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleApplication) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RoleApplication) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *RoleApplication) GetAppliedAtMs() int64 {
+	if x != nil {
+		return x.AppliedAtMs
+	}
+	return 0
+}
+
+type GetRoleDriftRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An empty list checks every online player.
+	UuidStrs      []string `protobuf:"bytes,1,rep,name=uuid_strs,json=uuidStrs,proto3" json:"uuid_strs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleDriftRequest) Reset() {
+	*x = GetRoleDriftRequest{}
+	mi := &file_proto_permission_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleDriftRequest) ProtoMessage() {}
+
+func (x *GetRoleDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleDriftRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleDriftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRoleDriftRequest) GetUuidStrs() []string {
+	if x != nil {
+		return x.UuidStrs
+	}
+	return nil
+}
+
+type PlayerRoleDrift struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UuidStr          string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Assignment       *RoleAssignment        `protobuf:"bytes,3,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	InSync           bool                   `protobuf:"varint,4,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	DesiredValues    uint32                 `protobuf:"varint,5,opt,name=desired_values,json=desiredValues,proto3" json:"desired_values,omitempty"`
+	ActualValues     uint32                 `protobuf:"varint,6,opt,name=actual_values,json=actualValues,proto3" json:"actual_values,omitempty"`
+	MissingAbilities []string               `protobuf:"bytes,7,rep,name=missing_abilities,json=missingAbilities,proto3" json:"missing_abilities,omitempty"`
+	ExtraAbilities   []string               `protobuf:"bytes,8,rep,name=extra_abilities,json=extraAbilities,proto3" json:"extra_abilities,omitempty"`
+	// op_drift is set when the role sets op and the player's operator status
+	// differs.
+	OpDrift         bool             `protobuf:"varint,9,opt,name=op_drift,json=opDrift,proto3" json:"op_drift,omitempty"`
+	LastApplication *RoleApplication `protobuf:"bytes,10,opt,name=last_application,json=lastApplication,proto3" json:"last_application,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerRoleDrift) Reset() {
+	*x = PlayerRoleDrift{}
+	mi := &file_proto_permission_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRoleDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRoleDrift) ProtoMessage() {}
+
+func (x *PlayerRoleDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRoleDrift.ProtoReflect.Descriptor instead.
+func (*PlayerRoleDrift) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlayerRoleDrift) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+func (x *PlayerRoleDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerRoleDrift) GetAssignment() *RoleAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *PlayerRoleDrift) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+func (x *PlayerRoleDrift) GetDesiredValues() uint32 {
+	if x != nil {
+		return x.DesiredValues
+	}
+	return 0
+}
+
+func (x *PlayerRoleDrift) GetActualValues() uint32 {
+	if x != nil {
+		return x.ActualValues
+	}
+	return 0
+}
+
+func (x *PlayerRoleDrift) GetMissingAbilities() []string {
+	if x != nil {
+		return x.MissingAbilities
+	}
+	return nil
+}
+
+func (x *PlayerRoleDrift) GetExtraAbilities() []string {
+	if x != nil {
+		return x.ExtraAbilities
+	}
+	return nil
+}
+
+func (x *PlayerRoleDrift) GetOpDrift() bool {
+	if x != nil {
+		return x.OpDrift
+	}
+	return false
+}
+
+func (x *PlayerRoleDrift) GetLastApplication() *RoleApplication {
+	if x != nil {
+		return x.LastApplication
+	}
+	return nil
+}
+
+type GetRoleDriftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// players lists the checked players that have a role assigned.
+	Players []*PlayerRoleDrift `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// missing lists requested UUIDs with no known player.
+	Missing       []string `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleDriftResponse) Reset() {
+	*x = GetRoleDriftResponse{}
+	mi := &file_proto_permission_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleDriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleDriftResponse) ProtoMessage() {}
+
+func (x *GetRoleDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_permission_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleDriftResponse.ProtoReflect.Descriptor instead.
+func (*GetRoleDriftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_permission_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetRoleDriftResponse) GetPlayers() []*PlayerRoleDrift {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GetRoleDriftResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+var File_proto_permission_proto protoreflect.FileDescriptor
+
+const file_proto_permission_proto_rawDesc = "" +
+	"\n" +
+	"\x16proto/permission.proto\x12\x18fateark.proto.permission\x1a\x14proto/response.proto\"\xa1\x02\n" +
+	"\rRoleAbilities\x12\x14\n" +
+	"\x05build\x18\x01 \x01(\bR\x05build\x12\x12\n" +
+	"\x04mine\x18\x02 \x01(\bR\x04mine\x12,\n" +
+	"\x12doors_and_switches\x18\x03 \x01(\bR\x10doorsAndSwitches\x12'\n" +
+	"\x0fopen_containers\x18\x04 \x01(\bR\x0eopenContainers\x12%\n" +
+	"\x0eattack_players\x18\x05 \x01(\bR\rattackPlayers\x12\x1f\n" +
+	"\vattack_mobs\x18\x06 \x01(\bR\n" +
+	"attackMobs\x12+\n" +
+	"\x11operator_commands\x18\a \x01(\bR\x10operatorCommands\x12\x1a\n" +
+	"\bteleport\x18\b \x01(\bR\bteleport\"\xd2\x01\n" +
+	"\x0ePermissionRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12E\n" +
+	"\tabilities\x18\x02 \x01(\v2'.fateark.proto.permission.RoleAbilitiesR\tabilities\x12\x13\n" +
+	"\x02op\x18\x03 \x01(\bH\x00R\x02op\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\"\n" +
+	"\rupdated_at_ms\x18\x06 \x01(\x03R\vupdatedAtMsB\x05\n" +
+	"\x03_opJ\x04\b\x05\x10\x06R\vpolicy_role\"\xce\x01\n" +
+	"\x0eRoleAssignment\x12A\n" +
+	"\x04kind\x18\x01 \x01(\x0e2-.fateark.proto.permission.RoleAssignment.KindR\x04kind\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\"\n" +
+	"\rcreated_at_ms\x18\x04 \x01(\x03R\vcreatedAtMs\"'\n" +
+	"\x04Kind\x12\b\n" +
+	"\x04UUID\x10\x00\x12\b\n" +
+	"\x04NAME\x10\x01\x12\v\n" +
+	"\aDEFAULT\x10\x02\"N\n" +
+	"\x0ePutRoleRequest\x12<\n" +
+	"\x04role\x18\x01 \x01(\v2(.fateark.proto.permission.PermissionRoleR\x04role\"$\n" +
+	"\x0eGetRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x12\n" +
+	"\x10ListRolesRequest\"S\n" +
+	"\x11ListRolesResponse\x12>\n" +
+	"\x05roles\x18\x01 \x03(\v2(.fateark.proto.permission.PermissionRoleR\x05roles\"'\n" +
+	"\x11DeleteRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"]\n" +
+	"\x11AssignRoleRequest\x12H\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2(.fateark.proto.permission.RoleAssignmentR\n" +
+	"assignment\"r\n" +
+	"\x13UnassignRoleRequest\x12A\n" +
+	"\x04kind\x18\x01 \x01(\x0e2-.fateark.proto.permission.RoleAssignment.KindR\x04kind\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\"\x18\n" +
+	"\x16ListAssignmentsRequest\"e\n" +
+	"\x17ListAssignmentsResponse\x12J\n" +
+	"\vassignments\x18\x01 \x03(\v2(.fateark.proto.permission.RoleAssignmentR\vassignments\"-\n" +
+	"\x10ApplyRoleRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"\xaa\x01\n" +
+	"\x0fRoleApplication\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06errors\x18\x05 \x03(\tR\x06errors\x12\"\n" +
+	"\rapplied_at_ms\x18\x06 \x01(\x03R\vappliedAtMs\"2\n" +
+	"\x13GetRoleDriftRequest\x12\x1b\n" +
+	"\tuuid_strs\x18\x01 \x03(\tR\buuidStrs\"\xb6\x03\n" +
+	"\x0fPlayerRoleDrift\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12H\n" +
+	"\n" +
+	"assignment\x18\x03 \x01(\v2(.fateark.proto.permission.RoleAssignmentR\n" +
+	"assignment\x12\x17\n" +
+	"\ain_sync\x18\x04 \x01(\bR\x06inSync\x12%\n" +
+	"\x0edesired_values\x18\x05 \x01(\rR\rdesiredValues\x12#\n" +
+	"\ractual_values\x18\x06 \x01(\rR\factualValues\x12+\n" +
+	"\x11missing_abilities\x18\a \x03(\tR\x10missingAbilities\x12'\n" +
+	"\x0fextra_abilities\x18\b \x03(\tR\x0eextraAbilities\x12\x19\n" +
+	"\bop_drift\x18\t \x01(\bR\aopDrift\x12T\n" +
+	"\x10last_application\x18\n" +
+	" \x01(\v2).fateark.proto.permission.RoleApplicationR\x0flastApplication\"u\n" +
+	"\x14GetRoleDriftResponse\x12C\n" +
+	"\aplayers\x18\x01 \x03(\v2).fateark.proto.permission.PlayerRoleDriftR\aplayers\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing2\xb3\a\n" +
+	"\x11PermissionService\x12]\n" +
+	"\aPutRole\x12(.fateark.proto.permission.PutRoleRequest\x1a(.fateark.proto.permission.PermissionRole\x12]\n" +
+	"\aGetRole\x12(.fateark.proto.permission.GetRoleRequest\x1a(.fateark.proto.permission.PermissionRole\x12d\n" +
+	"\tListRoles\x12*.fateark.proto.permission.ListRolesRequest\x1a+.fateark.proto.permission.ListRolesResponse\x12b\n" +
+	"\n" +
+	"DeleteRole\x12+.fateark.proto.permission.DeleteRoleRequest\x1a'.fateark.proto.response.GeneralResponse\x12c\n" +
+	"\n" +
+	"AssignRole\x12+.fateark.proto.permission.AssignRoleRequest\x1a(.fateark.proto.permission.RoleAssignment\x12f\n" +
+	"\fUnassignRole\x12-.fateark.proto.permission.UnassignRoleRequest\x1a'.fateark.proto.response.GeneralResponse\x12v\n" +
+	"\x0fListAssignments\x120.fateark.proto.permission.ListAssignmentsRequest\x1a1.fateark.proto.permission.ListAssignmentsResponse\x12b\n" +
+	"\tApplyRole\x12*.fateark.proto.permission.ApplyRoleRequest\x1a).fateark.proto.permission.RoleApplication\x12m\n" +
+	"\fGetRoleDrift\x12-.fateark.proto.permission.GetRoleDriftRequest\x1a..fateark.proto.permission.GetRoleDriftResponseBEZCgithub.com/Yeah114/tempest-core/network_api/permission;permissionpbb\x06proto3"
+
+var (
+	file_proto_permission_proto_rawDescOnce sync.Once
+	file_proto_permission_proto_rawDescData []byte
+)
+
+func file_proto_permission_proto_rawDescGZIP() []byte {
+	file_proto_permission_proto_rawDescOnce.Do(func() {
+		file_proto_permission_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_permission_proto_rawDesc), len(file_proto_permission_proto_rawDesc)))
+	})
+	return file_proto_permission_proto_rawDescData
+}
+
+var file_proto_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_permission_proto_goTypes = []any{
+	(RoleAssignment_Kind)(0),         // 0: fateark.proto.permission.RoleAssignment.Kind
+	(*RoleAbilities)(nil),            // 1: fateark.proto.permission.RoleAbilities
+	(*PermissionRole)(nil),           // 2: fateark.proto.permission.PermissionRole
+	(*RoleAssignment)(nil),           // 3: fateark.proto.permission.RoleAssignment
+	(*PutRoleRequest)(nil),           // 4: fateark.proto.permission.PutRoleRequest
+	(*GetRoleRequest)(nil),           // 5: fateark.proto.permission.GetRoleRequest
+	(*ListRolesRequest)(nil),         // 6: fateark.proto.permission.ListRolesRequest
+	(*ListRolesResponse)(nil),        // 7: fateark.proto.permission.ListRolesResponse
+	(*DeleteRoleRequest)(nil),        // 8: fateark.proto.permission.DeleteRoleRequest
+	(*AssignRoleRequest)(nil),        // 9: fateark.proto.permission.AssignRoleRequest
+	(*UnassignRoleRequest)(nil),      // 10: fateark.proto.permission.UnassignRoleRequest
+	(*ListAssignmentsRequest)(nil),   // 11: fateark.proto.permission.ListAssignmentsRequest
+	(*ListAssignmentsResponse)(nil),  // 12: fateark.proto.permission.ListAssignmentsResponse
+	(*ApplyRoleRequest)(nil),         // 13: fateark.proto.permission.ApplyRoleRequest
+	(*RoleApplication)(nil),          // 14: fateark.proto.permission.RoleApplication
+	(*GetRoleDriftRequest)(nil),      // 15: fateark.proto.permission.GetRoleDriftRequest
+	(*PlayerRoleDrift)(nil),          // 16: fateark.proto.permission.PlayerRoleDrift
+	(*GetRoleDriftResponse)(nil),     // 17: fateark.proto.permission.GetRoleDriftResponse
+	(*response.GeneralResponse)(nil), // 18: fateark.proto.response.GeneralResponse
+}
+var file_proto_permission_proto_depIdxs = []int32{
+	1,  // 0: fateark.proto.permission.PermissionRole.abilities:type_name -> fateark.proto.permission.RoleAbilities
+	0,  // 1: fateark.proto.permission.RoleAssignment.kind:type_name -> fateark.proto.permission.RoleAssignment.Kind
+	2,  // 2: fateark.proto.permission.PutRoleRequest.role:type_name -> fateark.proto.permission.PermissionRole
+	2,  // 3: fateark.proto.permission.ListRolesResponse.roles:type_name -> fateark.proto.permission.PermissionRole
+	3,  // 4: fateark.proto.permission.AssignRoleRequest.assignment:type_name -> fateark.proto.permission.RoleAssignment
+	0,  // 5: fateark.proto.permission.UnassignRoleRequest.kind:type_name -> fateark.proto.permission.RoleAssignment.Kind
+	3,  // 6: fateark.proto.permission.ListAssignmentsResponse.assignments:type_name -> fateark.proto.permission.RoleAssignment
+	3,  // 7: fateark.proto.permission.PlayerRoleDrift.assignment:type_name -> fateark.proto.permission.RoleAssignment
+	14, // 8: fateark.proto.permission.PlayerRoleDrift.last_application:type_name -> fateark.proto.permission.RoleApplication
+	16, // 9: fateark.proto.permission.GetRoleDriftResponse.players:type_name -> fateark.proto.permission.PlayerRoleDrift
+	4,  // 10: fateark.proto.permission.PermissionService.PutRole:input_type -> fateark.proto.permission.PutRoleRequest
+	5,  // 11: fateark.proto.permission.PermissionService.GetRole:input_type -> fateark.proto.permission.GetRoleRequest
+	6,  // 12: fateark.proto.permission.PermissionService.ListRoles:input_type -> fateark.proto.permission.ListRolesRequest
+	8,  // 13: fateark.proto.permission.PermissionService.DeleteRole:input_type -> fateark.proto.permission.DeleteRoleRequest
+	9,  // 14: fateark.proto.permission.PermissionService.AssignRole:input_type -> fateark.proto.permission.AssignRoleRequest
+	10, // 15: fateark.proto.permission.PermissionService.UnassignRole:input_type -> fateark.proto.permission.UnassignRoleRequest
+	11, // 16: fateark.proto.permission.PermissionService.ListAssignments:input_type -> fateark.proto.permission.ListAssignmentsRequest
+	13, // 17: fateark.proto.permission.PermissionService.ApplyRole:input_type -> fateark.proto.permission.ApplyRoleRequest
+	15, // 18: fateark.proto.permission.PermissionService.GetRoleDrift:input_type -> fateark.proto.permission.GetRoleDriftRequest
+	2,  // 19: fateark.proto.permission.PermissionService.PutRole:output_type -> fateark.proto.permission.PermissionRole
+	2,  // 20: fateark.proto.permission.PermissionService.GetRole:output_type -> fateark.proto.permission.PermissionRole
+	7,  // 21: fateark.proto.permission.PermissionService.ListRoles:output_type -> fateark.proto.permission.ListRolesResponse
+	18, // 22: fateark.proto.permission.PermissionService.DeleteRole:output_type -> fateark.proto.response.GeneralResponse
+	3,  // 23: fateark.proto.permission.PermissionService.AssignRole:output_type -> fateark.proto.permission.RoleAssignment
+	18, // 24: fateark.proto.permission.PermissionService.UnassignRole:output_type -> fateark.proto.response.GeneralResponse
+	12, // 25: fateark.proto.permission.PermissionService.ListAssignments:output_type -> fateark.proto.permission.ListAssignmentsResponse
+	14, // 26: fateark.proto.permission.PermissionService.ApplyRole:output_type -> fateark.proto.permission.RoleApplication
+	17, // 27: fateark.proto.permission.PermissionService.GetRoleDrift:output_type -> fateark.proto.permission.GetRoleDriftResponse
+	19, // [19:28] is the sub-list for method output_type
+	10, // [10:19] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_proto_permission_proto_init() }
+func file_proto_permission_proto_init() {
+	if File_proto_permission_proto != nil {
+		return
+	}
+	file_proto_permission_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_permission_proto_rawDesc), len(file_proto_permission_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_permission_proto_goTypes,
+		DependencyIndexes: file_proto_permission_proto_depIdxs,
+		EnumInfos:         file_proto_permission_proto_enumTypes,
+		MessageInfos:      file_proto_permission_proto_msgTypes,
+	}.Build()
+	File_proto_permission_proto = out.File
+	file_proto_permission_proto_goTypes = nil
+	file_proto_permission_proto_depIdxs = nil
+}
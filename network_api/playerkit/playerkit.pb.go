@@ -22,6 +22,101 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlayerPromptRequest_Channel int32
+
+const (
+	PlayerPromptRequest_CHAT       PlayerPromptRequest_Channel = 0
+	PlayerPromptRequest_ACTION_BAR PlayerPromptRequest_Channel = 1
+)
+
+// Enum value maps for PlayerPromptRequest_Channel.
+var (
+	PlayerPromptRequest_Channel_name = map[int32]string{
+		0: "CHAT",
+		1: "ACTION_BAR",
+	}
+	PlayerPromptRequest_Channel_value = map[string]int32{
+		"CHAT":       0,
+		"ACTION_BAR": 1,
+	}
+)
+
+func (x PlayerPromptRequest_Channel) Enum() *PlayerPromptRequest_Channel {
+	p := new(PlayerPromptRequest_Channel)
+	*p = x
+	return p
+}
+
+func (x PlayerPromptRequest_Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerPromptRequest_Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_playerkit_proto_enumTypes[0].Descriptor()
+}
+
+func (PlayerPromptRequest_Channel) Type() protoreflect.EnumType {
+	return &file_proto_playerkit_proto_enumTypes[0]
+}
+
+func (x PlayerPromptRequest_Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerPromptRequest_Channel.Descriptor instead.
+func (PlayerPromptRequest_Channel) EnumDescriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{53, 0}
+}
+
+type PlayerPromptResponse_Status int32
+
+const (
+	PlayerPromptResponse_ANSWERED  PlayerPromptResponse_Status = 0
+	PlayerPromptResponse_TIMEOUT   PlayerPromptResponse_Status = 1
+	PlayerPromptResponse_EXHAUSTED PlayerPromptResponse_Status = 2
+)
+
+// Enum value maps for PlayerPromptResponse_Status.
+var (
+	PlayerPromptResponse_Status_name = map[int32]string{
+		0: "ANSWERED",
+		1: "TIMEOUT",
+		2: "EXHAUSTED",
+	}
+	PlayerPromptResponse_Status_value = map[string]int32{
+		"ANSWERED":  0,
+		"TIMEOUT":   1,
+		"EXHAUSTED": 2,
+	}
+)
+
+func (x PlayerPromptResponse_Status) Enum() *PlayerPromptResponse_Status {
+	p := new(PlayerPromptResponse_Status)
+	*p = x
+	return p
+}
+
+func (x PlayerPromptResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerPromptResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_playerkit_proto_enumTypes[1].Descriptor()
+}
+
+func (PlayerPromptResponse_Status) Type() protoreflect.EnumType {
+	return &file_proto_playerkit_proto_enumTypes[1]
+}
+
+func (x PlayerPromptResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerPromptResponse_Status.Descriptor instead.
+func (PlayerPromptResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{54, 0}
+}
+
 type GetAllOnlinePlayersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1791,9 +1886,13 @@ func (x *SendPlayerActionBarRequest) GetActionBar() string {
 }
 
 type InterceptPlayerJustNextInputRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UuidStr       string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
-	RetrieverId   string                 `protobuf:"bytes,2,opt,name=retriever_id,json=retrieverId,proto3" json:"retriever_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UuidStr     string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	RetrieverId string                 `protobuf:"bytes,2,opt,name=retriever_id,json=retrieverId,proto3" json:"retriever_id,omitempty"`
+	// timeout_ms of zero waits for one minute.
+	TimeoutMs uint32 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// suppress hides the captured message from chat listeners.
+	Suppress      bool `protobuf:"varint,4,opt,name=suppress,proto3" json:"suppress,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1842,306 +1941,1929 @@ func (x *InterceptPlayerJustNextInputRequest) GetRetrieverId() string {
 	return ""
 }
 
-var File_proto_playerkit_proto protoreflect.FileDescriptor
+func (x *InterceptPlayerJustNextInputRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
 
-const file_proto_playerkit_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/playerkit.proto\x12\x17fateark.proto.playerkit\x1a\x14proto/response.proto\"\x1c\n" +
-	"\x1aGetAllOnlinePlayersRequest\",\n" +
-	"\x16GetPlayerByNameRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\",\n" +
-	"\x16GetPlayerByUUIDRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"5\n" +
-	"\x18ReleaseBindPlayerRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"1\n" +
-	"\x14GetPlayerNameRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\";\n" +
-	"\x1eGetPlayerEntityUniqueIDRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"6\n" +
-	"\x19GetPlayerLoginTimeRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\";\n" +
-	"\x1eGetPlayerPlatformChatIDRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\":\n" +
-	"\x1dGetPlayerBuildPlatformRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"3\n" +
-	"\x16GetPlayerSkinIDRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"5\n" +
-	"\x18GetPlayerCanBuildRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"K\n" +
-	"\x18SetPlayerCanBuildRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\"3\n" +
-	"\x16GetPlayerCanDigRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"I\n" +
-	"\x16SetPlayerCanDigRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\"@\n" +
-	"#GetPlayerCanDoorsAndSwitchesRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"V\n" +
-	"#SetPlayerCanDoorsAndSwitchesRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\">\n" +
-	"!GetPlayerCanOpenContainersRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"T\n" +
-	"!SetPlayerCanOpenContainersRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\"=\n" +
-	" GetPlayerCanAttackPlayersRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"S\n" +
-	" SetPlayerCanAttackPlayersRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\":\n" +
-	"\x1dGetPlayerCanAttackMobsRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"P\n" +
-	"\x1dSetPlayerCanAttackMobsRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\"@\n" +
-	"#GetPlayerCanOperatorCommandsRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"V\n" +
-	"#SetPlayerCanOperatorCommandsRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\"8\n" +
-	"\x1bGetPlayerCanTeleportRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"N\n" +
-	"\x1bSetPlayerCanTeleportRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05allow\x18\x02 \x01(\bR\x05allow\"?\n" +
-	"\"GetPlayerStatusInvulnerableRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"9\n" +
-	"\x1cGetPlayerStatusFlyingRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"9\n" +
-	"\x1cGetPlayerStatusMayFlyRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"5\n" +
-	"\x18GetPlayerDeviceIDRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"<\n" +
-	"\x1fGetPlayerEntityRuntimeIDRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\";\n" +
-	"\x1eGetPlayerEntityMetadataRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"1\n" +
-	"\x14GetPlayerIsOPRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"3\n" +
-	"\x16GetPlayerOnlineRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"D\n" +
-	"\x15SendPlayerChatRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"G\n" +
-	"\x18SendPlayerRawChatRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"f\n" +
-	"\x16SendPlayerTitleRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
-	"\tsub_title\x18\x03 \x01(\tR\bsubTitle\"V\n" +
-	"\x1aSendPlayerActionBarRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x1d\n" +
-	"\n" +
-	"action_bar\x18\x02 \x01(\tR\tactionBar\"c\n" +
-	"#InterceptPlayerJustNextInputRequest\x12\x19\n" +
-	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12!\n" +
-	"\fretriever_id\x18\x02 \x01(\tR\vretrieverId2\xab%\n" +
-	"\x10PlayerKitService\x12s\n" +
-	"\x13GetAllOnlinePlayers\x123.fateark.proto.playerkit.GetAllOnlinePlayersRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
-	"\x0fGetPlayerByName\x12/.fateark.proto.playerkit.GetPlayerByNameRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
-	"\x0fGetPlayerByUUID\x12/.fateark.proto.playerkit.GetPlayerByUUIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12o\n" +
-	"\x11ReleaseBindPlayer\x121.fateark.proto.playerkit.ReleaseBindPlayerRequest\x1a'.fateark.proto.response.GeneralResponse\x12g\n" +
-	"\rGetPlayerName\x12-.fateark.proto.playerkit.GetPlayerNameRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x80\x01\n" +
-	"\x17GetPlayerEntityUniqueID\x127.fateark.proto.playerkit.GetPlayerEntityUniqueIDRequest\x1a,.fateark.proto.response.GeneralInt64Response\x12v\n" +
-	"\x12GetPlayerLoginTime\x122.fateark.proto.playerkit.GetPlayerLoginTimeRequest\x1a,.fateark.proto.response.GeneralInt64Response\x12{\n" +
-	"\x17GetPlayerPlatformChatID\x127.fateark.proto.playerkit.GetPlayerPlatformChatIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12~\n" +
-	"\x16GetPlayerBuildPlatform\x126.fateark.proto.playerkit.GetPlayerBuildPlatformRequest\x1a,.fateark.proto.response.GeneralInt32Response\x12k\n" +
-	"\x0fGetPlayerSkinID\x12/.fateark.proto.playerkit.GetPlayerSkinIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12s\n" +
-	"\x11GetPlayerCanBuild\x121.fateark.proto.playerkit.GetPlayerCanBuildRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12o\n" +
-	"\x11SetPlayerCanBuild\x121.fateark.proto.playerkit.SetPlayerCanBuildRequest\x1a'.fateark.proto.response.GeneralResponse\x12o\n" +
-	"\x0fGetPlayerCanDig\x12/.fateark.proto.playerkit.GetPlayerCanDigRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12k\n" +
-	"\x0fSetPlayerCanDig\x12/.fateark.proto.playerkit.SetPlayerCanDigRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x89\x01\n" +
-	"\x1cGetPlayerCanDoorsAndSwitches\x12<.fateark.proto.playerkit.GetPlayerCanDoorsAndSwitchesRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x85\x01\n" +
-	"\x1cSetPlayerCanDoorsAndSwitches\x12<.fateark.proto.playerkit.SetPlayerCanDoorsAndSwitchesRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x85\x01\n" +
-	"\x1aGetPlayerCanOpenContainers\x12:.fateark.proto.playerkit.GetPlayerCanOpenContainersRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x81\x01\n" +
-	"\x1aSetPlayerCanOpenContainers\x12:.fateark.proto.playerkit.SetPlayerCanOpenContainersRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x83\x01\n" +
-	"\x19GetPlayerCanAttackPlayers\x129.fateark.proto.playerkit.GetPlayerCanAttackPlayersRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x7f\n" +
-	"\x19SetPlayerCanAttackPlayers\x129.fateark.proto.playerkit.SetPlayerCanAttackPlayersRequest\x1a'.fateark.proto.response.GeneralResponse\x12}\n" +
-	"\x16GetPlayerCanAttackMobs\x126.fateark.proto.playerkit.GetPlayerCanAttackMobsRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12y\n" +
-	"\x16SetPlayerCanAttackMobs\x126.fateark.proto.playerkit.SetPlayerCanAttackMobsRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x89\x01\n" +
-	"\x1cGetPlayerCanOperatorCommands\x12<.fateark.proto.playerkit.GetPlayerCanOperatorCommandsRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x85\x01\n" +
-	"\x1cSetPlayerCanOperatorCommands\x12<.fateark.proto.playerkit.SetPlayerCanOperatorCommandsRequest\x1a'.fateark.proto.response.GeneralResponse\x12y\n" +
-	"\x14GetPlayerCanTeleport\x124.fateark.proto.playerkit.GetPlayerCanTeleportRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12y\n" +
-	"\x14SetPlayerCanTeleport\x124.fateark.proto.playerkit.SetPlayerCanTeleportRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x87\x01\n" +
-	"\x1bGetPlayerStatusInvulnerable\x12;.fateark.proto.playerkit.GetPlayerStatusInvulnerableRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12{\n" +
-	"\x15GetPlayerStatusFlying\x125.fateark.proto.playerkit.GetPlayerStatusFlyingRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12{\n" +
-	"\x15GetPlayerStatusMayFly\x125.fateark.proto.playerkit.GetPlayerStatusMayFlyRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12o\n" +
-	"\x11GetPlayerDeviceID\x121.fateark.proto.playerkit.GetPlayerDeviceIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x83\x01\n" +
-	"\x18GetPlayerEntityRuntimeID\x128.fateark.proto.playerkit.GetPlayerEntityRuntimeIDRequest\x1a-.fateark.proto.response.GeneralUint64Response\x12{\n" +
-	"\x17GetPlayerEntityMetadata\x127.fateark.proto.playerkit.GetPlayerEntityMetadataRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
-	"\rGetPlayerIsOP\x12-.fateark.proto.playerkit.GetPlayerIsOPRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12o\n" +
-	"\x0fGetPlayerOnline\x12/.fateark.proto.playerkit.GetPlayerOnlineRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12i\n" +
-	"\x0eSendPlayerChat\x12..fateark.proto.playerkit.SendPlayerChatRequest\x1a'.fateark.proto.response.GeneralResponse\x12o\n" +
-	"\x11SendPlayerRawChat\x121.fateark.proto.playerkit.SendPlayerRawChatRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
-	"\x0fSendPlayerTitle\x12/.fateark.proto.playerkit.SendPlayerTitleRequest\x1a'.fateark.proto.response.GeneralResponse\x12s\n" +
-	"\x13SendPlayerActionBar\x123.fateark.proto.playerkit.SendPlayerActionBarRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x85\x01\n" +
-	"\x1cInterceptPlayerJustNextInput\x12<.fateark.proto.playerkit.InterceptPlayerJustNextInputRequest\x1a'.fateark.proto.response.GeneralResponseBCZAgithub.com/Yeah114/tempest-core/network_api/playerkit;playerkitpbb\x06proto3"
+func (x *InterceptPlayerJustNextInputRequest) GetSuppress() bool {
+	if x != nil {
+		return x.Suppress
+	}
+	return false
+}
 
-var (
-	file_proto_playerkit_proto_rawDescOnce sync.Once
-	file_proto_playerkit_proto_rawDescData []byte
-)
+type WaitPlayerInputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetrieverId   string                 `protobuf:"bytes,1,opt,name=retriever_id,json=retrieverId,proto3" json:"retriever_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func file_proto_playerkit_proto_rawDescGZIP() []byte {
-	file_proto_playerkit_proto_rawDescOnce.Do(func() {
-		file_proto_playerkit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_playerkit_proto_rawDesc), len(file_proto_playerkit_proto_rawDesc)))
-	})
-	return file_proto_playerkit_proto_rawDescData
+func (x *WaitPlayerInputRequest) Reset() {
+	*x = WaitPlayerInputRequest{}
+	mi := &file_proto_playerkit_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var file_proto_playerkit_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
-var file_proto_playerkit_proto_goTypes = []any{
-	(*GetAllOnlinePlayersRequest)(nil),          // 0: fateark.proto.playerkit.GetAllOnlinePlayersRequest
-	(*GetPlayerByNameRequest)(nil),              // 1: fateark.proto.playerkit.GetPlayerByNameRequest
-	(*GetPlayerByUUIDRequest)(nil),              // 2: fateark.proto.playerkit.GetPlayerByUUIDRequest
-	(*ReleaseBindPlayerRequest)(nil),            // 3: fateark.proto.playerkit.ReleaseBindPlayerRequest
-	(*GetPlayerNameRequest)(nil),                // 4: fateark.proto.playerkit.GetPlayerNameRequest
-	(*GetPlayerEntityUniqueIDRequest)(nil),      // 5: fateark.proto.playerkit.GetPlayerEntityUniqueIDRequest
-	(*GetPlayerLoginTimeRequest)(nil),           // 6: fateark.proto.playerkit.GetPlayerLoginTimeRequest
-	(*GetPlayerPlatformChatIDRequest)(nil),      // 7: fateark.proto.playerkit.GetPlayerPlatformChatIDRequest
-	(*GetPlayerBuildPlatformRequest)(nil),       // 8: fateark.proto.playerkit.GetPlayerBuildPlatformRequest
-	(*GetPlayerSkinIDRequest)(nil),              // 9: fateark.proto.playerkit.GetPlayerSkinIDRequest
-	(*GetPlayerCanBuildRequest)(nil),            // 10: fateark.proto.playerkit.GetPlayerCanBuildRequest
-	(*SetPlayerCanBuildRequest)(nil),            // 11: fateark.proto.playerkit.SetPlayerCanBuildRequest
-	(*GetPlayerCanDigRequest)(nil),              // 12: fateark.proto.playerkit.GetPlayerCanDigRequest
-	(*SetPlayerCanDigRequest)(nil),              // 13: fateark.proto.playerkit.SetPlayerCanDigRequest
-	(*GetPlayerCanDoorsAndSwitchesRequest)(nil), // 14: fateark.proto.playerkit.GetPlayerCanDoorsAndSwitchesRequest
-	(*SetPlayerCanDoorsAndSwitchesRequest)(nil), // 15: fateark.proto.playerkit.SetPlayerCanDoorsAndSwitchesRequest
-	(*GetPlayerCanOpenContainersRequest)(nil),   // 16: fateark.proto.playerkit.GetPlayerCanOpenContainersRequest
-	(*SetPlayerCanOpenContainersRequest)(nil),   // 17: fateark.proto.playerkit.SetPlayerCanOpenContainersRequest
-	(*GetPlayerCanAttackPlayersRequest)(nil),    // 18: fateark.proto.playerkit.GetPlayerCanAttackPlayersRequest
-	(*SetPlayerCanAttackPlayersRequest)(nil),    // 19: fateark.proto.playerkit.SetPlayerCanAttackPlayersRequest
-	(*GetPlayerCanAttackMobsRequest)(nil),       // 20: fateark.proto.playerkit.GetPlayerCanAttackMobsRequest
-	(*SetPlayerCanAttackMobsRequest)(nil),       // 21: fateark.proto.playerkit.SetPlayerCanAttackMobsRequest
-	(*GetPlayerCanOperatorCommandsRequest)(nil), // 22: fateark.proto.playerkit.GetPlayerCanOperatorCommandsRequest
-	(*SetPlayerCanOperatorCommandsRequest)(nil), // 23: fateark.proto.playerkit.SetPlayerCanOperatorCommandsRequest
-	(*GetPlayerCanTeleportRequest)(nil),         // 24: fateark.proto.playerkit.GetPlayerCanTeleportRequest
-	(*SetPlayerCanTeleportRequest)(nil),         // 25: fateark.proto.playerkit.SetPlayerCanTeleportRequest
-	(*GetPlayerStatusInvulnerableRequest)(nil),  // 26: fateark.proto.playerkit.GetPlayerStatusInvulnerableRequest
-	(*GetPlayerStatusFlyingRequest)(nil),        // 27: fateark.proto.playerkit.GetPlayerStatusFlyingRequest
-	(*GetPlayerStatusMayFlyRequest)(nil),        // 28: fateark.proto.playerkit.GetPlayerStatusMayFlyRequest
-	(*GetPlayerDeviceIDRequest)(nil),            // 29: fateark.proto.playerkit.GetPlayerDeviceIDRequest
-	(*GetPlayerEntityRuntimeIDRequest)(nil),     // 30: fateark.proto.playerkit.GetPlayerEntityRuntimeIDRequest
-	(*GetPlayerEntityMetadataRequest)(nil),      // 31: fateark.proto.playerkit.GetPlayerEntityMetadataRequest
-	(*GetPlayerIsOPRequest)(nil),                // 32: fateark.proto.playerkit.GetPlayerIsOPRequest
-	(*GetPlayerOnlineRequest)(nil),              // 33: fateark.proto.playerkit.GetPlayerOnlineRequest
-	(*SendPlayerChatRequest)(nil),               // 34: fateark.proto.playerkit.SendPlayerChatRequest
-	(*SendPlayerRawChatRequest)(nil),            // 35: fateark.proto.playerkit.SendPlayerRawChatRequest
-	(*SendPlayerTitleRequest)(nil),              // 36: fateark.proto.playerkit.SendPlayerTitleRequest
-	(*SendPlayerActionBarRequest)(nil),          // 37: fateark.proto.playerkit.SendPlayerActionBarRequest
-	(*InterceptPlayerJustNextInputRequest)(nil), // 38: fateark.proto.playerkit.InterceptPlayerJustNextInputRequest
-	(*response.GeneralResponse)(nil),            // 39: fateark.proto.response.GeneralResponse
-	(*response.GeneralInt64Response)(nil),       // 40: fateark.proto.response.GeneralInt64Response
-	(*response.GeneralInt32Response)(nil),       // 41: fateark.proto.response.GeneralInt32Response
-	(*response.GeneralBoolResponse)(nil),        // 42: fateark.proto.response.GeneralBoolResponse
-	(*response.GeneralUint64Response)(nil),      // 43: fateark.proto.response.GeneralUint64Response
+func (x *WaitPlayerInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_proto_playerkit_proto_depIdxs = []int32{
-	0,  // 0: fateark.proto.playerkit.PlayerKitService.GetAllOnlinePlayers:input_type -> fateark.proto.playerkit.GetAllOnlinePlayersRequest
-	1,  // 1: fateark.proto.playerkit.PlayerKitService.GetPlayerByName:input_type -> fateark.proto.playerkit.GetPlayerByNameRequest
-	2,  // 2: fateark.proto.playerkit.PlayerKitService.GetPlayerByUUID:input_type -> fateark.proto.playerkit.GetPlayerByUUIDRequest
-	3,  // 3: fateark.proto.playerkit.PlayerKitService.ReleaseBindPlayer:input_type -> fateark.proto.playerkit.ReleaseBindPlayerRequest
-	4,  // 4: fateark.proto.playerkit.PlayerKitService.GetPlayerName:input_type -> fateark.proto.playerkit.GetPlayerNameRequest
-	5,  // 5: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityUniqueID:input_type -> fateark.proto.playerkit.GetPlayerEntityUniqueIDRequest
-	6,  // 6: fateark.proto.playerkit.PlayerKitService.GetPlayerLoginTime:input_type -> fateark.proto.playerkit.GetPlayerLoginTimeRequest
-	7,  // 7: fateark.proto.playerkit.PlayerKitService.GetPlayerPlatformChatID:input_type -> fateark.proto.playerkit.GetPlayerPlatformChatIDRequest
-	8,  // 8: fateark.proto.playerkit.PlayerKitService.GetPlayerBuildPlatform:input_type -> fateark.proto.playerkit.GetPlayerBuildPlatformRequest
-	9,  // 9: fateark.proto.playerkit.PlayerKitService.GetPlayerSkinID:input_type -> fateark.proto.playerkit.GetPlayerSkinIDRequest
-	10, // 10: fateark.proto.playerkit.PlayerKitService.GetPlayerCanBuild:input_type -> fateark.proto.playerkit.GetPlayerCanBuildRequest
-	11, // 11: fateark.proto.playerkit.PlayerKitService.SetPlayerCanBuild:input_type -> fateark.proto.playerkit.SetPlayerCanBuildRequest
-	12, // 12: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDig:input_type -> fateark.proto.playerkit.GetPlayerCanDigRequest
-	13, // 13: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDig:input_type -> fateark.proto.playerkit.SetPlayerCanDigRequest
-	14, // 14: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDoorsAndSwitches:input_type -> fateark.proto.playerkit.GetPlayerCanDoorsAndSwitchesRequest
-	15, // 15: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDoorsAndSwitches:input_type -> fateark.proto.playerkit.SetPlayerCanDoorsAndSwitchesRequest
-	16, // 16: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOpenContainers:input_type -> fateark.proto.playerkit.GetPlayerCanOpenContainersRequest
-	17, // 17: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOpenContainers:input_type -> fateark.proto.playerkit.SetPlayerCanOpenContainersRequest
-	18, // 18: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackPlayers:input_type -> fateark.proto.playerkit.GetPlayerCanAttackPlayersRequest
-	19, // 19: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackPlayers:input_type -> fateark.proto.playerkit.SetPlayerCanAttackPlayersRequest
-	20, // 20: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackMobs:input_type -> fateark.proto.playerkit.GetPlayerCanAttackMobsRequest
-	21, // 21: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackMobs:input_type -> fateark.proto.playerkit.SetPlayerCanAttackMobsRequest
-	22, // 22: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOperatorCommands:input_type -> fateark.proto.playerkit.GetPlayerCanOperatorCommandsRequest
-	23, // 23: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOperatorCommands:input_type -> fateark.proto.playerkit.SetPlayerCanOperatorCommandsRequest
-	24, // 24: fateark.proto.playerkit.PlayerKitService.GetPlayerCanTeleport:input_type -> fateark.proto.playerkit.GetPlayerCanTeleportRequest
-	25, // 25: fateark.proto.playerkit.PlayerKitService.SetPlayerCanTeleport:input_type -> fateark.proto.playerkit.SetPlayerCanTeleportRequest
-	26, // 26: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusInvulnerable:input_type -> fateark.proto.playerkit.GetPlayerStatusInvulnerableRequest
-	27, // 27: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusFlying:input_type -> fateark.proto.playerkit.GetPlayerStatusFlyingRequest
-	28, // 28: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusMayFly:input_type -> fateark.proto.playerkit.GetPlayerStatusMayFlyRequest
-	29, // 29: fateark.proto.playerkit.PlayerKitService.GetPlayerDeviceID:input_type -> fateark.proto.playerkit.GetPlayerDeviceIDRequest
-	30, // 30: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityRuntimeID:input_type -> fateark.proto.playerkit.GetPlayerEntityRuntimeIDRequest
-	31, // 31: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityMetadata:input_type -> fateark.proto.playerkit.GetPlayerEntityMetadataRequest
-	32, // 32: fateark.proto.playerkit.PlayerKitService.GetPlayerIsOP:input_type -> fateark.proto.playerkit.GetPlayerIsOPRequest
-	33, // 33: fateark.proto.playerkit.PlayerKitService.GetPlayerOnline:input_type -> fateark.proto.playerkit.GetPlayerOnlineRequest
-	34, // 34: fateark.proto.playerkit.PlayerKitService.SendPlayerChat:input_type -> fateark.proto.playerkit.SendPlayerChatRequest
-	35, // 35: fateark.proto.playerkit.PlayerKitService.SendPlayerRawChat:input_type -> fateark.proto.playerkit.SendPlayerRawChatRequest
-	36, // 36: fateark.proto.playerkit.PlayerKitService.SendPlayerTitle:input_type -> fateark.proto.playerkit.SendPlayerTitleRequest
-	37, // 37: fateark.proto.playerkit.PlayerKitService.SendPlayerActionBar:input_type -> fateark.proto.playerkit.SendPlayerActionBarRequest
-	38, // 38: fateark.proto.playerkit.PlayerKitService.InterceptPlayerJustNextInput:input_type -> fateark.proto.playerkit.InterceptPlayerJustNextInputRequest
-	39, // 39: fateark.proto.playerkit.PlayerKitService.GetAllOnlinePlayers:output_type -> fateark.proto.response.GeneralResponse
-	39, // 40: fateark.proto.playerkit.PlayerKitService.GetPlayerByName:output_type -> fateark.proto.response.GeneralResponse
-	39, // 41: fateark.proto.playerkit.PlayerKitService.GetPlayerByUUID:output_type -> fateark.proto.response.GeneralResponse
-	39, // 42: fateark.proto.playerkit.PlayerKitService.ReleaseBindPlayer:output_type -> fateark.proto.response.GeneralResponse
-	39, // 43: fateark.proto.playerkit.PlayerKitService.GetPlayerName:output_type -> fateark.proto.response.GeneralResponse
-	40, // 44: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityUniqueID:output_type -> fateark.proto.response.GeneralInt64Response
-	40, // 45: fateark.proto.playerkit.PlayerKitService.GetPlayerLoginTime:output_type -> fateark.proto.response.GeneralInt64Response
-	39, // 46: fateark.proto.playerkit.PlayerKitService.GetPlayerPlatformChatID:output_type -> fateark.proto.response.GeneralResponse
-	41, // 47: fateark.proto.playerkit.PlayerKitService.GetPlayerBuildPlatform:output_type -> fateark.proto.response.GeneralInt32Response
-	39, // 48: fateark.proto.playerkit.PlayerKitService.GetPlayerSkinID:output_type -> fateark.proto.response.GeneralResponse
-	42, // 49: fateark.proto.playerkit.PlayerKitService.GetPlayerCanBuild:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 50: fateark.proto.playerkit.PlayerKitService.SetPlayerCanBuild:output_type -> fateark.proto.response.GeneralResponse
-	42, // 51: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDig:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 52: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDig:output_type -> fateark.proto.response.GeneralResponse
-	42, // 53: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDoorsAndSwitches:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 54: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDoorsAndSwitches:output_type -> fateark.proto.response.GeneralResponse
-	42, // 55: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOpenContainers:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 56: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOpenContainers:output_type -> fateark.proto.response.GeneralResponse
-	42, // 57: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackPlayers:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 58: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackPlayers:output_type -> fateark.proto.response.GeneralResponse
-	42, // 59: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackMobs:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 60: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackMobs:output_type -> fateark.proto.response.GeneralResponse
-	42, // 61: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOperatorCommands:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 62: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOperatorCommands:output_type -> fateark.proto.response.GeneralResponse
-	42, // 63: fateark.proto.playerkit.PlayerKitService.GetPlayerCanTeleport:output_type -> fateark.proto.response.GeneralBoolResponse
-	42, // 64: fateark.proto.playerkit.PlayerKitService.SetPlayerCanTeleport:output_type -> fateark.proto.response.GeneralBoolResponse
-	42, // 65: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusInvulnerable:output_type -> fateark.proto.response.GeneralBoolResponse
-	42, // 66: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusFlying:output_type -> fateark.proto.response.GeneralBoolResponse
-	42, // 67: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusMayFly:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 68: fateark.proto.playerkit.PlayerKitService.GetPlayerDeviceID:output_type -> fateark.proto.response.GeneralResponse
-	43, // 69: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityRuntimeID:output_type -> fateark.proto.response.GeneralUint64Response
-	39, // 70: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityMetadata:output_type -> fateark.proto.response.GeneralResponse
-	42, // 71: fateark.proto.playerkit.PlayerKitService.GetPlayerIsOP:output_type -> fateark.proto.response.GeneralBoolResponse
-	42, // 72: fateark.proto.playerkit.PlayerKitService.GetPlayerOnline:output_type -> fateark.proto.response.GeneralBoolResponse
-	39, // 73: fateark.proto.playerkit.PlayerKitService.SendPlayerChat:output_type -> fateark.proto.response.GeneralResponse
-	39, // 74: fateark.proto.playerkit.PlayerKitService.SendPlayerRawChat:output_type -> fateark.proto.response.GeneralResponse
-	39, // 75: fateark.proto.playerkit.PlayerKitService.SendPlayerTitle:output_type -> fateark.proto.response.GeneralResponse
-	39, // 76: fateark.proto.playerkit.PlayerKitService.SendPlayerActionBar:output_type -> fateark.proto.response.GeneralResponse
-	39, // 77: fateark.proto.playerkit.PlayerKitService.InterceptPlayerJustNextInput:output_type -> fateark.proto.response.GeneralResponse
-	39, // [39:78] is the sub-list for method output_type
-	0,  // [0:39] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+func (*WaitPlayerInputRequest) ProtoMessage() {}
+
+func (x *WaitPlayerInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_proto_playerkit_proto_init() }
-func file_proto_playerkit_proto_init() {
-	if File_proto_playerkit_proto != nil {
-		return
+// Deprecated: Use WaitPlayerInputRequest.ProtoReflect.Descriptor instead.
+func (*WaitPlayerInputRequest) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WaitPlayerInputRequest) GetRetrieverId() string {
+	if x != nil {
+		return x.RetrieverId
+	}
+	return ""
+}
+
+type PlayerInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetrieverId   string                 `protobuf:"bytes,1,opt,name=retriever_id,json=retrieverId,proto3" json:"retriever_id,omitempty"`
+	UuidStr       string                 `protobuf:"bytes,2,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CapturedAtMs  int64                  `protobuf:"varint,5,opt,name=captured_at_ms,json=capturedAtMs,proto3" json:"captured_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerInput) Reset() {
+	*x = PlayerInput{}
+	mi := &file_proto_playerkit_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInput) ProtoMessage() {}
+
+func (x *PlayerInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerInput.ProtoReflect.Descriptor instead.
+func (*PlayerInput) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PlayerInput) GetRetrieverId() string {
+	if x != nil {
+		return x.RetrieverId
+	}
+	return ""
+}
+
+func (x *PlayerInput) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+func (x *PlayerInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerInput) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlayerInput) GetCapturedAtMs() int64 {
+	if x != nil {
+		return x.CapturedAtMs
+	}
+	return 0
+}
+
+type CancelPlayerInputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetrieverId   string                 `protobuf:"bytes,1,opt,name=retriever_id,json=retrieverId,proto3" json:"retriever_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPlayerInputRequest) Reset() {
+	*x = CancelPlayerInputRequest{}
+	mi := &file_proto_playerkit_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPlayerInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPlayerInputRequest) ProtoMessage() {}
+
+func (x *CancelPlayerInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPlayerInputRequest.ProtoReflect.Descriptor instead.
+func (*CancelPlayerInputRequest) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelPlayerInputRequest) GetRetrieverId() string {
+	if x != nil {
+		return x.RetrieverId
+	}
+	return ""
+}
+
+type PlayerAbilities struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Build             bool                   `protobuf:"varint,1,opt,name=build,proto3" json:"build,omitempty"`
+	Mine              bool                   `protobuf:"varint,2,opt,name=mine,proto3" json:"mine,omitempty"`
+	DoorsAndSwitches  bool                   `protobuf:"varint,3,opt,name=doors_and_switches,json=doorsAndSwitches,proto3" json:"doors_and_switches,omitempty"`
+	OpenContainers    bool                   `protobuf:"varint,4,opt,name=open_containers,json=openContainers,proto3" json:"open_containers,omitempty"`
+	AttackPlayers     bool                   `protobuf:"varint,5,opt,name=attack_players,json=attackPlayers,proto3" json:"attack_players,omitempty"`
+	AttackMobs        bool                   `protobuf:"varint,6,opt,name=attack_mobs,json=attackMobs,proto3" json:"attack_mobs,omitempty"`
+	OperatorCommands  bool                   `protobuf:"varint,7,opt,name=operator_commands,json=operatorCommands,proto3" json:"operator_commands,omitempty"`
+	Teleport          bool                   `protobuf:"varint,8,opt,name=teleport,proto3" json:"teleport,omitempty"`
+	Invulnerable      bool                   `protobuf:"varint,9,opt,name=invulnerable,proto3" json:"invulnerable,omitempty"`
+	Flying            bool                   `protobuf:"varint,10,opt,name=flying,proto3" json:"flying,omitempty"`
+	MayFly            bool                   `protobuf:"varint,11,opt,name=may_fly,json=mayFly,proto3" json:"may_fly,omitempty"`
+	InstantBuild      bool                   `protobuf:"varint,12,opt,name=instant_build,json=instantBuild,proto3" json:"instant_build,omitempty"`
+	Lightning         bool                   `protobuf:"varint,13,opt,name=lightning,proto3" json:"lightning,omitempty"`
+	Muted             bool                   `protobuf:"varint,14,opt,name=muted,proto3" json:"muted,omitempty"`
+	WorldBuilder      bool                   `protobuf:"varint,15,opt,name=world_builder,json=worldBuilder,proto3" json:"world_builder,omitempty"`
+	NoClip            bool                   `protobuf:"varint,16,opt,name=no_clip,json=noClip,proto3" json:"no_clip,omitempty"`
+	PrivilegedBuilder bool                   `protobuf:"varint,17,opt,name=privileged_builder,json=privilegedBuilder,proto3" json:"privileged_builder,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlayerAbilities) Reset() {
+	*x = PlayerAbilities{}
+	mi := &file_proto_playerkit_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerAbilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAbilities) ProtoMessage() {}
+
+func (x *PlayerAbilities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAbilities.ProtoReflect.Descriptor instead.
+func (*PlayerAbilities) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PlayerAbilities) GetBuild() bool {
+	if x != nil {
+		return x.Build
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetMine() bool {
+	if x != nil {
+		return x.Mine
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetDoorsAndSwitches() bool {
+	if x != nil {
+		return x.DoorsAndSwitches
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetOpenContainers() bool {
+	if x != nil {
+		return x.OpenContainers
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetAttackPlayers() bool {
+	if x != nil {
+		return x.AttackPlayers
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetAttackMobs() bool {
+	if x != nil {
+		return x.AttackMobs
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetOperatorCommands() bool {
+	if x != nil {
+		return x.OperatorCommands
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetTeleport() bool {
+	if x != nil {
+		return x.Teleport
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetInvulnerable() bool {
+	if x != nil {
+		return x.Invulnerable
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetFlying() bool {
+	if x != nil {
+		return x.Flying
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetMayFly() bool {
+	if x != nil {
+		return x.MayFly
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetInstantBuild() bool {
+	if x != nil {
+		return x.InstantBuild
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetLightning() bool {
+	if x != nil {
+		return x.Lightning
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetWorldBuilder() bool {
+	if x != nil {
+		return x.WorldBuilder
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetNoClip() bool {
+	if x != nil {
+		return x.NoClip
+	}
+	return false
+}
+
+func (x *PlayerAbilities) GetPrivilegedBuilder() bool {
+	if x != nil {
+		return x.PrivilegedBuilder
+	}
+	return false
+}
+
+type PlayerMetadata struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	NameTag      string                 `protobuf:"bytes,1,opt,name=name_tag,json=nameTag,proto3" json:"name_tag,omitempty"`
+	ScoreTag     string                 `protobuf:"bytes,2,opt,name=score_tag,json=scoreTag,proto3" json:"score_tag,omitempty"`
+	Variant      int32                  `protobuf:"varint,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Scale        float32                `protobuf:"fixed32,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	Width        float32                `protobuf:"fixed32,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height       float32                `protobuf:"fixed32,6,opt,name=height,proto3" json:"height,omitempty"`
+	AirSupply    int32                  `protobuf:"varint,7,opt,name=air_supply,json=airSupply,proto3" json:"air_supply,omitempty"`
+	MaxAirSupply int32                  `protobuf:"varint,8,opt,name=max_air_supply,json=maxAirSupply,proto3" json:"max_air_supply,omitempty"`
+	OnFire       bool                   `protobuf:"varint,9,opt,name=on_fire,json=onFire,proto3" json:"on_fire,omitempty"`
+	Sneaking     bool                   `protobuf:"varint,10,opt,name=sneaking,proto3" json:"sneaking,omitempty"`
+	Riding       bool                   `protobuf:"varint,11,opt,name=riding,proto3" json:"riding,omitempty"`
+	Sprinting    bool                   `protobuf:"varint,12,opt,name=sprinting,proto3" json:"sprinting,omitempty"`
+	UsingItem    bool                   `protobuf:"varint,13,opt,name=using_item,json=usingItem,proto3" json:"using_item,omitempty"`
+	Invisible    bool                   `protobuf:"varint,14,opt,name=invisible,proto3" json:"invisible,omitempty"`
+	Immobile     bool                   `protobuf:"varint,15,opt,name=immobile,proto3" json:"immobile,omitempty"`
+	Gliding      bool                   `protobuf:"varint,16,opt,name=gliding,proto3" json:"gliding,omitempty"`
+	Swimming     bool                   `protobuf:"varint,17,opt,name=swimming,proto3" json:"swimming,omitempty"`
+	// raw_json is the complete metadata map keyed by data key. It is empty
+	// when the map holds a value that cannot be encoded as JSON.
+	RawJson       string `protobuf:"bytes,18,opt,name=raw_json,json=rawJson,proto3" json:"raw_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerMetadata) Reset() {
+	*x = PlayerMetadata{}
+	mi := &file_proto_playerkit_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerMetadata) ProtoMessage() {}
+
+func (x *PlayerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerMetadata.ProtoReflect.Descriptor instead.
+func (*PlayerMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PlayerMetadata) GetNameTag() string {
+	if x != nil {
+		return x.NameTag
+	}
+	return ""
+}
+
+func (x *PlayerMetadata) GetScoreTag() string {
+	if x != nil {
+		return x.ScoreTag
+	}
+	return ""
+}
+
+func (x *PlayerMetadata) GetVariant() int32 {
+	if x != nil {
+		return x.Variant
+	}
+	return 0
+}
+
+func (x *PlayerMetadata) GetScale() float32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *PlayerMetadata) GetWidth() float32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PlayerMetadata) GetHeight() float32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PlayerMetadata) GetAirSupply() int32 {
+	if x != nil {
+		return x.AirSupply
+	}
+	return 0
+}
+
+func (x *PlayerMetadata) GetMaxAirSupply() int32 {
+	if x != nil {
+		return x.MaxAirSupply
+	}
+	return 0
+}
+
+func (x *PlayerMetadata) GetOnFire() bool {
+	if x != nil {
+		return x.OnFire
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetSneaking() bool {
+	if x != nil {
+		return x.Sneaking
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetRiding() bool {
+	if x != nil {
+		return x.Riding
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetSprinting() bool {
+	if x != nil {
+		return x.Sprinting
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetUsingItem() bool {
+	if x != nil {
+		return x.UsingItem
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetInvisible() bool {
+	if x != nil {
+		return x.Invisible
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetImmobile() bool {
+	if x != nil {
+		return x.Immobile
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetGliding() bool {
+	if x != nil {
+		return x.Gliding
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetSwimming() bool {
+	if x != nil {
+		return x.Swimming
+	}
+	return false
+}
+
+func (x *PlayerMetadata) GetRawJson() string {
+	if x != nil {
+		return x.RawJson
+	}
+	return ""
+}
+
+type PlayerProfile struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UuidStr         string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EntityUniqueId  int64                  `protobuf:"varint,3,opt,name=entity_unique_id,json=entityUniqueId,proto3" json:"entity_unique_id,omitempty"`
+	EntityRuntimeId uint64                 `protobuf:"varint,4,opt,name=entity_runtime_id,json=entityRuntimeId,proto3" json:"entity_runtime_id,omitempty"`
+	// login_time is a Unix timestamp in seconds.
+	LoginTime              int64            `protobuf:"varint,5,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+	PlatformChatId         string           `protobuf:"bytes,6,opt,name=platform_chat_id,json=platformChatId,proto3" json:"platform_chat_id,omitempty"`
+	BuildPlatform          int32            `protobuf:"varint,7,opt,name=build_platform,json=buildPlatform,proto3" json:"build_platform,omitempty"`
+	SkinId                 string           `protobuf:"bytes,8,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	DeviceId               string           `protobuf:"bytes,9,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	CommandPermissionLevel uint32           `protobuf:"varint,10,opt,name=command_permission_level,json=commandPermissionLevel,proto3" json:"command_permission_level,omitempty"`
+	IsOp                   bool             `protobuf:"varint,11,opt,name=is_op,json=isOp,proto3" json:"is_op,omitempty"`
+	Online                 bool             `protobuf:"varint,12,opt,name=online,proto3" json:"online,omitempty"`
+	AbilityValues          uint32           `protobuf:"varint,13,opt,name=ability_values,json=abilityValues,proto3" json:"ability_values,omitempty"`
+	Abilities              *PlayerAbilities `protobuf:"bytes,14,opt,name=abilities,proto3" json:"abilities,omitempty"`
+	Metadata               *PlayerMetadata  `protobuf:"bytes,15,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PlayerProfile) Reset() {
+	*x = PlayerProfile{}
+	mi := &file_proto_playerkit_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProfile) ProtoMessage() {}
+
+func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProfile.ProtoReflect.Descriptor instead.
+func (*PlayerProfile) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PlayerProfile) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetEntityUniqueId() int64 {
+	if x != nil {
+		return x.EntityUniqueId
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetEntityRuntimeId() uint64 {
+	if x != nil {
+		return x.EntityRuntimeId
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetLoginTime() int64 {
+	if x != nil {
+		return x.LoginTime
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetPlatformChatId() string {
+	if x != nil {
+		return x.PlatformChatId
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetBuildPlatform() int32 {
+	if x != nil {
+		return x.BuildPlatform
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetCommandPermissionLevel() uint32 {
+	if x != nil {
+		return x.CommandPermissionLevel
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetIsOp() bool {
+	if x != nil {
+		return x.IsOp
+	}
+	return false
+}
+
+func (x *PlayerProfile) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *PlayerProfile) GetAbilityValues() uint32 {
+	if x != nil {
+		return x.AbilityValues
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetAbilities() *PlayerAbilities {
+	if x != nil {
+		return x.Abilities
+	}
+	return nil
+}
+
+func (x *PlayerProfile) GetMetadata() *PlayerMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GetPlayerProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UuidStr       string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerProfileRequest) Reset() {
+	*x = GetPlayerProfileRequest{}
+	mi := &file_proto_playerkit_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerProfileRequest) ProtoMessage() {}
+
+func (x *GetPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetPlayerProfileRequest) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+type GetPlayerProfilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An empty list returns every online player.
+	UuidStrs      []string `protobuf:"bytes,1,rep,name=uuid_strs,json=uuidStrs,proto3" json:"uuid_strs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerProfilesRequest) Reset() {
+	*x = GetPlayerProfilesRequest{}
+	mi := &file_proto_playerkit_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerProfilesRequest) ProtoMessage() {}
+
+func (x *GetPlayerProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerProfilesRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPlayerProfilesRequest) GetUuidStrs() []string {
+	if x != nil {
+		return x.UuidStrs
+	}
+	return nil
+}
+
+type GetPlayerProfilesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Profiles []*PlayerProfile       `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	// missing lists requested UUIDs with no known player.
+	Missing       []string `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerProfilesResponse) Reset() {
+	*x = GetPlayerProfilesResponse{}
+	mi := &file_proto_playerkit_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerProfilesResponse) ProtoMessage() {}
+
+func (x *GetPlayerProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerProfilesResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetPlayerProfilesResponse) GetProfiles() []*PlayerProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *GetPlayerProfilesResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+// AbilityChanges lists the permission abilities to change. Unset fields keep
+// the player's current value.
+type AbilityChanges struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Build            *bool                  `protobuf:"varint,1,opt,name=build,proto3,oneof" json:"build,omitempty"`
+	Mine             *bool                  `protobuf:"varint,2,opt,name=mine,proto3,oneof" json:"mine,omitempty"`
+	DoorsAndSwitches *bool                  `protobuf:"varint,3,opt,name=doors_and_switches,json=doorsAndSwitches,proto3,oneof" json:"doors_and_switches,omitempty"`
+	OpenContainers   *bool                  `protobuf:"varint,4,opt,name=open_containers,json=openContainers,proto3,oneof" json:"open_containers,omitempty"`
+	AttackPlayers    *bool                  `protobuf:"varint,5,opt,name=attack_players,json=attackPlayers,proto3,oneof" json:"attack_players,omitempty"`
+	AttackMobs       *bool                  `protobuf:"varint,6,opt,name=attack_mobs,json=attackMobs,proto3,oneof" json:"attack_mobs,omitempty"`
+	OperatorCommands *bool                  `protobuf:"varint,7,opt,name=operator_commands,json=operatorCommands,proto3,oneof" json:"operator_commands,omitempty"`
+	Teleport         *bool                  `protobuf:"varint,8,opt,name=teleport,proto3,oneof" json:"teleport,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AbilityChanges) Reset() {
+	*x = AbilityChanges{}
+	mi := &file_proto_playerkit_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbilityChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbilityChanges) ProtoMessage() {}
+
+func (x *AbilityChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbilityChanges.ProtoReflect.Descriptor instead.
+func (*AbilityChanges) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AbilityChanges) GetBuild() bool {
+	if x != nil && x.Build != nil {
+		return *x.Build
+	}
+	return false
+}
+
+func (x *AbilityChanges) GetMine() bool {
+	if x != nil && x.Mine != nil {
+		return *x.Mine
+	}
+	return false
+}
+
+func (x *AbilityChanges) GetDoorsAndSwitches() bool {
+	if x != nil && x.DoorsAndSwitches != nil {
+		return *x.DoorsAndSwitches
+	}
+	return false
+}
+
+func (x *AbilityChanges) GetOpenContainers() bool {
+	if x != nil && x.OpenContainers != nil {
+		return *x.OpenContainers
+	}
+	return false
+}
+
+func (x *AbilityChanges) GetAttackPlayers() bool {
+	if x != nil && x.AttackPlayers != nil {
+		return *x.AttackPlayers
+	}
+	return false
+}
+
+func (x *AbilityChanges) GetAttackMobs() bool {
+	if x != nil && x.AttackMobs != nil {
+		return *x.AttackMobs
+	}
+	return false
+}
+
+func (x *AbilityChanges) GetOperatorCommands() bool {
+	if x != nil && x.OperatorCommands != nil {
+		return *x.OperatorCommands
+	}
+	return false
+}
+
+func (x *AbilityChanges) GetTeleport() bool {
+	if x != nil && x.Teleport != nil {
+		return *x.Teleport
+	}
+	return false
+}
+
+type SetPlayerAbilitiesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UuidStr   string                 `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	Abilities *AbilityChanges        `protobuf:"bytes,2,opt,name=abilities,proto3" json:"abilities,omitempty"`
+	// timeout_ms bounds the wait for the server's UpdateAbilities; 0 uses 5s.
+	TimeoutMs     uint32 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerAbilitiesRequest) Reset() {
+	*x = SetPlayerAbilitiesRequest{}
+	mi := &file_proto_playerkit_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerAbilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerAbilitiesRequest) ProtoMessage() {}
+
+func (x *SetPlayerAbilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerAbilitiesRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerAbilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetPlayerAbilitiesRequest) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+func (x *SetPlayerAbilitiesRequest) GetAbilities() *AbilityChanges {
+	if x != nil {
+		return x.Abilities
+	}
+	return nil
+}
+
+func (x *SetPlayerAbilitiesRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type SetPlayerAbilitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// confirmed is set when the server reported exactly the requested set.
+	// Otherwise the values are those of the last update seen before the
+	// timeout.
+	Confirmed       bool             `protobuf:"varint,1,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	RequestedValues uint32           `protobuf:"varint,2,opt,name=requested_values,json=requestedValues,proto3" json:"requested_values,omitempty"`
+	AbilityValues   uint32           `protobuf:"varint,3,opt,name=ability_values,json=abilityValues,proto3" json:"ability_values,omitempty"`
+	PermissionLevel uint32           `protobuf:"varint,4,opt,name=permission_level,json=permissionLevel,proto3" json:"permission_level,omitempty"`
+	Abilities       *PlayerAbilities `protobuf:"bytes,5,opt,name=abilities,proto3" json:"abilities,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetPlayerAbilitiesResponse) Reset() {
+	*x = SetPlayerAbilitiesResponse{}
+	mi := &file_proto_playerkit_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerAbilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerAbilitiesResponse) ProtoMessage() {}
+
+func (x *SetPlayerAbilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerAbilitiesResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerAbilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetPlayerAbilitiesResponse) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *SetPlayerAbilitiesResponse) GetRequestedValues() uint32 {
+	if x != nil {
+		return x.RequestedValues
+	}
+	return 0
+}
+
+func (x *SetPlayerAbilitiesResponse) GetAbilityValues() uint32 {
+	if x != nil {
+		return x.AbilityValues
+	}
+	return 0
+}
+
+func (x *SetPlayerAbilitiesResponse) GetPermissionLevel() uint32 {
+	if x != nil {
+		return x.PermissionLevel
+	}
+	return 0
+}
+
+func (x *SetPlayerAbilitiesResponse) GetAbilities() *PlayerAbilities {
+	if x != nil {
+		return x.Abilities
+	}
+	return nil
+}
+
+type PromptNumberRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Integer       bool                   `protobuf:"varint,3,opt,name=integer,proto3" json:"integer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptNumberRange) Reset() {
+	*x = PromptNumberRange{}
+	mi := &file_proto_playerkit_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptNumberRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptNumberRange) ProtoMessage() {}
+
+func (x *PromptNumberRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptNumberRange.ProtoReflect.Descriptor instead.
+func (*PromptNumberRange) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PromptNumberRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *PromptNumberRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *PromptNumberRange) GetInteger() bool {
+	if x != nil {
+		return x.Integer
+	}
+	return false
+}
+
+type PromptChoices struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Answers match a choice or its 1-based position in the list.
+	Choices       []string `protobuf:"bytes,1,rep,name=choices,proto3" json:"choices,omitempty"`
+	CaseSensitive bool     `protobuf:"varint,2,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptChoices) Reset() {
+	*x = PromptChoices{}
+	mi := &file_proto_playerkit_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptChoices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptChoices) ProtoMessage() {}
+
+func (x *PromptChoices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptChoices.ProtoReflect.Descriptor instead.
+func (*PromptChoices) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PromptChoices) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *PromptChoices) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+type PlayerPromptRequest struct {
+	state    protoimpl.MessageState      `protogen:"open.v1"`
+	UuidStr  string                      `protobuf:"bytes,1,opt,name=uuid_str,json=uuidStr,proto3" json:"uuid_str,omitempty"`
+	Question string                      `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Channel  PlayerPromptRequest_Channel `protobuf:"varint,3,opt,name=channel,proto3,enum=fateark.proto.playerkit.PlayerPromptRequest_Channel" json:"channel,omitempty"`
+	// Types that are valid to be assigned to Validation:
+	//
+	//	*PlayerPromptRequest_Regex
+	//	*PlayerPromptRequest_Number
+	//	*PlayerPromptRequest_Choices
+	Validation isPlayerPromptRequest_Validation `protobuf_oneof:"validation"`
+	// max_attempts of zero allows three answers.
+	MaxAttempts uint32 `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// timeout_ms bounds the whole conversation; zero waits for one minute.
+	TimeoutMs uint32 `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// invalid_message is sent before re-prompting; "%s" is replaced by the
+	// reason the answer was rejected.
+	InvalidMessage string `protobuf:"bytes,9,opt,name=invalid_message,json=invalidMessage,proto3" json:"invalid_message,omitempty"`
+	Suppress       bool   `protobuf:"varint,10,opt,name=suppress,proto3" json:"suppress,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayerPromptRequest) Reset() {
+	*x = PlayerPromptRequest{}
+	mi := &file_proto_playerkit_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPromptRequest) ProtoMessage() {}
+
+func (x *PlayerPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPromptRequest.ProtoReflect.Descriptor instead.
+func (*PlayerPromptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PlayerPromptRequest) GetUuidStr() string {
+	if x != nil {
+		return x.UuidStr
+	}
+	return ""
+}
+
+func (x *PlayerPromptRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *PlayerPromptRequest) GetChannel() PlayerPromptRequest_Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return PlayerPromptRequest_CHAT
+}
+
+func (x *PlayerPromptRequest) GetValidation() isPlayerPromptRequest_Validation {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+func (x *PlayerPromptRequest) GetRegex() string {
+	if x != nil {
+		if x, ok := x.Validation.(*PlayerPromptRequest_Regex); ok {
+			return x.Regex
+		}
+	}
+	return ""
+}
+
+func (x *PlayerPromptRequest) GetNumber() *PromptNumberRange {
+	if x != nil {
+		if x, ok := x.Validation.(*PlayerPromptRequest_Number); ok {
+			return x.Number
+		}
+	}
+	return nil
+}
+
+func (x *PlayerPromptRequest) GetChoices() *PromptChoices {
+	if x != nil {
+		if x, ok := x.Validation.(*PlayerPromptRequest_Choices); ok {
+			return x.Choices
+		}
+	}
+	return nil
+}
+
+func (x *PlayerPromptRequest) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *PlayerPromptRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *PlayerPromptRequest) GetInvalidMessage() string {
+	if x != nil {
+		return x.InvalidMessage
+	}
+	return ""
+}
+
+func (x *PlayerPromptRequest) GetSuppress() bool {
+	if x != nil {
+		return x.Suppress
+	}
+	return false
+}
+
+type isPlayerPromptRequest_Validation interface {
+	isPlayerPromptRequest_Validation()
+}
+
+type PlayerPromptRequest_Regex struct {
+	// regex must match the whole answer.
+	Regex string `protobuf:"bytes,4,opt,name=regex,proto3,oneof"`
+}
+
+type PlayerPromptRequest_Number struct {
+	Number *PromptNumberRange `protobuf:"bytes,5,opt,name=number,proto3,oneof"`
+}
+
+type PlayerPromptRequest_Choices struct {
+	Choices *PromptChoices `protobuf:"bytes,6,opt,name=choices,proto3,oneof"`
+}
+
+func (*PlayerPromptRequest_Regex) isPlayerPromptRequest_Validation() {}
+
+func (*PlayerPromptRequest_Number) isPlayerPromptRequest_Validation() {}
+
+func (*PlayerPromptRequest_Choices) isPlayerPromptRequest_Validation() {}
+
+type PlayerPromptResponse struct {
+	state    protoimpl.MessageState      `protogen:"open.v1"`
+	Status   PlayerPromptResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=fateark.proto.playerkit.PlayerPromptResponse_Status" json:"status,omitempty"`
+	Answer   string                      `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	Attempts uint32                      `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Number   float64                     `protobuf:"fixed64,4,opt,name=number,proto3" json:"number,omitempty"`
+	// choice_index is the matched choice, or -1 without choice validation.
+	ChoiceIndex   int32 `protobuf:"varint,5,opt,name=choice_index,json=choiceIndex,proto3" json:"choice_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerPromptResponse) Reset() {
+	*x = PlayerPromptResponse{}
+	mi := &file_proto_playerkit_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPromptResponse) ProtoMessage() {}
+
+func (x *PlayerPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_playerkit_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPromptResponse.ProtoReflect.Descriptor instead.
+func (*PlayerPromptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_playerkit_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PlayerPromptResponse) GetStatus() PlayerPromptResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PlayerPromptResponse_ANSWERED
+}
+
+func (x *PlayerPromptResponse) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *PlayerPromptResponse) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PlayerPromptResponse) GetNumber() float64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *PlayerPromptResponse) GetChoiceIndex() int32 {
+	if x != nil {
+		return x.ChoiceIndex
+	}
+	return 0
+}
+
+var File_proto_playerkit_proto protoreflect.FileDescriptor
+
+const file_proto_playerkit_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/playerkit.proto\x12\x17fateark.proto.playerkit\x1a\x14proto/response.proto\"\x1c\n" +
+	"\x1aGetAllOnlinePlayersRequest\",\n" +
+	"\x16GetPlayerByNameRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\",\n" +
+	"\x16GetPlayerByUUIDRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"5\n" +
+	"\x18ReleaseBindPlayerRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"1\n" +
+	"\x14GetPlayerNameRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\";\n" +
+	"\x1eGetPlayerEntityUniqueIDRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"6\n" +
+	"\x19GetPlayerLoginTimeRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\";\n" +
+	"\x1eGetPlayerPlatformChatIDRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\":\n" +
+	"\x1dGetPlayerBuildPlatformRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"3\n" +
+	"\x16GetPlayerSkinIDRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"5\n" +
+	"\x18GetPlayerCanBuildRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"K\n" +
+	"\x18SetPlayerCanBuildRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\"3\n" +
+	"\x16GetPlayerCanDigRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"I\n" +
+	"\x16SetPlayerCanDigRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\"@\n" +
+	"#GetPlayerCanDoorsAndSwitchesRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"V\n" +
+	"#SetPlayerCanDoorsAndSwitchesRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\">\n" +
+	"!GetPlayerCanOpenContainersRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"T\n" +
+	"!SetPlayerCanOpenContainersRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\"=\n" +
+	" GetPlayerCanAttackPlayersRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"S\n" +
+	" SetPlayerCanAttackPlayersRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\":\n" +
+	"\x1dGetPlayerCanAttackMobsRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"P\n" +
+	"\x1dSetPlayerCanAttackMobsRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\"@\n" +
+	"#GetPlayerCanOperatorCommandsRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"V\n" +
+	"#SetPlayerCanOperatorCommandsRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\"8\n" +
+	"\x1bGetPlayerCanTeleportRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"N\n" +
+	"\x1bSetPlayerCanTeleportRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05allow\x18\x02 \x01(\bR\x05allow\"?\n" +
+	"\"GetPlayerStatusInvulnerableRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"9\n" +
+	"\x1cGetPlayerStatusFlyingRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"9\n" +
+	"\x1cGetPlayerStatusMayFlyRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"5\n" +
+	"\x18GetPlayerDeviceIDRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"<\n" +
+	"\x1fGetPlayerEntityRuntimeIDRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\";\n" +
+	"\x1eGetPlayerEntityMetadataRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"1\n" +
+	"\x14GetPlayerIsOPRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"3\n" +
+	"\x16GetPlayerOnlineRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"D\n" +
+	"\x15SendPlayerChatRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"G\n" +
+	"\x18SendPlayerRawChatRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"f\n" +
+	"\x16SendPlayerTitleRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1b\n" +
+	"\tsub_title\x18\x03 \x01(\tR\bsubTitle\"V\n" +
+	"\x1aSendPlayerActionBarRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x1d\n" +
+	"\n" +
+	"action_bar\x18\x02 \x01(\tR\tactionBar\"\x9e\x01\n" +
+	"#InterceptPlayerJustNextInputRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12!\n" +
+	"\fretriever_id\x18\x02 \x01(\tR\vretrieverId\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x03 \x01(\rR\ttimeoutMs\x12\x1a\n" +
+	"\bsuppress\x18\x04 \x01(\bR\bsuppress\";\n" +
+	"\x16WaitPlayerInputRequest\x12!\n" +
+	"\fretriever_id\x18\x01 \x01(\tR\vretrieverId\"\x9f\x01\n" +
+	"\vPlayerInput\x12!\n" +
+	"\fretriever_id\x18\x01 \x01(\tR\vretrieverId\x12\x19\n" +
+	"\buuid_str\x18\x02 \x01(\tR\auuidStr\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12$\n" +
+	"\x0ecaptured_at_ms\x18\x05 \x01(\x03R\fcapturedAtMs\"=\n" +
+	"\x18CancelPlayerInputRequest\x12!\n" +
+	"\fretriever_id\x18\x01 \x01(\tR\vretrieverId\"\xbe\x04\n" +
+	"\x0fPlayerAbilities\x12\x14\n" +
+	"\x05build\x18\x01 \x01(\bR\x05build\x12\x12\n" +
+	"\x04mine\x18\x02 \x01(\bR\x04mine\x12,\n" +
+	"\x12doors_and_switches\x18\x03 \x01(\bR\x10doorsAndSwitches\x12'\n" +
+	"\x0fopen_containers\x18\x04 \x01(\bR\x0eopenContainers\x12%\n" +
+	"\x0eattack_players\x18\x05 \x01(\bR\rattackPlayers\x12\x1f\n" +
+	"\vattack_mobs\x18\x06 \x01(\bR\n" +
+	"attackMobs\x12+\n" +
+	"\x11operator_commands\x18\a \x01(\bR\x10operatorCommands\x12\x1a\n" +
+	"\bteleport\x18\b \x01(\bR\bteleport\x12\"\n" +
+	"\finvulnerable\x18\t \x01(\bR\finvulnerable\x12\x16\n" +
+	"\x06flying\x18\n" +
+	" \x01(\bR\x06flying\x12\x17\n" +
+	"\amay_fly\x18\v \x01(\bR\x06mayFly\x12#\n" +
+	"\rinstant_build\x18\f \x01(\bR\finstantBuild\x12\x1c\n" +
+	"\tlightning\x18\r \x01(\bR\tlightning\x12\x14\n" +
+	"\x05muted\x18\x0e \x01(\bR\x05muted\x12#\n" +
+	"\rworld_builder\x18\x0f \x01(\bR\fworldBuilder\x12\x17\n" +
+	"\ano_clip\x18\x10 \x01(\bR\x06noClip\x12-\n" +
+	"\x12privileged_builder\x18\x11 \x01(\bR\x11privilegedBuilder\"\x80\x04\n" +
+	"\x0ePlayerMetadata\x12\x19\n" +
+	"\bname_tag\x18\x01 \x01(\tR\anameTag\x12\x1b\n" +
+	"\tscore_tag\x18\x02 \x01(\tR\bscoreTag\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\x05R\avariant\x12\x14\n" +
+	"\x05scale\x18\x04 \x01(\x02R\x05scale\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x02R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x02R\x06height\x12\x1d\n" +
+	"\n" +
+	"air_supply\x18\a \x01(\x05R\tairSupply\x12$\n" +
+	"\x0emax_air_supply\x18\b \x01(\x05R\fmaxAirSupply\x12\x17\n" +
+	"\aon_fire\x18\t \x01(\bR\x06onFire\x12\x1a\n" +
+	"\bsneaking\x18\n" +
+	" \x01(\bR\bsneaking\x12\x16\n" +
+	"\x06riding\x18\v \x01(\bR\x06riding\x12\x1c\n" +
+	"\tsprinting\x18\f \x01(\bR\tsprinting\x12\x1d\n" +
+	"\n" +
+	"using_item\x18\r \x01(\bR\tusingItem\x12\x1c\n" +
+	"\tinvisible\x18\x0e \x01(\bR\tinvisible\x12\x1a\n" +
+	"\bimmobile\x18\x0f \x01(\bR\bimmobile\x12\x18\n" +
+	"\agliding\x18\x10 \x01(\bR\agliding\x12\x1a\n" +
+	"\bswimming\x18\x11 \x01(\bR\bswimming\x12\x19\n" +
+	"\braw_json\x18\x12 \x01(\tR\arawJson\"\xd5\x04\n" +
+	"\rPlayerProfile\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x10entity_unique_id\x18\x03 \x01(\x03R\x0eentityUniqueId\x12*\n" +
+	"\x11entity_runtime_id\x18\x04 \x01(\x04R\x0fentityRuntimeId\x12\x1d\n" +
+	"\n" +
+	"login_time\x18\x05 \x01(\x03R\tloginTime\x12(\n" +
+	"\x10platform_chat_id\x18\x06 \x01(\tR\x0eplatformChatId\x12%\n" +
+	"\x0ebuild_platform\x18\a \x01(\x05R\rbuildPlatform\x12\x17\n" +
+	"\askin_id\x18\b \x01(\tR\x06skinId\x12\x1b\n" +
+	"\tdevice_id\x18\t \x01(\tR\bdeviceId\x128\n" +
+	"\x18command_permission_level\x18\n" +
+	" \x01(\rR\x16commandPermissionLevel\x12\x13\n" +
+	"\x05is_op\x18\v \x01(\bR\x04isOp\x12\x16\n" +
+	"\x06online\x18\f \x01(\bR\x06online\x12%\n" +
+	"\x0eability_values\x18\r \x01(\rR\rabilityValues\x12F\n" +
+	"\tabilities\x18\x0e \x01(\v2(.fateark.proto.playerkit.PlayerAbilitiesR\tabilities\x12C\n" +
+	"\bmetadata\x18\x0f \x01(\v2'.fateark.proto.playerkit.PlayerMetadataR\bmetadata\"4\n" +
+	"\x17GetPlayerProfileRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\"7\n" +
+	"\x18GetPlayerProfilesRequest\x12\x1b\n" +
+	"\tuuid_strs\x18\x01 \x03(\tR\buuidStrs\"y\n" +
+	"\x19GetPlayerProfilesResponse\x12B\n" +
+	"\bprofiles\x18\x01 \x03(\v2&.fateark.proto.playerkit.PlayerProfileR\bprofiles\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing\"\xce\x03\n" +
+	"\x0eAbilityChanges\x12\x19\n" +
+	"\x05build\x18\x01 \x01(\bH\x00R\x05build\x88\x01\x01\x12\x17\n" +
+	"\x04mine\x18\x02 \x01(\bH\x01R\x04mine\x88\x01\x01\x121\n" +
+	"\x12doors_and_switches\x18\x03 \x01(\bH\x02R\x10doorsAndSwitches\x88\x01\x01\x12,\n" +
+	"\x0fopen_containers\x18\x04 \x01(\bH\x03R\x0eopenContainers\x88\x01\x01\x12*\n" +
+	"\x0eattack_players\x18\x05 \x01(\bH\x04R\rattackPlayers\x88\x01\x01\x12$\n" +
+	"\vattack_mobs\x18\x06 \x01(\bH\x05R\n" +
+	"attackMobs\x88\x01\x01\x120\n" +
+	"\x11operator_commands\x18\a \x01(\bH\x06R\x10operatorCommands\x88\x01\x01\x12\x1f\n" +
+	"\bteleport\x18\b \x01(\bH\aR\bteleport\x88\x01\x01B\b\n" +
+	"\x06_buildB\a\n" +
+	"\x05_mineB\x15\n" +
+	"\x13_doors_and_switchesB\x12\n" +
+	"\x10_open_containersB\x11\n" +
+	"\x0f_attack_playersB\x0e\n" +
+	"\f_attack_mobsB\x14\n" +
+	"\x12_operator_commandsB\v\n" +
+	"\t_teleport\"\x9c\x01\n" +
+	"\x19SetPlayerAbilitiesRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12E\n" +
+	"\tabilities\x18\x02 \x01(\v2'.fateark.proto.playerkit.AbilityChangesR\tabilities\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x03 \x01(\rR\ttimeoutMs\"\xff\x01\n" +
+	"\x1aSetPlayerAbilitiesResponse\x12\x1c\n" +
+	"\tconfirmed\x18\x01 \x01(\bR\tconfirmed\x12)\n" +
+	"\x10requested_values\x18\x02 \x01(\rR\x0frequestedValues\x12%\n" +
+	"\x0eability_values\x18\x03 \x01(\rR\rabilityValues\x12)\n" +
+	"\x10permission_level\x18\x04 \x01(\rR\x0fpermissionLevel\x12F\n" +
+	"\tabilities\x18\x05 \x01(\v2(.fateark.proto.playerkit.PlayerAbilitiesR\tabilities\"k\n" +
+	"\x11PromptNumberRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x18\n" +
+	"\ainteger\x18\x03 \x01(\bR\aintegerB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"P\n" +
+	"\rPromptChoices\x12\x18\n" +
+	"\achoices\x18\x01 \x03(\tR\achoices\x12%\n" +
+	"\x0ecase_sensitive\x18\x02 \x01(\bR\rcaseSensitive\"\xf8\x03\n" +
+	"\x13PlayerPromptRequest\x12\x19\n" +
+	"\buuid_str\x18\x01 \x01(\tR\auuidStr\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x12N\n" +
+	"\achannel\x18\x03 \x01(\x0e24.fateark.proto.playerkit.PlayerPromptRequest.ChannelR\achannel\x12\x16\n" +
+	"\x05regex\x18\x04 \x01(\tH\x00R\x05regex\x12D\n" +
+	"\x06number\x18\x05 \x01(\v2*.fateark.proto.playerkit.PromptNumberRangeH\x00R\x06number\x12B\n" +
+	"\achoices\x18\x06 \x01(\v2&.fateark.proto.playerkit.PromptChoicesH\x00R\achoices\x12!\n" +
+	"\fmax_attempts\x18\a \x01(\rR\vmaxAttempts\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\b \x01(\rR\ttimeoutMs\x12'\n" +
+	"\x0finvalid_message\x18\t \x01(\tR\x0einvalidMessage\x12\x1a\n" +
+	"\bsuppress\x18\n" +
+	" \x01(\bR\bsuppress\"#\n" +
+	"\aChannel\x12\b\n" +
+	"\x04CHAT\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ACTION_BAR\x10\x01B\f\n" +
+	"\n" +
+	"validation\"\x87\x02\n" +
+	"\x14PlayerPromptResponse\x12L\n" +
+	"\x06status\x18\x01 \x01(\x0e24.fateark.proto.playerkit.PlayerPromptResponse.StatusR\x06status\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\rR\battempts\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x01R\x06number\x12!\n" +
+	"\fchoice_index\x18\x05 \x01(\x05R\vchoiceIndex\"2\n" +
+	"\x06Status\x12\f\n" +
+	"\bANSWERED\x10\x00\x12\v\n" +
+	"\aTIMEOUT\x10\x01\x12\r\n" +
+	"\tEXHAUSTED\x10\x022\xdc*\n" +
+	"\x10PlayerKitService\x12s\n" +
+	"\x13GetAllOnlinePlayers\x123.fateark.proto.playerkit.GetAllOnlinePlayersRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
+	"\x0fGetPlayerByName\x12/.fateark.proto.playerkit.GetPlayerByNameRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
+	"\x0fGetPlayerByUUID\x12/.fateark.proto.playerkit.GetPlayerByUUIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12o\n" +
+	"\x11ReleaseBindPlayer\x121.fateark.proto.playerkit.ReleaseBindPlayerRequest\x1a'.fateark.proto.response.GeneralResponse\x12g\n" +
+	"\rGetPlayerName\x12-.fateark.proto.playerkit.GetPlayerNameRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x80\x01\n" +
+	"\x17GetPlayerEntityUniqueID\x127.fateark.proto.playerkit.GetPlayerEntityUniqueIDRequest\x1a,.fateark.proto.response.GeneralInt64Response\x12v\n" +
+	"\x12GetPlayerLoginTime\x122.fateark.proto.playerkit.GetPlayerLoginTimeRequest\x1a,.fateark.proto.response.GeneralInt64Response\x12{\n" +
+	"\x17GetPlayerPlatformChatID\x127.fateark.proto.playerkit.GetPlayerPlatformChatIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12~\n" +
+	"\x16GetPlayerBuildPlatform\x126.fateark.proto.playerkit.GetPlayerBuildPlatformRequest\x1a,.fateark.proto.response.GeneralInt32Response\x12k\n" +
+	"\x0fGetPlayerSkinID\x12/.fateark.proto.playerkit.GetPlayerSkinIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12s\n" +
+	"\x11GetPlayerCanBuild\x121.fateark.proto.playerkit.GetPlayerCanBuildRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12o\n" +
+	"\x11SetPlayerCanBuild\x121.fateark.proto.playerkit.SetPlayerCanBuildRequest\x1a'.fateark.proto.response.GeneralResponse\x12o\n" +
+	"\x0fGetPlayerCanDig\x12/.fateark.proto.playerkit.GetPlayerCanDigRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12k\n" +
+	"\x0fSetPlayerCanDig\x12/.fateark.proto.playerkit.SetPlayerCanDigRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x89\x01\n" +
+	"\x1cGetPlayerCanDoorsAndSwitches\x12<.fateark.proto.playerkit.GetPlayerCanDoorsAndSwitchesRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x85\x01\n" +
+	"\x1cSetPlayerCanDoorsAndSwitches\x12<.fateark.proto.playerkit.SetPlayerCanDoorsAndSwitchesRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x85\x01\n" +
+	"\x1aGetPlayerCanOpenContainers\x12:.fateark.proto.playerkit.GetPlayerCanOpenContainersRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x81\x01\n" +
+	"\x1aSetPlayerCanOpenContainers\x12:.fateark.proto.playerkit.SetPlayerCanOpenContainersRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x83\x01\n" +
+	"\x19GetPlayerCanAttackPlayers\x129.fateark.proto.playerkit.GetPlayerCanAttackPlayersRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x7f\n" +
+	"\x19SetPlayerCanAttackPlayers\x129.fateark.proto.playerkit.SetPlayerCanAttackPlayersRequest\x1a'.fateark.proto.response.GeneralResponse\x12}\n" +
+	"\x16GetPlayerCanAttackMobs\x126.fateark.proto.playerkit.GetPlayerCanAttackMobsRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12y\n" +
+	"\x16SetPlayerCanAttackMobs\x126.fateark.proto.playerkit.SetPlayerCanAttackMobsRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x89\x01\n" +
+	"\x1cGetPlayerCanOperatorCommands\x12<.fateark.proto.playerkit.GetPlayerCanOperatorCommandsRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x85\x01\n" +
+	"\x1cSetPlayerCanOperatorCommands\x12<.fateark.proto.playerkit.SetPlayerCanOperatorCommandsRequest\x1a'.fateark.proto.response.GeneralResponse\x12y\n" +
+	"\x14GetPlayerCanTeleport\x124.fateark.proto.playerkit.GetPlayerCanTeleportRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12y\n" +
+	"\x14SetPlayerCanTeleport\x124.fateark.proto.playerkit.SetPlayerCanTeleportRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12\x87\x01\n" +
+	"\x1bGetPlayerStatusInvulnerable\x12;.fateark.proto.playerkit.GetPlayerStatusInvulnerableRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12{\n" +
+	"\x15GetPlayerStatusFlying\x125.fateark.proto.playerkit.GetPlayerStatusFlyingRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12{\n" +
+	"\x15GetPlayerStatusMayFly\x125.fateark.proto.playerkit.GetPlayerStatusMayFlyRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12o\n" +
+	"\x11GetPlayerDeviceID\x121.fateark.proto.playerkit.GetPlayerDeviceIDRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x83\x01\n" +
+	"\x18GetPlayerEntityRuntimeID\x128.fateark.proto.playerkit.GetPlayerEntityRuntimeIDRequest\x1a-.fateark.proto.response.GeneralUint64Response\x12{\n" +
+	"\x17GetPlayerEntityMetadata\x127.fateark.proto.playerkit.GetPlayerEntityMetadataRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
+	"\rGetPlayerIsOP\x12-.fateark.proto.playerkit.GetPlayerIsOPRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12o\n" +
+	"\x0fGetPlayerOnline\x12/.fateark.proto.playerkit.GetPlayerOnlineRequest\x1a+.fateark.proto.response.GeneralBoolResponse\x12i\n" +
+	"\x0eSendPlayerChat\x12..fateark.proto.playerkit.SendPlayerChatRequest\x1a'.fateark.proto.response.GeneralResponse\x12o\n" +
+	"\x11SendPlayerRawChat\x121.fateark.proto.playerkit.SendPlayerRawChatRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
+	"\x0fSendPlayerTitle\x12/.fateark.proto.playerkit.SendPlayerTitleRequest\x1a'.fateark.proto.response.GeneralResponse\x12s\n" +
+	"\x13SendPlayerActionBar\x123.fateark.proto.playerkit.SendPlayerActionBarRequest\x1a'.fateark.proto.response.GeneralResponse\x12\x85\x01\n" +
+	"\x1cInterceptPlayerJustNextInput\x12<.fateark.proto.playerkit.InterceptPlayerJustNextInputRequest\x1a'.fateark.proto.response.GeneralResponse\x12h\n" +
+	"\x0fWaitPlayerInput\x12/.fateark.proto.playerkit.WaitPlayerInputRequest\x1a$.fateark.proto.playerkit.PlayerInput\x12o\n" +
+	"\x11CancelPlayerInput\x121.fateark.proto.playerkit.CancelPlayerInputRequest\x1a'.fateark.proto.response.GeneralResponse\x12k\n" +
+	"\fPlayerPrompt\x12,.fateark.proto.playerkit.PlayerPromptRequest\x1a-.fateark.proto.playerkit.PlayerPromptResponse\x12l\n" +
+	"\x10GetPlayerProfile\x120.fateark.proto.playerkit.GetPlayerProfileRequest\x1a&.fateark.proto.playerkit.PlayerProfile\x12z\n" +
+	"\x11GetPlayerProfiles\x121.fateark.proto.playerkit.GetPlayerProfilesRequest\x1a2.fateark.proto.playerkit.GetPlayerProfilesResponse\x12}\n" +
+	"\x12SetPlayerAbilities\x122.fateark.proto.playerkit.SetPlayerAbilitiesRequest\x1a3.fateark.proto.playerkit.SetPlayerAbilitiesResponseBCZAgithub.com/Yeah114/tempest-core/network_api/playerkit;playerkitpbb\x06proto3"
+
+var (
+	file_proto_playerkit_proto_rawDescOnce sync.Once
+	file_proto_playerkit_proto_rawDescData []byte
+)
+
+func file_proto_playerkit_proto_rawDescGZIP() []byte {
+	file_proto_playerkit_proto_rawDescOnce.Do(func() {
+		file_proto_playerkit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_playerkit_proto_rawDesc), len(file_proto_playerkit_proto_rawDesc)))
+	})
+	return file_proto_playerkit_proto_rawDescData
+}
+
+var file_proto_playerkit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_playerkit_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_proto_playerkit_proto_goTypes = []any{
+	(PlayerPromptRequest_Channel)(0),            // 0: fateark.proto.playerkit.PlayerPromptRequest.Channel
+	(PlayerPromptResponse_Status)(0),            // 1: fateark.proto.playerkit.PlayerPromptResponse.Status
+	(*GetAllOnlinePlayersRequest)(nil),          // 2: fateark.proto.playerkit.GetAllOnlinePlayersRequest
+	(*GetPlayerByNameRequest)(nil),              // 3: fateark.proto.playerkit.GetPlayerByNameRequest
+	(*GetPlayerByUUIDRequest)(nil),              // 4: fateark.proto.playerkit.GetPlayerByUUIDRequest
+	(*ReleaseBindPlayerRequest)(nil),            // 5: fateark.proto.playerkit.ReleaseBindPlayerRequest
+	(*GetPlayerNameRequest)(nil),                // 6: fateark.proto.playerkit.GetPlayerNameRequest
+	(*GetPlayerEntityUniqueIDRequest)(nil),      // 7: fateark.proto.playerkit.GetPlayerEntityUniqueIDRequest
+	(*GetPlayerLoginTimeRequest)(nil),           // 8: fateark.proto.playerkit.GetPlayerLoginTimeRequest
+	(*GetPlayerPlatformChatIDRequest)(nil),      // 9: fateark.proto.playerkit.GetPlayerPlatformChatIDRequest
+	(*GetPlayerBuildPlatformRequest)(nil),       // 10: fateark.proto.playerkit.GetPlayerBuildPlatformRequest
+	(*GetPlayerSkinIDRequest)(nil),              // 11: fateark.proto.playerkit.GetPlayerSkinIDRequest
+	(*GetPlayerCanBuildRequest)(nil),            // 12: fateark.proto.playerkit.GetPlayerCanBuildRequest
+	(*SetPlayerCanBuildRequest)(nil),            // 13: fateark.proto.playerkit.SetPlayerCanBuildRequest
+	(*GetPlayerCanDigRequest)(nil),              // 14: fateark.proto.playerkit.GetPlayerCanDigRequest
+	(*SetPlayerCanDigRequest)(nil),              // 15: fateark.proto.playerkit.SetPlayerCanDigRequest
+	(*GetPlayerCanDoorsAndSwitchesRequest)(nil), // 16: fateark.proto.playerkit.GetPlayerCanDoorsAndSwitchesRequest
+	(*SetPlayerCanDoorsAndSwitchesRequest)(nil), // 17: fateark.proto.playerkit.SetPlayerCanDoorsAndSwitchesRequest
+	(*GetPlayerCanOpenContainersRequest)(nil),   // 18: fateark.proto.playerkit.GetPlayerCanOpenContainersRequest
+	(*SetPlayerCanOpenContainersRequest)(nil),   // 19: fateark.proto.playerkit.SetPlayerCanOpenContainersRequest
+	(*GetPlayerCanAttackPlayersRequest)(nil),    // 20: fateark.proto.playerkit.GetPlayerCanAttackPlayersRequest
+	(*SetPlayerCanAttackPlayersRequest)(nil),    // 21: fateark.proto.playerkit.SetPlayerCanAttackPlayersRequest
+	(*GetPlayerCanAttackMobsRequest)(nil),       // 22: fateark.proto.playerkit.GetPlayerCanAttackMobsRequest
+	(*SetPlayerCanAttackMobsRequest)(nil),       // 23: fateark.proto.playerkit.SetPlayerCanAttackMobsRequest
+	(*GetPlayerCanOperatorCommandsRequest)(nil), // 24: fateark.proto.playerkit.GetPlayerCanOperatorCommandsRequest
+	(*SetPlayerCanOperatorCommandsRequest)(nil), // 25: fateark.proto.playerkit.SetPlayerCanOperatorCommandsRequest
+	(*GetPlayerCanTeleportRequest)(nil),         // 26: fateark.proto.playerkit.GetPlayerCanTeleportRequest
+	(*SetPlayerCanTeleportRequest)(nil),         // 27: fateark.proto.playerkit.SetPlayerCanTeleportRequest
+	(*GetPlayerStatusInvulnerableRequest)(nil),  // 28: fateark.proto.playerkit.GetPlayerStatusInvulnerableRequest
+	(*GetPlayerStatusFlyingRequest)(nil),        // 29: fateark.proto.playerkit.GetPlayerStatusFlyingRequest
+	(*GetPlayerStatusMayFlyRequest)(nil),        // 30: fateark.proto.playerkit.GetPlayerStatusMayFlyRequest
+	(*GetPlayerDeviceIDRequest)(nil),            // 31: fateark.proto.playerkit.GetPlayerDeviceIDRequest
+	(*GetPlayerEntityRuntimeIDRequest)(nil),     // 32: fateark.proto.playerkit.GetPlayerEntityRuntimeIDRequest
+	(*GetPlayerEntityMetadataRequest)(nil),      // 33: fateark.proto.playerkit.GetPlayerEntityMetadataRequest
+	(*GetPlayerIsOPRequest)(nil),                // 34: fateark.proto.playerkit.GetPlayerIsOPRequest
+	(*GetPlayerOnlineRequest)(nil),              // 35: fateark.proto.playerkit.GetPlayerOnlineRequest
+	(*SendPlayerChatRequest)(nil),               // 36: fateark.proto.playerkit.SendPlayerChatRequest
+	(*SendPlayerRawChatRequest)(nil),            // 37: fateark.proto.playerkit.SendPlayerRawChatRequest
+	(*SendPlayerTitleRequest)(nil),              // 38: fateark.proto.playerkit.SendPlayerTitleRequest
+	(*SendPlayerActionBarRequest)(nil),          // 39: fateark.proto.playerkit.SendPlayerActionBarRequest
+	(*InterceptPlayerJustNextInputRequest)(nil), // 40: fateark.proto.playerkit.InterceptPlayerJustNextInputRequest
+	(*WaitPlayerInputRequest)(nil),              // 41: fateark.proto.playerkit.WaitPlayerInputRequest
+	(*PlayerInput)(nil),                         // 42: fateark.proto.playerkit.PlayerInput
+	(*CancelPlayerInputRequest)(nil),            // 43: fateark.proto.playerkit.CancelPlayerInputRequest
+	(*PlayerAbilities)(nil),                     // 44: fateark.proto.playerkit.PlayerAbilities
+	(*PlayerMetadata)(nil),                      // 45: fateark.proto.playerkit.PlayerMetadata
+	(*PlayerProfile)(nil),                       // 46: fateark.proto.playerkit.PlayerProfile
+	(*GetPlayerProfileRequest)(nil),             // 47: fateark.proto.playerkit.GetPlayerProfileRequest
+	(*GetPlayerProfilesRequest)(nil),            // 48: fateark.proto.playerkit.GetPlayerProfilesRequest
+	(*GetPlayerProfilesResponse)(nil),           // 49: fateark.proto.playerkit.GetPlayerProfilesResponse
+	(*AbilityChanges)(nil),                      // 50: fateark.proto.playerkit.AbilityChanges
+	(*SetPlayerAbilitiesRequest)(nil),           // 51: fateark.proto.playerkit.SetPlayerAbilitiesRequest
+	(*SetPlayerAbilitiesResponse)(nil),          // 52: fateark.proto.playerkit.SetPlayerAbilitiesResponse
+	(*PromptNumberRange)(nil),                   // 53: fateark.proto.playerkit.PromptNumberRange
+	(*PromptChoices)(nil),                       // 54: fateark.proto.playerkit.PromptChoices
+	(*PlayerPromptRequest)(nil),                 // 55: fateark.proto.playerkit.PlayerPromptRequest
+	(*PlayerPromptResponse)(nil),                // 56: fateark.proto.playerkit.PlayerPromptResponse
+	(*response.GeneralResponse)(nil),            // 57: fateark.proto.response.GeneralResponse
+	(*response.GeneralInt64Response)(nil),       // 58: fateark.proto.response.GeneralInt64Response
+	(*response.GeneralInt32Response)(nil),       // 59: fateark.proto.response.GeneralInt32Response
+	(*response.GeneralBoolResponse)(nil),        // 60: fateark.proto.response.GeneralBoolResponse
+	(*response.GeneralUint64Response)(nil),      // 61: fateark.proto.response.GeneralUint64Response
+}
+var file_proto_playerkit_proto_depIdxs = []int32{
+	44, // 0: fateark.proto.playerkit.PlayerProfile.abilities:type_name -> fateark.proto.playerkit.PlayerAbilities
+	45, // 1: fateark.proto.playerkit.PlayerProfile.metadata:type_name -> fateark.proto.playerkit.PlayerMetadata
+	46, // 2: fateark.proto.playerkit.GetPlayerProfilesResponse.profiles:type_name -> fateark.proto.playerkit.PlayerProfile
+	50, // 3: fateark.proto.playerkit.SetPlayerAbilitiesRequest.abilities:type_name -> fateark.proto.playerkit.AbilityChanges
+	44, // 4: fateark.proto.playerkit.SetPlayerAbilitiesResponse.abilities:type_name -> fateark.proto.playerkit.PlayerAbilities
+	0,  // 5: fateark.proto.playerkit.PlayerPromptRequest.channel:type_name -> fateark.proto.playerkit.PlayerPromptRequest.Channel
+	53, // 6: fateark.proto.playerkit.PlayerPromptRequest.number:type_name -> fateark.proto.playerkit.PromptNumberRange
+	54, // 7: fateark.proto.playerkit.PlayerPromptRequest.choices:type_name -> fateark.proto.playerkit.PromptChoices
+	1,  // 8: fateark.proto.playerkit.PlayerPromptResponse.status:type_name -> fateark.proto.playerkit.PlayerPromptResponse.Status
+	2,  // 9: fateark.proto.playerkit.PlayerKitService.GetAllOnlinePlayers:input_type -> fateark.proto.playerkit.GetAllOnlinePlayersRequest
+	3,  // 10: fateark.proto.playerkit.PlayerKitService.GetPlayerByName:input_type -> fateark.proto.playerkit.GetPlayerByNameRequest
+	4,  // 11: fateark.proto.playerkit.PlayerKitService.GetPlayerByUUID:input_type -> fateark.proto.playerkit.GetPlayerByUUIDRequest
+	5,  // 12: fateark.proto.playerkit.PlayerKitService.ReleaseBindPlayer:input_type -> fateark.proto.playerkit.ReleaseBindPlayerRequest
+	6,  // 13: fateark.proto.playerkit.PlayerKitService.GetPlayerName:input_type -> fateark.proto.playerkit.GetPlayerNameRequest
+	7,  // 14: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityUniqueID:input_type -> fateark.proto.playerkit.GetPlayerEntityUniqueIDRequest
+	8,  // 15: fateark.proto.playerkit.PlayerKitService.GetPlayerLoginTime:input_type -> fateark.proto.playerkit.GetPlayerLoginTimeRequest
+	9,  // 16: fateark.proto.playerkit.PlayerKitService.GetPlayerPlatformChatID:input_type -> fateark.proto.playerkit.GetPlayerPlatformChatIDRequest
+	10, // 17: fateark.proto.playerkit.PlayerKitService.GetPlayerBuildPlatform:input_type -> fateark.proto.playerkit.GetPlayerBuildPlatformRequest
+	11, // 18: fateark.proto.playerkit.PlayerKitService.GetPlayerSkinID:input_type -> fateark.proto.playerkit.GetPlayerSkinIDRequest
+	12, // 19: fateark.proto.playerkit.PlayerKitService.GetPlayerCanBuild:input_type -> fateark.proto.playerkit.GetPlayerCanBuildRequest
+	13, // 20: fateark.proto.playerkit.PlayerKitService.SetPlayerCanBuild:input_type -> fateark.proto.playerkit.SetPlayerCanBuildRequest
+	14, // 21: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDig:input_type -> fateark.proto.playerkit.GetPlayerCanDigRequest
+	15, // 22: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDig:input_type -> fateark.proto.playerkit.SetPlayerCanDigRequest
+	16, // 23: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDoorsAndSwitches:input_type -> fateark.proto.playerkit.GetPlayerCanDoorsAndSwitchesRequest
+	17, // 24: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDoorsAndSwitches:input_type -> fateark.proto.playerkit.SetPlayerCanDoorsAndSwitchesRequest
+	18, // 25: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOpenContainers:input_type -> fateark.proto.playerkit.GetPlayerCanOpenContainersRequest
+	19, // 26: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOpenContainers:input_type -> fateark.proto.playerkit.SetPlayerCanOpenContainersRequest
+	20, // 27: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackPlayers:input_type -> fateark.proto.playerkit.GetPlayerCanAttackPlayersRequest
+	21, // 28: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackPlayers:input_type -> fateark.proto.playerkit.SetPlayerCanAttackPlayersRequest
+	22, // 29: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackMobs:input_type -> fateark.proto.playerkit.GetPlayerCanAttackMobsRequest
+	23, // 30: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackMobs:input_type -> fateark.proto.playerkit.SetPlayerCanAttackMobsRequest
+	24, // 31: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOperatorCommands:input_type -> fateark.proto.playerkit.GetPlayerCanOperatorCommandsRequest
+	25, // 32: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOperatorCommands:input_type -> fateark.proto.playerkit.SetPlayerCanOperatorCommandsRequest
+	26, // 33: fateark.proto.playerkit.PlayerKitService.GetPlayerCanTeleport:input_type -> fateark.proto.playerkit.GetPlayerCanTeleportRequest
+	27, // 34: fateark.proto.playerkit.PlayerKitService.SetPlayerCanTeleport:input_type -> fateark.proto.playerkit.SetPlayerCanTeleportRequest
+	28, // 35: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusInvulnerable:input_type -> fateark.proto.playerkit.GetPlayerStatusInvulnerableRequest
+	29, // 36: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusFlying:input_type -> fateark.proto.playerkit.GetPlayerStatusFlyingRequest
+	30, // 37: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusMayFly:input_type -> fateark.proto.playerkit.GetPlayerStatusMayFlyRequest
+	31, // 38: fateark.proto.playerkit.PlayerKitService.GetPlayerDeviceID:input_type -> fateark.proto.playerkit.GetPlayerDeviceIDRequest
+	32, // 39: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityRuntimeID:input_type -> fateark.proto.playerkit.GetPlayerEntityRuntimeIDRequest
+	33, // 40: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityMetadata:input_type -> fateark.proto.playerkit.GetPlayerEntityMetadataRequest
+	34, // 41: fateark.proto.playerkit.PlayerKitService.GetPlayerIsOP:input_type -> fateark.proto.playerkit.GetPlayerIsOPRequest
+	35, // 42: fateark.proto.playerkit.PlayerKitService.GetPlayerOnline:input_type -> fateark.proto.playerkit.GetPlayerOnlineRequest
+	36, // 43: fateark.proto.playerkit.PlayerKitService.SendPlayerChat:input_type -> fateark.proto.playerkit.SendPlayerChatRequest
+	37, // 44: fateark.proto.playerkit.PlayerKitService.SendPlayerRawChat:input_type -> fateark.proto.playerkit.SendPlayerRawChatRequest
+	38, // 45: fateark.proto.playerkit.PlayerKitService.SendPlayerTitle:input_type -> fateark.proto.playerkit.SendPlayerTitleRequest
+	39, // 46: fateark.proto.playerkit.PlayerKitService.SendPlayerActionBar:input_type -> fateark.proto.playerkit.SendPlayerActionBarRequest
+	40, // 47: fateark.proto.playerkit.PlayerKitService.InterceptPlayerJustNextInput:input_type -> fateark.proto.playerkit.InterceptPlayerJustNextInputRequest
+	41, // 48: fateark.proto.playerkit.PlayerKitService.WaitPlayerInput:input_type -> fateark.proto.playerkit.WaitPlayerInputRequest
+	43, // 49: fateark.proto.playerkit.PlayerKitService.CancelPlayerInput:input_type -> fateark.proto.playerkit.CancelPlayerInputRequest
+	55, // 50: fateark.proto.playerkit.PlayerKitService.PlayerPrompt:input_type -> fateark.proto.playerkit.PlayerPromptRequest
+	47, // 51: fateark.proto.playerkit.PlayerKitService.GetPlayerProfile:input_type -> fateark.proto.playerkit.GetPlayerProfileRequest
+	48, // 52: fateark.proto.playerkit.PlayerKitService.GetPlayerProfiles:input_type -> fateark.proto.playerkit.GetPlayerProfilesRequest
+	51, // 53: fateark.proto.playerkit.PlayerKitService.SetPlayerAbilities:input_type -> fateark.proto.playerkit.SetPlayerAbilitiesRequest
+	57, // 54: fateark.proto.playerkit.PlayerKitService.GetAllOnlinePlayers:output_type -> fateark.proto.response.GeneralResponse
+	57, // 55: fateark.proto.playerkit.PlayerKitService.GetPlayerByName:output_type -> fateark.proto.response.GeneralResponse
+	57, // 56: fateark.proto.playerkit.PlayerKitService.GetPlayerByUUID:output_type -> fateark.proto.response.GeneralResponse
+	57, // 57: fateark.proto.playerkit.PlayerKitService.ReleaseBindPlayer:output_type -> fateark.proto.response.GeneralResponse
+	57, // 58: fateark.proto.playerkit.PlayerKitService.GetPlayerName:output_type -> fateark.proto.response.GeneralResponse
+	58, // 59: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityUniqueID:output_type -> fateark.proto.response.GeneralInt64Response
+	58, // 60: fateark.proto.playerkit.PlayerKitService.GetPlayerLoginTime:output_type -> fateark.proto.response.GeneralInt64Response
+	57, // 61: fateark.proto.playerkit.PlayerKitService.GetPlayerPlatformChatID:output_type -> fateark.proto.response.GeneralResponse
+	59, // 62: fateark.proto.playerkit.PlayerKitService.GetPlayerBuildPlatform:output_type -> fateark.proto.response.GeneralInt32Response
+	57, // 63: fateark.proto.playerkit.PlayerKitService.GetPlayerSkinID:output_type -> fateark.proto.response.GeneralResponse
+	60, // 64: fateark.proto.playerkit.PlayerKitService.GetPlayerCanBuild:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 65: fateark.proto.playerkit.PlayerKitService.SetPlayerCanBuild:output_type -> fateark.proto.response.GeneralResponse
+	60, // 66: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDig:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 67: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDig:output_type -> fateark.proto.response.GeneralResponse
+	60, // 68: fateark.proto.playerkit.PlayerKitService.GetPlayerCanDoorsAndSwitches:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 69: fateark.proto.playerkit.PlayerKitService.SetPlayerCanDoorsAndSwitches:output_type -> fateark.proto.response.GeneralResponse
+	60, // 70: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOpenContainers:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 71: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOpenContainers:output_type -> fateark.proto.response.GeneralResponse
+	60, // 72: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackPlayers:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 73: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackPlayers:output_type -> fateark.proto.response.GeneralResponse
+	60, // 74: fateark.proto.playerkit.PlayerKitService.GetPlayerCanAttackMobs:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 75: fateark.proto.playerkit.PlayerKitService.SetPlayerCanAttackMobs:output_type -> fateark.proto.response.GeneralResponse
+	60, // 76: fateark.proto.playerkit.PlayerKitService.GetPlayerCanOperatorCommands:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 77: fateark.proto.playerkit.PlayerKitService.SetPlayerCanOperatorCommands:output_type -> fateark.proto.response.GeneralResponse
+	60, // 78: fateark.proto.playerkit.PlayerKitService.GetPlayerCanTeleport:output_type -> fateark.proto.response.GeneralBoolResponse
+	60, // 79: fateark.proto.playerkit.PlayerKitService.SetPlayerCanTeleport:output_type -> fateark.proto.response.GeneralBoolResponse
+	60, // 80: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusInvulnerable:output_type -> fateark.proto.response.GeneralBoolResponse
+	60, // 81: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusFlying:output_type -> fateark.proto.response.GeneralBoolResponse
+	60, // 82: fateark.proto.playerkit.PlayerKitService.GetPlayerStatusMayFly:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 83: fateark.proto.playerkit.PlayerKitService.GetPlayerDeviceID:output_type -> fateark.proto.response.GeneralResponse
+	61, // 84: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityRuntimeID:output_type -> fateark.proto.response.GeneralUint64Response
+	57, // 85: fateark.proto.playerkit.PlayerKitService.GetPlayerEntityMetadata:output_type -> fateark.proto.response.GeneralResponse
+	60, // 86: fateark.proto.playerkit.PlayerKitService.GetPlayerIsOP:output_type -> fateark.proto.response.GeneralBoolResponse
+	60, // 87: fateark.proto.playerkit.PlayerKitService.GetPlayerOnline:output_type -> fateark.proto.response.GeneralBoolResponse
+	57, // 88: fateark.proto.playerkit.PlayerKitService.SendPlayerChat:output_type -> fateark.proto.response.GeneralResponse
+	57, // 89: fateark.proto.playerkit.PlayerKitService.SendPlayerRawChat:output_type -> fateark.proto.response.GeneralResponse
+	57, // 90: fateark.proto.playerkit.PlayerKitService.SendPlayerTitle:output_type -> fateark.proto.response.GeneralResponse
+	57, // 91: fateark.proto.playerkit.PlayerKitService.SendPlayerActionBar:output_type -> fateark.proto.response.GeneralResponse
+	57, // 92: fateark.proto.playerkit.PlayerKitService.InterceptPlayerJustNextInput:output_type -> fateark.proto.response.GeneralResponse
+	42, // 93: fateark.proto.playerkit.PlayerKitService.WaitPlayerInput:output_type -> fateark.proto.playerkit.PlayerInput
+	57, // 94: fateark.proto.playerkit.PlayerKitService.CancelPlayerInput:output_type -> fateark.proto.response.GeneralResponse
+	56, // 95: fateark.proto.playerkit.PlayerKitService.PlayerPrompt:output_type -> fateark.proto.playerkit.PlayerPromptResponse
+	46, // 96: fateark.proto.playerkit.PlayerKitService.GetPlayerProfile:output_type -> fateark.proto.playerkit.PlayerProfile
+	49, // 97: fateark.proto.playerkit.PlayerKitService.GetPlayerProfiles:output_type -> fateark.proto.playerkit.GetPlayerProfilesResponse
+	52, // 98: fateark.proto.playerkit.PlayerKitService.SetPlayerAbilities:output_type -> fateark.proto.playerkit.SetPlayerAbilitiesResponse
+	54, // [54:99] is the sub-list for method output_type
+	9,  // [9:54] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_playerkit_proto_init() }
+func file_proto_playerkit_proto_init() {
+	if File_proto_playerkit_proto != nil {
+		return
+	}
+	file_proto_playerkit_proto_msgTypes[48].OneofWrappers = []any{}
+	file_proto_playerkit_proto_msgTypes[51].OneofWrappers = []any{}
+	file_proto_playerkit_proto_msgTypes[53].OneofWrappers = []any{
+		(*PlayerPromptRequest_Regex)(nil),
+		(*PlayerPromptRequest_Number)(nil),
+		(*PlayerPromptRequest_Choices)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_playerkit_proto_rawDesc), len(file_proto_playerkit_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   39,
+			NumEnums:      2,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_playerkit_proto_goTypes,
 		DependencyIndexes: file_proto_playerkit_proto_depIdxs,
+		EnumInfos:         file_proto_playerkit_proto_enumTypes,
 		MessageInfos:      file_proto_playerkit_proto_msgTypes,
 	}.Build()
 	File_proto_playerkit_proto = out.File
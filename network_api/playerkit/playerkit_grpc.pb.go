@@ -59,6 +59,12 @@ const (
 	PlayerKitService_SendPlayerTitle_FullMethodName              = "/fateark.proto.playerkit.PlayerKitService/SendPlayerTitle"
 	PlayerKitService_SendPlayerActionBar_FullMethodName          = "/fateark.proto.playerkit.PlayerKitService/SendPlayerActionBar"
 	PlayerKitService_InterceptPlayerJustNextInput_FullMethodName = "/fateark.proto.playerkit.PlayerKitService/InterceptPlayerJustNextInput"
+	PlayerKitService_WaitPlayerInput_FullMethodName              = "/fateark.proto.playerkit.PlayerKitService/WaitPlayerInput"
+	PlayerKitService_CancelPlayerInput_FullMethodName            = "/fateark.proto.playerkit.PlayerKitService/CancelPlayerInput"
+	PlayerKitService_PlayerPrompt_FullMethodName                 = "/fateark.proto.playerkit.PlayerKitService/PlayerPrompt"
+	PlayerKitService_GetPlayerProfile_FullMethodName             = "/fateark.proto.playerkit.PlayerKitService/GetPlayerProfile"
+	PlayerKitService_GetPlayerProfiles_FullMethodName            = "/fateark.proto.playerkit.PlayerKitService/GetPlayerProfiles"
+	PlayerKitService_SetPlayerAbilities_FullMethodName           = "/fateark.proto.playerkit.PlayerKitService/SetPlayerAbilities"
 )
 
 // PlayerKitServiceClient is the client API for PlayerKitService service.
@@ -104,6 +110,12 @@ type PlayerKitServiceClient interface {
 	SendPlayerTitle(ctx context.Context, in *SendPlayerTitleRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
 	SendPlayerActionBar(ctx context.Context, in *SendPlayerActionBarRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
 	InterceptPlayerJustNextInput(ctx context.Context, in *InterceptPlayerJustNextInputRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	WaitPlayerInput(ctx context.Context, in *WaitPlayerInputRequest, opts ...grpc.CallOption) (*PlayerInput, error)
+	CancelPlayerInput(ctx context.Context, in *CancelPlayerInputRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	PlayerPrompt(ctx context.Context, in *PlayerPromptRequest, opts ...grpc.CallOption) (*PlayerPromptResponse, error)
+	GetPlayerProfile(ctx context.Context, in *GetPlayerProfileRequest, opts ...grpc.CallOption) (*PlayerProfile, error)
+	GetPlayerProfiles(ctx context.Context, in *GetPlayerProfilesRequest, opts ...grpc.CallOption) (*GetPlayerProfilesResponse, error)
+	SetPlayerAbilities(ctx context.Context, in *SetPlayerAbilitiesRequest, opts ...grpc.CallOption) (*SetPlayerAbilitiesResponse, error)
 }
 
 type playerKitServiceClient struct {
@@ -504,6 +516,66 @@ func (c *playerKitServiceClient) InterceptPlayerJustNextInput(ctx context.Contex
 	return out, nil
 }
 
+func (c *playerKitServiceClient) WaitPlayerInput(ctx context.Context, in *WaitPlayerInputRequest, opts ...grpc.CallOption) (*PlayerInput, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerInput)
+	err := c.cc.Invoke(ctx, PlayerKitService_WaitPlayerInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerKitServiceClient) CancelPlayerInput(ctx context.Context, in *CancelPlayerInputRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(response.GeneralResponse)
+	err := c.cc.Invoke(ctx, PlayerKitService_CancelPlayerInput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerKitServiceClient) PlayerPrompt(ctx context.Context, in *PlayerPromptRequest, opts ...grpc.CallOption) (*PlayerPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerPromptResponse)
+	err := c.cc.Invoke(ctx, PlayerKitService_PlayerPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerKitServiceClient) GetPlayerProfile(ctx context.Context, in *GetPlayerProfileRequest, opts ...grpc.CallOption) (*PlayerProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerProfile)
+	err := c.cc.Invoke(ctx, PlayerKitService_GetPlayerProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerKitServiceClient) GetPlayerProfiles(ctx context.Context, in *GetPlayerProfilesRequest, opts ...grpc.CallOption) (*GetPlayerProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayerProfilesResponse)
+	err := c.cc.Invoke(ctx, PlayerKitService_GetPlayerProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerKitServiceClient) SetPlayerAbilities(ctx context.Context, in *SetPlayerAbilitiesRequest, opts ...grpc.CallOption) (*SetPlayerAbilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPlayerAbilitiesResponse)
+	err := c.cc.Invoke(ctx, PlayerKitService_SetPlayerAbilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlayerKitServiceServer is the server API for PlayerKitService service.
 // All implementations must embed UnimplementedPlayerKitServiceServer
 // for forward compatibility.
@@ -547,6 +619,12 @@ type PlayerKitServiceServer interface {
 	SendPlayerTitle(context.Context, *SendPlayerTitleRequest) (*response.GeneralResponse, error)
 	SendPlayerActionBar(context.Context, *SendPlayerActionBarRequest) (*response.GeneralResponse, error)
 	InterceptPlayerJustNextInput(context.Context, *InterceptPlayerJustNextInputRequest) (*response.GeneralResponse, error)
+	WaitPlayerInput(context.Context, *WaitPlayerInputRequest) (*PlayerInput, error)
+	CancelPlayerInput(context.Context, *CancelPlayerInputRequest) (*response.GeneralResponse, error)
+	PlayerPrompt(context.Context, *PlayerPromptRequest) (*PlayerPromptResponse, error)
+	GetPlayerProfile(context.Context, *GetPlayerProfileRequest) (*PlayerProfile, error)
+	GetPlayerProfiles(context.Context, *GetPlayerProfilesRequest) (*GetPlayerProfilesResponse, error)
+	SetPlayerAbilities(context.Context, *SetPlayerAbilitiesRequest) (*SetPlayerAbilitiesResponse, error)
 	mustEmbedUnimplementedPlayerKitServiceServer()
 }
 
@@ -674,6 +752,24 @@ func (UnimplementedPlayerKitServiceServer) SendPlayerActionBar(context.Context,
 func (UnimplementedPlayerKitServiceServer) InterceptPlayerJustNextInput(context.Context, *InterceptPlayerJustNextInputRequest) (*response.GeneralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterceptPlayerJustNextInput not implemented")
 }
+func (UnimplementedPlayerKitServiceServer) WaitPlayerInput(context.Context, *WaitPlayerInputRequest) (*PlayerInput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitPlayerInput not implemented")
+}
+func (UnimplementedPlayerKitServiceServer) CancelPlayerInput(context.Context, *CancelPlayerInputRequest) (*response.GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPlayerInput not implemented")
+}
+func (UnimplementedPlayerKitServiceServer) PlayerPrompt(context.Context, *PlayerPromptRequest) (*PlayerPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerPrompt not implemented")
+}
+func (UnimplementedPlayerKitServiceServer) GetPlayerProfile(context.Context, *GetPlayerProfileRequest) (*PlayerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerProfile not implemented")
+}
+func (UnimplementedPlayerKitServiceServer) GetPlayerProfiles(context.Context, *GetPlayerProfilesRequest) (*GetPlayerProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerProfiles not implemented")
+}
+func (UnimplementedPlayerKitServiceServer) SetPlayerAbilities(context.Context, *SetPlayerAbilitiesRequest) (*SetPlayerAbilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlayerAbilities not implemented")
+}
 func (UnimplementedPlayerKitServiceServer) mustEmbedUnimplementedPlayerKitServiceServer() {}
 func (UnimplementedPlayerKitServiceServer) testEmbeddedByValue()                          {}
 
@@ -1397,6 +1493,114 @@ func _PlayerKitService_InterceptPlayerJustNextInput_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlayerKitService_WaitPlayerInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitPlayerInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServiceServer).WaitPlayerInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKitService_WaitPlayerInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServiceServer).WaitPlayerInput(ctx, req.(*WaitPlayerInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerKitService_CancelPlayerInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPlayerInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServiceServer).CancelPlayerInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKitService_CancelPlayerInput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServiceServer).CancelPlayerInput(ctx, req.(*CancelPlayerInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerKitService_PlayerPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServiceServer).PlayerPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKitService_PlayerPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServiceServer).PlayerPrompt(ctx, req.(*PlayerPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerKitService_GetPlayerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServiceServer).GetPlayerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKitService_GetPlayerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServiceServer).GetPlayerProfile(ctx, req.(*GetPlayerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerKitService_GetPlayerProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServiceServer).GetPlayerProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKitService_GetPlayerProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServiceServer).GetPlayerProfiles(ctx, req.(*GetPlayerProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerKitService_SetPlayerAbilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerAbilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerKitServiceServer).SetPlayerAbilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerKitService_SetPlayerAbilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerKitServiceServer).SetPlayerAbilities(ctx, req.(*SetPlayerAbilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlayerKitService_ServiceDesc is the grpc.ServiceDesc for PlayerKitService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1560,6 +1764,30 @@ var PlayerKitService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InterceptPlayerJustNextInput",
 			Handler:    _PlayerKitService_InterceptPlayerJustNextInput_Handler,
 		},
+		{
+			MethodName: "WaitPlayerInput",
+			Handler:    _PlayerKitService_WaitPlayerInput_Handler,
+		},
+		{
+			MethodName: "CancelPlayerInput",
+			Handler:    _PlayerKitService_CancelPlayerInput_Handler,
+		},
+		{
+			MethodName: "PlayerPrompt",
+			Handler:    _PlayerKitService_PlayerPrompt_Handler,
+		},
+		{
+			MethodName: "GetPlayerProfile",
+			Handler:    _PlayerKitService_GetPlayerProfile_Handler,
+		},
+		{
+			MethodName: "GetPlayerProfiles",
+			Handler:    _PlayerKitService_GetPlayerProfiles_Handler,
+		},
+		{
+			MethodName: "SetPlayerAbilities",
+			Handler:    _PlayerKitService_SetPlayerAbilities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/playerkit.proto",
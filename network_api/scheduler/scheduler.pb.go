@@ -0,0 +1,830 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/scheduler.proto
+
+package schedulerpb
+
+import (
+	command "github.com/Yeah114/tempest-core/network_api/command"
+	response "github.com/Yeah114/tempest-core/network_api/response"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type JobResult_Status int32
+
+const (
+	JobResult_SUCCEEDED JobResult_Status = 0
+	JobResult_FAILED    JobResult_Status = 1
+	JobResult_SKIPPED   JobResult_Status = 2
+)
+
+// Enum value maps for JobResult_Status.
+var (
+	JobResult_Status_name = map[int32]string{
+		0: "SUCCEEDED",
+		1: "FAILED",
+		2: "SKIPPED",
+	}
+	JobResult_Status_value = map[string]int32{
+		"SUCCEEDED": 0,
+		"FAILED":    1,
+		"SKIPPED":   2,
+	}
+)
+
+func (x JobResult_Status) Enum() *JobResult_Status {
+	p := new(JobResult_Status)
+	*p = x
+	return p
+}
+
+func (x JobResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scheduler_proto_enumTypes[0].Descriptor()
+}
+
+func (JobResult_Status) Type() protoreflect.EnumType {
+	return &file_proto_scheduler_proto_enumTypes[0]
+}
+
+func (x JobResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobResult_Status.Descriptor instead.
+func (JobResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type JobResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Status      JobResult_Status       `protobuf:"varint,1,opt,name=status,proto3,enum=fateark.proto.scheduler.JobResult_Status" json:"status,omitempty"`
+	StartedAtMs int64                  `protobuf:"varint,2,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"`
+	DurationMs  int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// succeeded counts commands that were sent; their output is not awaited,
+	// so a command the server rejected still counts.
+	Succeeded     uint32 `protobuf:"varint,5,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        uint32 `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       uint32 `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult) Reset() {
+	*x = JobResult{}
+	mi := &file_proto_scheduler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResult) ProtoMessage() {}
+
+func (x *JobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResult.ProtoReflect.Descriptor instead.
+func (*JobResult) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JobResult) GetStatus() JobResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return JobResult_SUCCEEDED
+}
+
+func (x *JobResult) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *JobResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *JobResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JobResult) GetSucceeded() uint32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *JobResult) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *JobResult) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type Job struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Schedule:
+	//
+	//	*Job_Cron
+	//	*Job_IntervalMs
+	Schedule    isJob_Schedule          `protobuf_oneof:"schedule"`
+	Timezone    string                  `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Commands    []*command.BatchCommand `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty"`
+	StopOnError bool                    `protobuf:"varint,7,opt,name=stop_on_error,json=stopOnError,proto3" json:"stop_on_error,omitempty"`
+	Paused      bool                    `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	// role is the command policy role of the creator. Only callers of that
+	// role or policy managers may pause, resume or delete the job.
+	Role          string     `protobuf:"bytes,9,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAtMs   int64      `protobuf:"varint,10,opt,name=created_at_ms,json=createdAtMs,proto3" json:"created_at_ms,omitempty"`
+	NextRunMs     int64      `protobuf:"varint,11,opt,name=next_run_ms,json=nextRunMs,proto3" json:"next_run_ms,omitempty"`
+	Runs          uint64     `protobuf:"varint,12,opt,name=runs,proto3" json:"runs,omitempty"`
+	LastResult    *JobResult `protobuf:"bytes,13,opt,name=last_result,json=lastResult,proto3" json:"last_result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_proto_scheduler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetSchedule() isJob_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Job) GetCron() string {
+	if x != nil {
+		if x, ok := x.Schedule.(*Job_Cron); ok {
+			return x.Cron
+		}
+	}
+	return ""
+}
+
+func (x *Job) GetIntervalMs() uint64 {
+	if x != nil {
+		if x, ok := x.Schedule.(*Job_IntervalMs); ok {
+			return x.IntervalMs
+		}
+	}
+	return 0
+}
+
+func (x *Job) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Job) GetCommands() []*command.BatchCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *Job) GetStopOnError() bool {
+	if x != nil {
+		return x.StopOnError
+	}
+	return false
+}
+
+func (x *Job) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Job) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedAtMs() int64 {
+	if x != nil {
+		return x.CreatedAtMs
+	}
+	return 0
+}
+
+func (x *Job) GetNextRunMs() int64 {
+	if x != nil {
+		return x.NextRunMs
+	}
+	return 0
+}
+
+func (x *Job) GetRuns() uint64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *Job) GetLastResult() *JobResult {
+	if x != nil {
+		return x.LastResult
+	}
+	return nil
+}
+
+type isJob_Schedule interface {
+	isJob_Schedule()
+}
+
+type Job_Cron struct {
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3,oneof"`
+}
+
+type Job_IntervalMs struct {
+	IntervalMs uint64 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3,oneof"`
+}
+
+func (*Job_Cron) isJob_Schedule() {}
+
+func (*Job_IntervalMs) isJob_Schedule() {}
+
+type CreateJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Schedule:
+	//
+	//	*CreateJobRequest_Cron
+	//	*CreateJobRequest_IntervalMs
+	Schedule      isCreateJobRequest_Schedule `protobuf_oneof:"schedule"`
+	Timezone      string                      `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Commands      []*command.BatchCommand     `protobuf:"bytes,5,rep,name=commands,proto3" json:"commands,omitempty"`
+	StopOnError   bool                        `protobuf:"varint,6,opt,name=stop_on_error,json=stopOnError,proto3" json:"stop_on_error,omitempty"`
+	Paused        bool                        `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJobRequest) Reset() {
+	*x = CreateJobRequest{}
+	mi := &file_proto_scheduler_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobRequest) ProtoMessage() {}
+
+func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetSchedule() isCreateJobRequest_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *CreateJobRequest) GetCron() string {
+	if x != nil {
+		if x, ok := x.Schedule.(*CreateJobRequest_Cron); ok {
+			return x.Cron
+		}
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetIntervalMs() uint64 {
+	if x != nil {
+		if x, ok := x.Schedule.(*CreateJobRequest_IntervalMs); ok {
+			return x.IntervalMs
+		}
+	}
+	return 0
+}
+
+func (x *CreateJobRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetCommands() []*command.BatchCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *CreateJobRequest) GetStopOnError() bool {
+	if x != nil {
+		return x.StopOnError
+	}
+	return false
+}
+
+func (x *CreateJobRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type isCreateJobRequest_Schedule interface {
+	isCreateJobRequest_Schedule()
+}
+
+type CreateJobRequest_Cron struct {
+	Cron string `protobuf:"bytes,2,opt,name=cron,proto3,oneof"`
+}
+
+type CreateJobRequest_IntervalMs struct {
+	IntervalMs uint64 `protobuf:"varint,3,opt,name=interval_ms,json=intervalMs,proto3,oneof"`
+}
+
+func (*CreateJobRequest_Cron) isCreateJobRequest_Schedule() {}
+
+func (*CreateJobRequest_IntervalMs) isCreateJobRequest_Schedule() {}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_proto_scheduler_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{3}
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_proto_scheduler_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_proto_scheduler_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PauseJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Paused        bool                   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseJobRequest) Reset() {
+	*x = PauseJobRequest{}
+	mi := &file_proto_scheduler_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseJobRequest) ProtoMessage() {}
+
+func (x *PauseJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseJobRequest.ProtoReflect.Descriptor instead.
+func (*PauseJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PauseJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PauseJobRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type DeleteJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	mi := &file_proto_scheduler_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scheduler_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scheduler_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_proto_scheduler_proto protoreflect.FileDescriptor
+
+const file_proto_scheduler_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/scheduler.proto\x12\x17fateark.proto.scheduler\x1a\x13proto/command.proto\x1a\x14proto/response.proto\"\xaf\x02\n" +
+	"\tJobResult\x12A\n" +
+	"\x06status\x18\x01 \x01(\x0e2).fateark.proto.scheduler.JobResult.StatusR\x06status\x12\"\n" +
+	"\rstarted_at_ms\x18\x02 \x01(\x03R\vstartedAtMs\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x03R\n" +
+	"durationMs\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1c\n" +
+	"\tsucceeded\x18\x05 \x01(\rR\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\rR\x06failed\x12\x18\n" +
+	"\askipped\x18\a \x01(\rR\askipped\"0\n" +
+	"\x06Status\x12\r\n" +
+	"\tSUCCEEDED\x10\x00\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x01\x12\v\n" +
+	"\aSKIPPED\x10\x02\"\xb8\x03\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x04cron\x18\x03 \x01(\tH\x00R\x04cron\x12!\n" +
+	"\vinterval_ms\x18\x04 \x01(\x04H\x00R\n" +
+	"intervalMs\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12?\n" +
+	"\bcommands\x18\x06 \x03(\v2#.fateark.proto.command.BatchCommandR\bcommands\x12\"\n" +
+	"\rstop_on_error\x18\a \x01(\bR\vstopOnError\x12\x16\n" +
+	"\x06paused\x18\b \x01(\bR\x06paused\x12\x12\n" +
+	"\x04role\x18\t \x01(\tR\x04role\x12\"\n" +
+	"\rcreated_at_ms\x18\n" +
+	" \x01(\x03R\vcreatedAtMs\x12\x1e\n" +
+	"\vnext_run_ms\x18\v \x01(\x03R\tnextRunMs\x12\x12\n" +
+	"\x04runs\x18\f \x01(\x04R\x04runs\x12C\n" +
+	"\vlast_result\x18\r \x01(\v2\".fateark.proto.scheduler.JobResultR\n" +
+	"lastResultB\n" +
+	"\n" +
+	"\bschedule\"\x84\x02\n" +
+	"\x10CreateJobRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x04cron\x18\x02 \x01(\tH\x00R\x04cron\x12!\n" +
+	"\vinterval_ms\x18\x03 \x01(\x04H\x00R\n" +
+	"intervalMs\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12?\n" +
+	"\bcommands\x18\x05 \x03(\v2#.fateark.proto.command.BatchCommandR\bcommands\x12\"\n" +
+	"\rstop_on_error\x18\x06 \x01(\bR\vstopOnError\x12\x16\n" +
+	"\x06paused\x18\a \x01(\bR\x06pausedB\n" +
+	"\n" +
+	"\bschedule\"\x11\n" +
+	"\x0fListJobsRequest\"D\n" +
+	"\x10ListJobsResponse\x120\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x1c.fateark.proto.scheduler.JobR\x04jobs\"\x1f\n" +
+	"\rGetJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x0fPauseJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06paused\x18\x02 \x01(\bR\x06paused\"\"\n" +
+	"\x10DeleteJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xce\x03\n" +
+	"\x10SchedulerService\x12T\n" +
+	"\tCreateJob\x12).fateark.proto.scheduler.CreateJobRequest\x1a\x1c.fateark.proto.scheduler.Job\x12_\n" +
+	"\bListJobs\x12(.fateark.proto.scheduler.ListJobsRequest\x1a).fateark.proto.scheduler.ListJobsResponse\x12N\n" +
+	"\x06GetJob\x12&.fateark.proto.scheduler.GetJobRequest\x1a\x1c.fateark.proto.scheduler.Job\x12R\n" +
+	"\bPauseJob\x12(.fateark.proto.scheduler.PauseJobRequest\x1a\x1c.fateark.proto.scheduler.Job\x12_\n" +
+	"\tDeleteJob\x12).fateark.proto.scheduler.DeleteJobRequest\x1a'.fateark.proto.response.GeneralResponseBCZAgithub.com/Yeah114/tempest-core/network_api/scheduler;schedulerpbb\x06proto3"
+
+var (
+	file_proto_scheduler_proto_rawDescOnce sync.Once
+	file_proto_scheduler_proto_rawDescData []byte
+)
+
+func file_proto_scheduler_proto_rawDescGZIP() []byte {
+	file_proto_scheduler_proto_rawDescOnce.Do(func() {
+		file_proto_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_scheduler_proto_rawDesc), len(file_proto_scheduler_proto_rawDesc)))
+	})
+	return file_proto_scheduler_proto_rawDescData
+}
+
+var file_proto_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_scheduler_proto_goTypes = []any{
+	(JobResult_Status)(0),            // 0: fateark.proto.scheduler.JobResult.Status
+	(*JobResult)(nil),                // 1: fateark.proto.scheduler.JobResult
+	(*Job)(nil),                      // 2: fateark.proto.scheduler.Job
+	(*CreateJobRequest)(nil),         // 3: fateark.proto.scheduler.CreateJobRequest
+	(*ListJobsRequest)(nil),          // 4: fateark.proto.scheduler.ListJobsRequest
+	(*ListJobsResponse)(nil),         // 5: fateark.proto.scheduler.ListJobsResponse
+	(*GetJobRequest)(nil),            // 6: fateark.proto.scheduler.GetJobRequest
+	(*PauseJobRequest)(nil),          // 7: fateark.proto.scheduler.PauseJobRequest
+	(*DeleteJobRequest)(nil),         // 8: fateark.proto.scheduler.DeleteJobRequest
+	(*command.BatchCommand)(nil),     // 9: fateark.proto.command.BatchCommand
+	(*response.GeneralResponse)(nil), // 10: fateark.proto.response.GeneralResponse
+}
+var file_proto_scheduler_proto_depIdxs = []int32{
+	0,  // 0: fateark.proto.scheduler.JobResult.status:type_name -> fateark.proto.scheduler.JobResult.Status
+	9,  // 1: fateark.proto.scheduler.Job.commands:type_name -> fateark.proto.command.BatchCommand
+	1,  // 2: fateark.proto.scheduler.Job.last_result:type_name -> fateark.proto.scheduler.JobResult
+	9,  // 3: fateark.proto.scheduler.CreateJobRequest.commands:type_name -> fateark.proto.command.BatchCommand
+	2,  // 4: fateark.proto.scheduler.ListJobsResponse.jobs:type_name -> fateark.proto.scheduler.Job
+	3,  // 5: fateark.proto.scheduler.SchedulerService.CreateJob:input_type -> fateark.proto.scheduler.CreateJobRequest
+	4,  // 6: fateark.proto.scheduler.SchedulerService.ListJobs:input_type -> fateark.proto.scheduler.ListJobsRequest
+	6,  // 7: fateark.proto.scheduler.SchedulerService.GetJob:input_type -> fateark.proto.scheduler.GetJobRequest
+	7,  // 8: fateark.proto.scheduler.SchedulerService.PauseJob:input_type -> fateark.proto.scheduler.PauseJobRequest
+	8,  // 9: fateark.proto.scheduler.SchedulerService.DeleteJob:input_type -> fateark.proto.scheduler.DeleteJobRequest
+	2,  // 10: fateark.proto.scheduler.SchedulerService.CreateJob:output_type -> fateark.proto.scheduler.Job
+	5,  // 11: fateark.proto.scheduler.SchedulerService.ListJobs:output_type -> fateark.proto.scheduler.ListJobsResponse
+	2,  // 12: fateark.proto.scheduler.SchedulerService.GetJob:output_type -> fateark.proto.scheduler.Job
+	2,  // 13: fateark.proto.scheduler.SchedulerService.PauseJob:output_type -> fateark.proto.scheduler.Job
+	10, // 14: fateark.proto.scheduler.SchedulerService.DeleteJob:output_type -> fateark.proto.response.GeneralResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_scheduler_proto_init() }
+func file_proto_scheduler_proto_init() {
+	if File_proto_scheduler_proto != nil {
+		return
+	}
+	file_proto_scheduler_proto_msgTypes[1].OneofWrappers = []any{
+		(*Job_Cron)(nil),
+		(*Job_IntervalMs)(nil),
+	}
+	file_proto_scheduler_proto_msgTypes[2].OneofWrappers = []any{
+		(*CreateJobRequest_Cron)(nil),
+		(*CreateJobRequest_IntervalMs)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_scheduler_proto_rawDesc), len(file_proto_scheduler_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_scheduler_proto_goTypes,
+		DependencyIndexes: file_proto_scheduler_proto_depIdxs,
+		EnumInfos:         file_proto_scheduler_proto_enumTypes,
+		MessageInfos:      file_proto_scheduler_proto_msgTypes,
+	}.Build()
+	File_proto_scheduler_proto = out.File
+	file_proto_scheduler_proto_goTypes = nil
+	file_proto_scheduler_proto_depIdxs = nil
+}
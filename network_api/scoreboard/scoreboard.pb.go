@@ -0,0 +1,881 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/scoreboard.proto
+
+package scoreboardpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScoreSource int32
+
+const (
+	ScoreSource_CACHE   ScoreSource = 0
+	ScoreSource_COMMAND ScoreSource = 1
+)
+
+// Enum value maps for ScoreSource.
+var (
+	ScoreSource_name = map[int32]string{
+		0: "CACHE",
+		1: "COMMAND",
+	}
+	ScoreSource_value = map[string]int32{
+		"CACHE":   0,
+		"COMMAND": 1,
+	}
+)
+
+func (x ScoreSource) Enum() *ScoreSource {
+	p := new(ScoreSource)
+	*p = x
+	return p
+}
+
+func (x ScoreSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoreSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scoreboard_proto_enumTypes[0].Descriptor()
+}
+
+func (ScoreSource) Type() protoreflect.EnumType {
+	return &file_proto_scoreboard_proto_enumTypes[0]
+}
+
+func (x ScoreSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoreSource.Descriptor instead.
+func (ScoreSource) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{0}
+}
+
+type Score_Identity int32
+
+const (
+	Score_UNKNOWN     Score_Identity = 0
+	Score_PLAYER      Score_Identity = 1
+	Score_ENTITY      Score_Identity = 2
+	Score_FAKE_PLAYER Score_Identity = 3
+)
+
+// Enum value maps for Score_Identity.
+var (
+	Score_Identity_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "PLAYER",
+		2: "ENTITY",
+		3: "FAKE_PLAYER",
+	}
+	Score_Identity_value = map[string]int32{
+		"UNKNOWN":     0,
+		"PLAYER":      1,
+		"ENTITY":      2,
+		"FAKE_PLAYER": 3,
+	}
+)
+
+func (x Score_Identity) Enum() *Score_Identity {
+	p := new(Score_Identity)
+	*p = x
+	return p
+}
+
+func (x Score_Identity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Score_Identity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scoreboard_proto_enumTypes[1].Descriptor()
+}
+
+func (Score_Identity) Type() protoreflect.EnumType {
+	return &file_proto_scoreboard_proto_enumTypes[1]
+}
+
+func (x Score_Identity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Score_Identity.Descriptor instead.
+func (Score_Identity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Objective struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Criteria      string                 `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	DisplaySlots  []string               `protobuf:"bytes,4,rep,name=display_slots,json=displaySlots,proto3" json:"display_slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Objective) Reset() {
+	*x = Objective{}
+	mi := &file_proto_scoreboard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Objective) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Objective) ProtoMessage() {}
+
+func (x *Objective) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Objective.ProtoReflect.Descriptor instead.
+func (*Objective) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Objective) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Objective) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Objective) GetCriteria() string {
+	if x != nil {
+		return x.Criteria
+	}
+	return ""
+}
+
+func (x *Objective) GetDisplaySlots() []string {
+	if x != nil {
+		return x.DisplaySlots
+	}
+	return nil
+}
+
+type Score struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Objective      string                 `protobuf:"bytes,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value          int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Identity       Score_Identity         `protobuf:"varint,4,opt,name=identity,proto3,enum=fateark.proto.scoreboard.Score_Identity" json:"identity,omitempty"`
+	EntityUniqueId int64                  `protobuf:"varint,5,opt,name=entity_unique_id,json=entityUniqueId,proto3" json:"entity_unique_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_proto_scoreboard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Score) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *Score) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Score) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Score) GetIdentity() Score_Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return Score_UNKNOWN
+}
+
+func (x *Score) GetEntityUniqueId() int64 {
+	if x != nil {
+		return x.EntityUniqueId
+	}
+	return 0
+}
+
+type ListObjectivesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectivesRequest) Reset() {
+	*x = ListObjectivesRequest{}
+	mi := &file_proto_scoreboard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectivesRequest) ProtoMessage() {}
+
+func (x *ListObjectivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectivesRequest.ProtoReflect.Descriptor instead.
+func (*ListObjectivesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{2}
+}
+
+type ListObjectivesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objectives    []*Objective           `protobuf:"bytes,1,rep,name=objectives,proto3" json:"objectives,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectivesResponse) Reset() {
+	*x = ListObjectivesResponse{}
+	mi := &file_proto_scoreboard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectivesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectivesResponse) ProtoMessage() {}
+
+func (x *ListObjectivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectivesResponse.ProtoReflect.Descriptor instead.
+func (*ListObjectivesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListObjectivesResponse) GetObjectives() []*Objective {
+	if x != nil {
+		return x.Objectives
+	}
+	return nil
+}
+
+type GetScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objective     string                 `protobuf:"bytes,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Refresh       bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreRequest) Reset() {
+	*x = GetScoreRequest{}
+	mi := &file_proto_scoreboard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreRequest) ProtoMessage() {}
+
+func (x *GetScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreRequest.ProtoReflect.Descriptor instead.
+func (*GetScoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetScoreRequest) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *GetScoreRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GetScoreRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type GetScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Source        ScoreSource            `protobuf:"varint,3,opt,name=source,proto3,enum=fateark.proto.scoreboard.ScoreSource" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreResponse) Reset() {
+	*x = GetScoreResponse{}
+	mi := &file_proto_scoreboard_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreResponse) ProtoMessage() {}
+
+func (x *GetScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreResponse.ProtoReflect.Descriptor instead.
+func (*GetScoreResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetScoreResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *GetScoreResponse) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GetScoreResponse) GetSource() ScoreSource {
+	if x != nil {
+		return x.Source
+	}
+	return ScoreSource_CACHE
+}
+
+type SetScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objective     string                 `protobuf:"bytes,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetScoreRequest) Reset() {
+	*x = SetScoreRequest{}
+	mi := &file_proto_scoreboard_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScoreRequest) ProtoMessage() {}
+
+func (x *SetScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScoreRequest.ProtoReflect.Descriptor instead.
+func (*SetScoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetScoreRequest) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *SetScoreRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SetScoreRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type AddScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objective     string                 `protobuf:"bytes,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddScoreRequest) Reset() {
+	*x = AddScoreRequest{}
+	mi := &file_proto_scoreboard_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddScoreRequest) ProtoMessage() {}
+
+func (x *AddScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddScoreRequest.ProtoReflect.Descriptor instead.
+func (*AddScoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddScoreRequest) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *AddScoreRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AddScoreRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type ScoreValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreValue) Reset() {
+	*x = ScoreValue{}
+	mi := &file_proto_scoreboard_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreValue) ProtoMessage() {}
+
+func (x *ScoreValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreValue.ProtoReflect.Descriptor instead.
+func (*ScoreValue) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScoreValue) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type GetObjectiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objective     string                 `protobuf:"bytes,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	Refresh       bool                   `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectiveRequest) Reset() {
+	*x = GetObjectiveRequest{}
+	mi := &file_proto_scoreboard_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectiveRequest) ProtoMessage() {}
+
+func (x *GetObjectiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectiveRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetObjectiveRequest) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *GetObjectiveRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type GetObjectiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objective     string                 `protobuf:"bytes,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	Scores        []*Score               `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	Source        ScoreSource            `protobuf:"varint,3,opt,name=source,proto3,enum=fateark.proto.scoreboard.ScoreSource" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectiveResponse) Reset() {
+	*x = GetObjectiveResponse{}
+	mi := &file_proto_scoreboard_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectiveResponse) ProtoMessage() {}
+
+func (x *GetObjectiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scoreboard_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectiveResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scoreboard_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetObjectiveResponse) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *GetObjectiveResponse) GetScores() []*Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *GetObjectiveResponse) GetSource() ScoreSource {
+	if x != nil {
+		return x.Source
+	}
+	return ScoreSource_CACHE
+}
+
+var File_proto_scoreboard_proto protoreflect.FileDescriptor
+
+const file_proto_scoreboard_proto_rawDesc = "" +
+	"\n" +
+	"\x16proto/scoreboard.proto\x12\x18fateark.proto.scoreboard\"\x83\x01\n" +
+	"\tObjective\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1a\n" +
+	"\bcriteria\x18\x03 \x01(\tR\bcriteria\x12#\n" +
+	"\rdisplay_slots\x18\x04 \x03(\tR\fdisplaySlots\"\x81\x02\n" +
+	"\x05Score\x12\x1c\n" +
+	"\tobjective\x18\x01 \x01(\tR\tobjective\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x05R\x05value\x12D\n" +
+	"\bidentity\x18\x04 \x01(\x0e2(.fateark.proto.scoreboard.Score.IdentityR\bidentity\x12(\n" +
+	"\x10entity_unique_id\x18\x05 \x01(\x03R\x0eentityUniqueId\"@\n" +
+	"\bIdentity\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\n" +
+	"\n" +
+	"\x06PLAYER\x10\x01\x12\n" +
+	"\n" +
+	"\x06ENTITY\x10\x02\x12\x0f\n" +
+	"\vFAKE_PLAYER\x10\x03\"\x17\n" +
+	"\x15ListObjectivesRequest\"]\n" +
+	"\x16ListObjectivesResponse\x12C\n" +
+	"\n" +
+	"objectives\x18\x01 \x03(\v2#.fateark.proto.scoreboard.ObjectiveR\n" +
+	"objectives\"a\n" +
+	"\x0fGetScoreRequest\x12\x1c\n" +
+	"\tobjective\x18\x01 \x01(\tR\tobjective\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"}\n" +
+	"\x10GetScoreResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value\x12=\n" +
+	"\x06source\x18\x03 \x01(\x0e2%.fateark.proto.scoreboard.ScoreSourceR\x06source\"]\n" +
+	"\x0fSetScoreRequest\x12\x1c\n" +
+	"\tobjective\x18\x01 \x01(\tR\tobjective\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x05R\x05value\"]\n" +
+	"\x0fAddScoreRequest\x12\x1c\n" +
+	"\tobjective\x18\x01 \x01(\tR\tobjective\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\"\"\n" +
+	"\n" +
+	"ScoreValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\"M\n" +
+	"\x13GetObjectiveRequest\x12\x1c\n" +
+	"\tobjective\x18\x01 \x01(\tR\tobjective\x12\x18\n" +
+	"\arefresh\x18\x02 \x01(\bR\arefresh\"\xac\x01\n" +
+	"\x14GetObjectiveResponse\x12\x1c\n" +
+	"\tobjective\x18\x01 \x01(\tR\tobjective\x127\n" +
+	"\x06scores\x18\x02 \x03(\v2\x1f.fateark.proto.scoreboard.ScoreR\x06scores\x12=\n" +
+	"\x06source\x18\x03 \x01(\x0e2%.fateark.proto.scoreboard.ScoreSourceR\x06source*%\n" +
+	"\vScoreSource\x12\t\n" +
+	"\x05CACHE\x10\x00\x12\v\n" +
+	"\aCOMMAND\x10\x012\x94\x04\n" +
+	"\x11ScoreboardService\x12s\n" +
+	"\x0eListObjectives\x12/.fateark.proto.scoreboard.ListObjectivesRequest\x1a0.fateark.proto.scoreboard.ListObjectivesResponse\x12a\n" +
+	"\bGetScore\x12).fateark.proto.scoreboard.GetScoreRequest\x1a*.fateark.proto.scoreboard.GetScoreResponse\x12[\n" +
+	"\bSetScore\x12).fateark.proto.scoreboard.SetScoreRequest\x1a$.fateark.proto.scoreboard.ScoreValue\x12[\n" +
+	"\bAddScore\x12).fateark.proto.scoreboard.AddScoreRequest\x1a$.fateark.proto.scoreboard.ScoreValue\x12m\n" +
+	"\fGetObjective\x12-.fateark.proto.scoreboard.GetObjectiveRequest\x1a..fateark.proto.scoreboard.GetObjectiveResponseBEZCgithub.com/Yeah114/tempest-core/network_api/scoreboard;scoreboardpbb\x06proto3"
+
+var (
+	file_proto_scoreboard_proto_rawDescOnce sync.Once
+	file_proto_scoreboard_proto_rawDescData []byte
+)
+
+func file_proto_scoreboard_proto_rawDescGZIP() []byte {
+	file_proto_scoreboard_proto_rawDescOnce.Do(func() {
+		file_proto_scoreboard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_scoreboard_proto_rawDesc), len(file_proto_scoreboard_proto_rawDesc)))
+	})
+	return file_proto_scoreboard_proto_rawDescData
+}
+
+var file_proto_scoreboard_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_scoreboard_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_scoreboard_proto_goTypes = []any{
+	(ScoreSource)(0),               // 0: fateark.proto.scoreboard.ScoreSource
+	(Score_Identity)(0),            // 1: fateark.proto.scoreboard.Score.Identity
+	(*Objective)(nil),              // 2: fateark.proto.scoreboard.Objective
+	(*Score)(nil),                  // 3: fateark.proto.scoreboard.Score
+	(*ListObjectivesRequest)(nil),  // 4: fateark.proto.scoreboard.ListObjectivesRequest
+	(*ListObjectivesResponse)(nil), // 5: fateark.proto.scoreboard.ListObjectivesResponse
+	(*GetScoreRequest)(nil),        // 6: fateark.proto.scoreboard.GetScoreRequest
+	(*GetScoreResponse)(nil),       // 7: fateark.proto.scoreboard.GetScoreResponse
+	(*SetScoreRequest)(nil),        // 8: fateark.proto.scoreboard.SetScoreRequest
+	(*AddScoreRequest)(nil),        // 9: fateark.proto.scoreboard.AddScoreRequest
+	(*ScoreValue)(nil),             // 10: fateark.proto.scoreboard.ScoreValue
+	(*GetObjectiveRequest)(nil),    // 11: fateark.proto.scoreboard.GetObjectiveRequest
+	(*GetObjectiveResponse)(nil),   // 12: fateark.proto.scoreboard.GetObjectiveResponse
+}
+var file_proto_scoreboard_proto_depIdxs = []int32{
+	1,  // 0: fateark.proto.scoreboard.Score.identity:type_name -> fateark.proto.scoreboard.Score.Identity
+	2,  // 1: fateark.proto.scoreboard.ListObjectivesResponse.objectives:type_name -> fateark.proto.scoreboard.Objective
+	0,  // 2: fateark.proto.scoreboard.GetScoreResponse.source:type_name -> fateark.proto.scoreboard.ScoreSource
+	3,  // 3: fateark.proto.scoreboard.GetObjectiveResponse.scores:type_name -> fateark.proto.scoreboard.Score
+	0,  // 4: fateark.proto.scoreboard.GetObjectiveResponse.source:type_name -> fateark.proto.scoreboard.ScoreSource
+	4,  // 5: fateark.proto.scoreboard.ScoreboardService.ListObjectives:input_type -> fateark.proto.scoreboard.ListObjectivesRequest
+	6,  // 6: fateark.proto.scoreboard.ScoreboardService.GetScore:input_type -> fateark.proto.scoreboard.GetScoreRequest
+	8,  // 7: fateark.proto.scoreboard.ScoreboardService.SetScore:input_type -> fateark.proto.scoreboard.SetScoreRequest
+	9,  // 8: fateark.proto.scoreboard.ScoreboardService.AddScore:input_type -> fateark.proto.scoreboard.AddScoreRequest
+	11, // 9: fateark.proto.scoreboard.ScoreboardService.GetObjective:input_type -> fateark.proto.scoreboard.GetObjectiveRequest
+	5,  // 10: fateark.proto.scoreboard.ScoreboardService.ListObjectives:output_type -> fateark.proto.scoreboard.ListObjectivesResponse
+	7,  // 11: fateark.proto.scoreboard.ScoreboardService.GetScore:output_type -> fateark.proto.scoreboard.GetScoreResponse
+	10, // 12: fateark.proto.scoreboard.ScoreboardService.SetScore:output_type -> fateark.proto.scoreboard.ScoreValue
+	10, // 13: fateark.proto.scoreboard.ScoreboardService.AddScore:output_type -> fateark.proto.scoreboard.ScoreValue
+	12, // 14: fateark.proto.scoreboard.ScoreboardService.GetObjective:output_type -> fateark.proto.scoreboard.GetObjectiveResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_scoreboard_proto_init() }
+func file_proto_scoreboard_proto_init() {
+	if File_proto_scoreboard_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_scoreboard_proto_rawDesc), len(file_proto_scoreboard_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_scoreboard_proto_goTypes,
+		DependencyIndexes: file_proto_scoreboard_proto_depIdxs,
+		EnumInfos:         file_proto_scoreboard_proto_enumTypes,
+		MessageInfos:      file_proto_scoreboard_proto_msgTypes,
+	}.Build()
+	File_proto_scoreboard_proto = out.File
+	file_proto_scoreboard_proto_goTypes = nil
+	file_proto_scoreboard_proto_depIdxs = nil
+}
@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: proto/scoreboard.proto
+
+package scoreboardpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ScoreboardService_ListObjectives_FullMethodName = "/fateark.proto.scoreboard.ScoreboardService/ListObjectives"
+	ScoreboardService_GetScore_FullMethodName       = "/fateark.proto.scoreboard.ScoreboardService/GetScore"
+	ScoreboardService_SetScore_FullMethodName       = "/fateark.proto.scoreboard.ScoreboardService/SetScore"
+	ScoreboardService_AddScore_FullMethodName       = "/fateark.proto.scoreboard.ScoreboardService/AddScore"
+	ScoreboardService_GetObjective_FullMethodName   = "/fateark.proto.scoreboard.ScoreboardService/GetObjective"
+)
+
+// ScoreboardServiceClient is the client API for ScoreboardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScoreboardServiceClient interface {
+	ListObjectives(ctx context.Context, in *ListObjectivesRequest, opts ...grpc.CallOption) (*ListObjectivesResponse, error)
+	GetScore(ctx context.Context, in *GetScoreRequest, opts ...grpc.CallOption) (*GetScoreResponse, error)
+	SetScore(ctx context.Context, in *SetScoreRequest, opts ...grpc.CallOption) (*ScoreValue, error)
+	AddScore(ctx context.Context, in *AddScoreRequest, opts ...grpc.CallOption) (*ScoreValue, error)
+	GetObjective(ctx context.Context, in *GetObjectiveRequest, opts ...grpc.CallOption) (*GetObjectiveResponse, error)
+}
+
+type scoreboardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScoreboardServiceClient(cc grpc.ClientConnInterface) ScoreboardServiceClient {
+	return &scoreboardServiceClient{cc}
+}
+
+func (c *scoreboardServiceClient) ListObjectives(ctx context.Context, in *ListObjectivesRequest, opts ...grpc.CallOption) (*ListObjectivesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObjectivesResponse)
+	err := c.cc.Invoke(ctx, ScoreboardService_ListObjectives_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoreboardServiceClient) GetScore(ctx context.Context, in *GetScoreRequest, opts ...grpc.CallOption) (*GetScoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScoreResponse)
+	err := c.cc.Invoke(ctx, ScoreboardService_GetScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoreboardServiceClient) SetScore(ctx context.Context, in *SetScoreRequest, opts ...grpc.CallOption) (*ScoreValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScoreValue)
+	err := c.cc.Invoke(ctx, ScoreboardService_SetScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoreboardServiceClient) AddScore(ctx context.Context, in *AddScoreRequest, opts ...grpc.CallOption) (*ScoreValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScoreValue)
+	err := c.cc.Invoke(ctx, ScoreboardService_AddScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoreboardServiceClient) GetObjective(ctx context.Context, in *GetObjectiveRequest, opts ...grpc.CallOption) (*GetObjectiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectiveResponse)
+	err := c.cc.Invoke(ctx, ScoreboardService_GetObjective_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScoreboardServiceServer is the server API for ScoreboardService service.
+// All implementations must embed UnimplementedScoreboardServiceServer
+// for forward compatibility.
+type ScoreboardServiceServer interface {
+	ListObjectives(context.Context, *ListObjectivesRequest) (*ListObjectivesResponse, error)
+	GetScore(context.Context, *GetScoreRequest) (*GetScoreResponse, error)
+	SetScore(context.Context, *SetScoreRequest) (*ScoreValue, error)
+	AddScore(context.Context, *AddScoreRequest) (*ScoreValue, error)
+	GetObjective(context.Context, *GetObjectiveRequest) (*GetObjectiveResponse, error)
+	mustEmbedUnimplementedScoreboardServiceServer()
+}
+
+// UnimplementedScoreboardServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScoreboardServiceServer struct{}
+
+func (UnimplementedScoreboardServiceServer) ListObjectives(context.Context, *ListObjectivesRequest) (*ListObjectivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectives not implemented")
+}
+func (UnimplementedScoreboardServiceServer) GetScore(context.Context, *GetScoreRequest) (*GetScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScore not implemented")
+}
+func (UnimplementedScoreboardServiceServer) SetScore(context.Context, *SetScoreRequest) (*ScoreValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScore not implemented")
+}
+func (UnimplementedScoreboardServiceServer) AddScore(context.Context, *AddScoreRequest) (*ScoreValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScore not implemented")
+}
+func (UnimplementedScoreboardServiceServer) GetObjective(context.Context, *GetObjectiveRequest) (*GetObjectiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjective not implemented")
+}
+func (UnimplementedScoreboardServiceServer) mustEmbedUnimplementedScoreboardServiceServer() {}
+func (UnimplementedScoreboardServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeScoreboardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScoreboardServiceServer will
+// result in compilation errors.
+type UnsafeScoreboardServiceServer interface {
+	mustEmbedUnimplementedScoreboardServiceServer()
+}
+
+func RegisterScoreboardServiceServer(s grpc.ServiceRegistrar, srv ScoreboardServiceServer) {
+	// If the following call pancis, it indicates UnimplementedScoreboardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScoreboardService_ServiceDesc, srv)
+}
+
+func _ScoreboardService_ListObjectives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoreboardServiceServer).ListObjectives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoreboardService_ListObjectives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoreboardServiceServer).ListObjectives(ctx, req.(*ListObjectivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoreboardService_GetScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoreboardServiceServer).GetScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoreboardService_GetScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoreboardServiceServer).GetScore(ctx, req.(*GetScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoreboardService_SetScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoreboardServiceServer).SetScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoreboardService_SetScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoreboardServiceServer).SetScore(ctx, req.(*SetScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoreboardService_AddScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoreboardServiceServer).AddScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoreboardService_AddScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoreboardServiceServer).AddScore(ctx, req.(*AddScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoreboardService_GetObjective_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoreboardServiceServer).GetObjective(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoreboardService_GetObjective_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoreboardServiceServer).GetObjective(ctx, req.(*GetObjectiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScoreboardService_ServiceDesc is the grpc.ServiceDesc for ScoreboardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScoreboardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fateark.proto.scoreboard.ScoreboardService",
+	HandlerType: (*ScoreboardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListObjectives",
+			Handler:    _ScoreboardService_ListObjectives_Handler,
+		},
+		{
+			MethodName: "GetScore",
+			Handler:    _ScoreboardService_GetScore_Handler,
+		},
+		{
+			MethodName: "SetScore",
+			Handler:    _ScoreboardService_SetScore_Handler,
+		},
+		{
+			MethodName: "AddScore",
+			Handler:    _ScoreboardService_AddScore_Handler,
+		},
+		{
+			MethodName: "GetObjective",
+			Handler:    _ScoreboardService_GetObjective_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/scoreboard.proto",
+}
@@ -0,0 +1,693 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/tag.proto
+
+package tagpb
+
+import (
+	command "github.com/Yeah114/tempest-core/network_api/command"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TagTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*TagTarget_PlayerUuid
+	//	*TagTarget_Username
+	//	*TagTarget_Selector
+	//	*TagTarget_Spec
+	Target        isTagTarget_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagTarget) Reset() {
+	*x = TagTarget{}
+	mi := &file_proto_tag_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTarget) ProtoMessage() {}
+
+func (x *TagTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTarget.ProtoReflect.Descriptor instead.
+func (*TagTarget) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TagTarget) GetTarget() isTagTarget_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *TagTarget) GetPlayerUuid() string {
+	if x != nil {
+		if x, ok := x.Target.(*TagTarget_PlayerUuid); ok {
+			return x.PlayerUuid
+		}
+	}
+	return ""
+}
+
+func (x *TagTarget) GetUsername() string {
+	if x != nil {
+		if x, ok := x.Target.(*TagTarget_Username); ok {
+			return x.Username
+		}
+	}
+	return ""
+}
+
+func (x *TagTarget) GetSelector() string {
+	if x != nil {
+		if x, ok := x.Target.(*TagTarget_Selector); ok {
+			return x.Selector
+		}
+	}
+	return ""
+}
+
+func (x *TagTarget) GetSpec() *command.SelectorSpec {
+	if x != nil {
+		if x, ok := x.Target.(*TagTarget_Spec); ok {
+			return x.Spec
+		}
+	}
+	return nil
+}
+
+type isTagTarget_Target interface {
+	isTagTarget_Target()
+}
+
+type TagTarget_PlayerUuid struct {
+	PlayerUuid string `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3,oneof"`
+}
+
+type TagTarget_Username struct {
+	Username string `protobuf:"bytes,2,opt,name=username,proto3,oneof"`
+}
+
+type TagTarget_Selector struct {
+	Selector string `protobuf:"bytes,3,opt,name=selector,proto3,oneof"`
+}
+
+type TagTarget_Spec struct {
+	Spec *command.SelectorSpec `protobuf:"bytes,4,opt,name=spec,proto3,oneof"`
+}
+
+func (*TagTarget_PlayerUuid) isTagTarget_Target() {}
+
+func (*TagTarget_Username) isTagTarget_Target() {}
+
+func (*TagTarget_Selector) isTagTarget_Target() {}
+
+func (*TagTarget_Spec) isTagTarget_Target() {}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_tag_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTagsRequest) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_tag_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTagsResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ModifyTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Targets       []*TagTarget           `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Pacing        *command.CommandPacing `protobuf:"bytes,3,opt,name=pacing,proto3" json:"pacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyTagsRequest) Reset() {
+	*x = ModifyTagsRequest{}
+	mi := &file_proto_tag_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyTagsRequest) ProtoMessage() {}
+
+func (x *ModifyTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyTagsRequest.ProtoReflect.Descriptor instead.
+func (*ModifyTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ModifyTagsRequest) GetTargets() []*TagTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *ModifyTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ModifyTagsRequest) GetPacing() *command.CommandPacing {
+	if x != nil {
+		return x.Pacing
+	}
+	return nil
+}
+
+type TagChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetIndex   uint32                 `protobuf:"varint,1,opt,name=target_index,json=targetIndex,proto3" json:"target_index,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Affected      uint32                 `protobuf:"varint,4,opt,name=affected,proto3" json:"affected,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagChange) Reset() {
+	*x = TagChange{}
+	mi := &file_proto_tag_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagChange) ProtoMessage() {}
+
+func (x *TagChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagChange.ProtoReflect.Descriptor instead.
+func (*TagChange) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TagChange) GetTargetIndex() uint32 {
+	if x != nil {
+		return x.TargetIndex
+	}
+	return 0
+}
+
+func (x *TagChange) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagChange) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TagChange) GetAffected() uint32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *TagChange) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ModifyTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*TagChange           `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Succeeded     uint32                 `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        uint32                 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyTagsResponse) Reset() {
+	*x = ModifyTagsResponse{}
+	mi := &file_proto_tag_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyTagsResponse) ProtoMessage() {}
+
+func (x *ModifyTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyTagsResponse.ProtoReflect.Descriptor instead.
+func (*ModifyTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ModifyTagsResponse) GetChanges() []*TagChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ModifyTagsResponse) GetSucceeded() uint32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ModifyTagsResponse) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type FindTaggedPlayersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindTaggedPlayersRequest) Reset() {
+	*x = FindTaggedPlayersRequest{}
+	mi := &file_proto_tag_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindTaggedPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTaggedPlayersRequest) ProtoMessage() {}
+
+func (x *FindTaggedPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTaggedPlayersRequest.ProtoReflect.Descriptor instead.
+func (*FindTaggedPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindTaggedPlayersRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type TaggedPlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaggedPlayer) Reset() {
+	*x = TaggedPlayer{}
+	mi := &file_proto_tag_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaggedPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaggedPlayer) ProtoMessage() {}
+
+func (x *TaggedPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaggedPlayer.ProtoReflect.Descriptor instead.
+func (*TaggedPlayer) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TaggedPlayer) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TaggedPlayer) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type FindTaggedPlayersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*TaggedPlayer        `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindTaggedPlayersResponse) Reset() {
+	*x = FindTaggedPlayersResponse{}
+	mi := &file_proto_tag_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindTaggedPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindTaggedPlayersResponse) ProtoMessage() {}
+
+func (x *FindTaggedPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tag_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindTaggedPlayersResponse.ProtoReflect.Descriptor instead.
+func (*FindTaggedPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tag_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FindTaggedPlayersResponse) GetPlayers() []*TaggedPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+var File_proto_tag_proto protoreflect.FileDescriptor
+
+const file_proto_tag_proto_rawDesc = "" +
+	"\n" +
+	"\x0fproto/tag.proto\x12\x11fateark.proto.tag\x1a\x13proto/command.proto\"\xaf\x01\n" +
+	"\tTagTarget\x12!\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tH\x00R\n" +
+	"playerUuid\x12\x1c\n" +
+	"\busername\x18\x02 \x01(\tH\x00R\busername\x12\x1c\n" +
+	"\bselector\x18\x03 \x01(\tH\x00R\bselector\x129\n" +
+	"\x04spec\x18\x04 \x01(\v2#.fateark.proto.command.SelectorSpecH\x00R\x04specB\b\n" +
+	"\x06target\"2\n" +
+	"\x0fListTagsRequest\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\"B\n" +
+	"\x10ListTagsResponse\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"\x9d\x01\n" +
+	"\x11ModifyTagsRequest\x126\n" +
+	"\atargets\x18\x01 \x03(\v2\x1c.fateark.proto.tag.TagTargetR\atargets\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12<\n" +
+	"\x06pacing\x18\x03 \x01(\v2$.fateark.proto.command.CommandPacingR\x06pacing\"\x90\x01\n" +
+	"\tTagChange\x12!\n" +
+	"\ftarget_index\x18\x01 \x01(\rR\vtargetIndex\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x1a\n" +
+	"\baffected\x18\x04 \x01(\rR\baffected\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x82\x01\n" +
+	"\x12ModifyTagsResponse\x126\n" +
+	"\achanges\x18\x01 \x03(\v2\x1c.fateark.proto.tag.TagChangeR\achanges\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\rR\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\rR\x06failed\",\n" +
+	"\x18FindTaggedPlayersRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\">\n" +
+	"\fTaggedPlayer\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"V\n" +
+	"\x19FindTaggedPlayersResponse\x129\n" +
+	"\aplayers\x18\x01 \x03(\v2\x1f.fateark.proto.tag.TaggedPlayerR\aplayers2\x84\x03\n" +
+	"\n" +
+	"TagService\x12S\n" +
+	"\bListTags\x12\".fateark.proto.tag.ListTagsRequest\x1a#.fateark.proto.tag.ListTagsResponse\x12V\n" +
+	"\aAddTags\x12$.fateark.proto.tag.ModifyTagsRequest\x1a%.fateark.proto.tag.ModifyTagsResponse\x12Y\n" +
+	"\n" +
+	"RemoveTags\x12$.fateark.proto.tag.ModifyTagsRequest\x1a%.fateark.proto.tag.ModifyTagsResponse\x12n\n" +
+	"\x11FindTaggedPlayers\x12+.fateark.proto.tag.FindTaggedPlayersRequest\x1a,.fateark.proto.tag.FindTaggedPlayersResponseB7Z5github.com/Yeah114/tempest-core/network_api/tag;tagpbb\x06proto3"
+
+var (
+	file_proto_tag_proto_rawDescOnce sync.Once
+	file_proto_tag_proto_rawDescData []byte
+)
+
+func file_proto_tag_proto_rawDescGZIP() []byte {
+	file_proto_tag_proto_rawDescOnce.Do(func() {
+		file_proto_tag_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_tag_proto_rawDesc), len(file_proto_tag_proto_rawDesc)))
+	})
+	return file_proto_tag_proto_rawDescData
+}
+
+var file_proto_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_tag_proto_goTypes = []any{
+	(*TagTarget)(nil),                 // 0: fateark.proto.tag.TagTarget
+	(*ListTagsRequest)(nil),           // 1: fateark.proto.tag.ListTagsRequest
+	(*ListTagsResponse)(nil),          // 2: fateark.proto.tag.ListTagsResponse
+	(*ModifyTagsRequest)(nil),         // 3: fateark.proto.tag.ModifyTagsRequest
+	(*TagChange)(nil),                 // 4: fateark.proto.tag.TagChange
+	(*ModifyTagsResponse)(nil),        // 5: fateark.proto.tag.ModifyTagsResponse
+	(*FindTaggedPlayersRequest)(nil),  // 6: fateark.proto.tag.FindTaggedPlayersRequest
+	(*TaggedPlayer)(nil),              // 7: fateark.proto.tag.TaggedPlayer
+	(*FindTaggedPlayersResponse)(nil), // 8: fateark.proto.tag.FindTaggedPlayersResponse
+	(*command.SelectorSpec)(nil),      // 9: fateark.proto.command.SelectorSpec
+	(*command.CommandPacing)(nil),     // 10: fateark.proto.command.CommandPacing
+}
+var file_proto_tag_proto_depIdxs = []int32{
+	9,  // 0: fateark.proto.tag.TagTarget.spec:type_name -> fateark.proto.command.SelectorSpec
+	0,  // 1: fateark.proto.tag.ModifyTagsRequest.targets:type_name -> fateark.proto.tag.TagTarget
+	10, // 2: fateark.proto.tag.ModifyTagsRequest.pacing:type_name -> fateark.proto.command.CommandPacing
+	4,  // 3: fateark.proto.tag.ModifyTagsResponse.changes:type_name -> fateark.proto.tag.TagChange
+	7,  // 4: fateark.proto.tag.FindTaggedPlayersResponse.players:type_name -> fateark.proto.tag.TaggedPlayer
+	1,  // 5: fateark.proto.tag.TagService.ListTags:input_type -> fateark.proto.tag.ListTagsRequest
+	3,  // 6: fateark.proto.tag.TagService.AddTags:input_type -> fateark.proto.tag.ModifyTagsRequest
+	3,  // 7: fateark.proto.tag.TagService.RemoveTags:input_type -> fateark.proto.tag.ModifyTagsRequest
+	6,  // 8: fateark.proto.tag.TagService.FindTaggedPlayers:input_type -> fateark.proto.tag.FindTaggedPlayersRequest
+	2,  // 9: fateark.proto.tag.TagService.ListTags:output_type -> fateark.proto.tag.ListTagsResponse
+	5,  // 10: fateark.proto.tag.TagService.AddTags:output_type -> fateark.proto.tag.ModifyTagsResponse
+	5,  // 11: fateark.proto.tag.TagService.RemoveTags:output_type -> fateark.proto.tag.ModifyTagsResponse
+	8,  // 12: fateark.proto.tag.TagService.FindTaggedPlayers:output_type -> fateark.proto.tag.FindTaggedPlayersResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_tag_proto_init() }
+func file_proto_tag_proto_init() {
+	if File_proto_tag_proto != nil {
+		return
+	}
+	file_proto_tag_proto_msgTypes[0].OneofWrappers = []any{
+		(*TagTarget_PlayerUuid)(nil),
+		(*TagTarget_Username)(nil),
+		(*TagTarget_Selector)(nil),
+		(*TagTarget_Spec)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tag_proto_rawDesc), len(file_proto_tag_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_tag_proto_goTypes,
+		DependencyIndexes: file_proto_tag_proto_depIdxs,
+		MessageInfos:      file_proto_tag_proto_msgTypes,
+	}.Build()
+	File_proto_tag_proto = out.File
+	file_proto_tag_proto_goTypes = nil
+	file_proto_tag_proto_depIdxs = nil
+}
@@ -0,0 +1,1125 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.21.12
+// source: proto/world.proto
+
+package worldpb
+
+import (
+	response "github.com/Yeah114/tempest-core/network_api/response"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Difficulty int32
+
+const (
+	Difficulty_PEACEFUL Difficulty = 0
+	Difficulty_EASY     Difficulty = 1
+	Difficulty_NORMAL   Difficulty = 2
+	Difficulty_HARD     Difficulty = 3
+)
+
+// Enum value maps for Difficulty.
+var (
+	Difficulty_name = map[int32]string{
+		0: "PEACEFUL",
+		1: "EASY",
+		2: "NORMAL",
+		3: "HARD",
+	}
+	Difficulty_value = map[string]int32{
+		"PEACEFUL": 0,
+		"EASY":     1,
+		"NORMAL":   2,
+		"HARD":     3,
+	}
+)
+
+func (x Difficulty) Enum() *Difficulty {
+	p := new(Difficulty)
+	*p = x
+	return p
+}
+
+func (x Difficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Difficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_world_proto_enumTypes[0].Descriptor()
+}
+
+func (Difficulty) Type() protoreflect.EnumType {
+	return &file_proto_world_proto_enumTypes[0]
+}
+
+func (x Difficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Difficulty.Descriptor instead.
+func (Difficulty) EnumDescriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{0}
+}
+
+type Weather int32
+
+const (
+	Weather_CLEAR   Weather = 0
+	Weather_RAIN    Weather = 1
+	Weather_THUNDER Weather = 2
+)
+
+// Enum value maps for Weather.
+var (
+	Weather_name = map[int32]string{
+		0: "CLEAR",
+		1: "RAIN",
+		2: "THUNDER",
+	}
+	Weather_value = map[string]int32{
+		"CLEAR":   0,
+		"RAIN":    1,
+		"THUNDER": 2,
+	}
+)
+
+func (x Weather) Enum() *Weather {
+	p := new(Weather)
+	*p = x
+	return p
+}
+
+func (x Weather) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weather) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_world_proto_enumTypes[1].Descriptor()
+}
+
+func (Weather) Type() protoreflect.EnumType {
+	return &file_proto_world_proto_enumTypes[1]
+}
+
+func (x Weather) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weather.Descriptor instead.
+func (Weather) EnumDescriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{1}
+}
+
+type TimePreset int32
+
+const (
+	TimePreset_DAY      TimePreset = 0
+	TimePreset_NOON     TimePreset = 1
+	TimePreset_SUNSET   TimePreset = 2
+	TimePreset_NIGHT    TimePreset = 3
+	TimePreset_MIDNIGHT TimePreset = 4
+	TimePreset_SUNRISE  TimePreset = 5
+)
+
+// Enum value maps for TimePreset.
+var (
+	TimePreset_name = map[int32]string{
+		0: "DAY",
+		1: "NOON",
+		2: "SUNSET",
+		3: "NIGHT",
+		4: "MIDNIGHT",
+		5: "SUNRISE",
+	}
+	TimePreset_value = map[string]int32{
+		"DAY":      0,
+		"NOON":     1,
+		"SUNSET":   2,
+		"NIGHT":    3,
+		"MIDNIGHT": 4,
+		"SUNRISE":  5,
+	}
+)
+
+func (x TimePreset) Enum() *TimePreset {
+	p := new(TimePreset)
+	*p = x
+	return p
+}
+
+func (x TimePreset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimePreset) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_world_proto_enumTypes[2].Descriptor()
+}
+
+func (TimePreset) Type() protoreflect.EnumType {
+	return &file_proto_world_proto_enumTypes[2]
+}
+
+func (x TimePreset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimePreset.Descriptor instead.
+func (TimePreset) EnumDescriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{2}
+}
+
+type GameRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*GameRule_BoolValue
+	//	*GameRule_IntValue
+	//	*GameRule_FloatValue
+	Value         isGameRule_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameRule) Reset() {
+	*x = GameRule{}
+	mi := &file_proto_world_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRule) ProtoMessage() {}
+
+func (x *GameRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRule.ProtoReflect.Descriptor instead.
+func (*GameRule) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GameRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameRule) GetValue() isGameRule_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GameRule) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*GameRule_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *GameRule) GetIntValue() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*GameRule_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *GameRule) GetFloatValue() float32 {
+	if x != nil {
+		if x, ok := x.Value.(*GameRule_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+type isGameRule_Value interface {
+	isGameRule_Value()
+}
+
+type GameRule_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type GameRule_IntValue struct {
+	IntValue int32 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type GameRule_FloatValue struct {
+	FloatValue float32 `protobuf:"fixed32,4,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+func (*GameRule_BoolValue) isGameRule_Value() {}
+
+func (*GameRule_IntValue) isGameRule_Value() {}
+
+func (*GameRule_FloatValue) isGameRule_Value() {}
+
+type GetWorldInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldInfoRequest) Reset() {
+	*x = GetWorldInfoRequest{}
+	mi := &file_proto_world_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldInfoRequest) ProtoMessage() {}
+
+func (x *GetWorldInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetWorldInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{1}
+}
+
+type WorldInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// time is the absolute world time in ticks; day_time wraps at 24000.
+	Time           int32       `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	DayTime        int32       `protobuf:"varint,2,opt,name=day_time,json=dayTime,proto3" json:"day_time,omitempty"`
+	DayTimePercent float32     `protobuf:"fixed32,3,opt,name=day_time_percent,json=dayTimePercent,proto3" json:"day_time_percent,omitempty"`
+	CurrentTick    int64       `protobuf:"varint,4,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty"`
+	Difficulty     Difficulty  `protobuf:"varint,5,opt,name=difficulty,proto3,enum=fateark.proto.world.Difficulty" json:"difficulty,omitempty"`
+	GameMode       int32       `protobuf:"varint,6,opt,name=game_mode,json=gameMode,proto3" json:"game_mode,omitempty"`
+	GameRules      []*GameRule `protobuf:"bytes,7,rep,name=game_rules,json=gameRules,proto3" json:"game_rules,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorldInfo) Reset() {
+	*x = WorldInfo{}
+	mi := &file_proto_world_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldInfo) ProtoMessage() {}
+
+func (x *WorldInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldInfo.ProtoReflect.Descriptor instead.
+func (*WorldInfo) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorldInfo) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *WorldInfo) GetDayTime() int32 {
+	if x != nil {
+		return x.DayTime
+	}
+	return 0
+}
+
+func (x *WorldInfo) GetDayTimePercent() float32 {
+	if x != nil {
+		return x.DayTimePercent
+	}
+	return 0
+}
+
+func (x *WorldInfo) GetCurrentTick() int64 {
+	if x != nil {
+		return x.CurrentTick
+	}
+	return 0
+}
+
+func (x *WorldInfo) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_PEACEFUL
+}
+
+func (x *WorldInfo) GetGameMode() int32 {
+	if x != nil {
+		return x.GameMode
+	}
+	return 0
+}
+
+func (x *WorldInfo) GetGameRules() []*GameRule {
+	if x != nil {
+		return x.GameRules
+	}
+	return nil
+}
+
+type GetGameRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameRuleRequest) Reset() {
+	*x = GetGameRuleRequest{}
+	mi := &file_proto_world_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRuleRequest) ProtoMessage() {}
+
+func (x *GetGameRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGameRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetGameRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *GameRule              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGameRuleRequest) Reset() {
+	*x = SetGameRuleRequest{}
+	mi := &file_proto_world_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGameRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameRuleRequest) ProtoMessage() {}
+
+func (x *SetGameRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetGameRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetGameRuleRequest) GetRule() *GameRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type SetTimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Time:
+	//
+	//	*SetTimeRequest_Ticks
+	//	*SetTimeRequest_Preset
+	Time isSetTimeRequest_Time `protobuf_oneof:"time"`
+	// add adds ticks to the current time instead of setting it.
+	Add           bool `protobuf:"varint,3,opt,name=add,proto3" json:"add,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimeRequest) Reset() {
+	*x = SetTimeRequest{}
+	mi := &file_proto_world_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimeRequest) ProtoMessage() {}
+
+func (x *SetTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimeRequest.ProtoReflect.Descriptor instead.
+func (*SetTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetTimeRequest) GetTime() isSetTimeRequest_Time {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SetTimeRequest) GetTicks() int32 {
+	if x != nil {
+		if x, ok := x.Time.(*SetTimeRequest_Ticks); ok {
+			return x.Ticks
+		}
+	}
+	return 0
+}
+
+func (x *SetTimeRequest) GetPreset() TimePreset {
+	if x != nil {
+		if x, ok := x.Time.(*SetTimeRequest_Preset); ok {
+			return x.Preset
+		}
+	}
+	return TimePreset_DAY
+}
+
+func (x *SetTimeRequest) GetAdd() bool {
+	if x != nil {
+		return x.Add
+	}
+	return false
+}
+
+type isSetTimeRequest_Time interface {
+	isSetTimeRequest_Time()
+}
+
+type SetTimeRequest_Ticks struct {
+	Ticks int32 `protobuf:"varint,1,opt,name=ticks,proto3,oneof"`
+}
+
+type SetTimeRequest_Preset struct {
+	Preset TimePreset `protobuf:"varint,2,opt,name=preset,proto3,enum=fateark.proto.world.TimePreset,oneof"`
+}
+
+func (*SetTimeRequest_Ticks) isSetTimeRequest_Time() {}
+
+func (*SetTimeRequest_Preset) isSetTimeRequest_Time() {}
+
+type GetWeatherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeatherRequest) Reset() {
+	*x = GetWeatherRequest{}
+	mi := &file_proto_world_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeatherRequest) ProtoMessage() {}
+
+func (x *GetWeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeatherRequest.ProtoReflect.Descriptor instead.
+func (*GetWeatherRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{6}
+}
+
+type GetWeatherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weather       Weather                `protobuf:"varint,1,opt,name=weather,proto3,enum=fateark.proto.world.Weather" json:"weather,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeatherResponse) Reset() {
+	*x = GetWeatherResponse{}
+	mi := &file_proto_world_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeatherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeatherResponse) ProtoMessage() {}
+
+func (x *GetWeatherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeatherResponse.ProtoReflect.Descriptor instead.
+func (*GetWeatherResponse) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetWeatherResponse) GetWeather() Weather {
+	if x != nil {
+		return x.Weather
+	}
+	return Weather_CLEAR
+}
+
+type SetWeatherRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Weather Weather                `protobuf:"varint,1,opt,name=weather,proto3,enum=fateark.proto.world.Weather" json:"weather,omitempty"`
+	// duration_ticks of zero lets the server pick a random duration.
+	DurationTicks int32 `protobuf:"varint,2,opt,name=duration_ticks,json=durationTicks,proto3" json:"duration_ticks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWeatherRequest) Reset() {
+	*x = SetWeatherRequest{}
+	mi := &file_proto_world_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWeatherRequest) ProtoMessage() {}
+
+func (x *SetWeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWeatherRequest.ProtoReflect.Descriptor instead.
+func (*SetWeatherRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetWeatherRequest) GetWeather() Weather {
+	if x != nil {
+		return x.Weather
+	}
+	return Weather_CLEAR
+}
+
+func (x *SetWeatherRequest) GetDurationTicks() int32 {
+	if x != nil {
+		return x.DurationTicks
+	}
+	return 0
+}
+
+type SetDifficultyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Difficulty    Difficulty             `protobuf:"varint,1,opt,name=difficulty,proto3,enum=fateark.proto.world.Difficulty" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDifficultyRequest) Reset() {
+	*x = SetDifficultyRequest{}
+	mi := &file_proto_world_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDifficultyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDifficultyRequest) ProtoMessage() {}
+
+func (x *SetDifficultyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDifficultyRequest.ProtoReflect.Descriptor instead.
+func (*SetDifficultyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetDifficultyRequest) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_PEACEFUL
+}
+
+type GetWorldSpawnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldSpawnRequest) Reset() {
+	*x = GetWorldSpawnRequest{}
+	mi := &file_proto_world_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldSpawnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldSpawnRequest) ProtoMessage() {}
+
+func (x *GetWorldSpawnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldSpawnRequest.ProtoReflect.Descriptor instead.
+func (*GetWorldSpawnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{10}
+}
+
+type WorldSpawn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             int32                  `protobuf:"varint,3,opt,name=z,proto3" json:"z,omitempty"`
+	Dimension     int32                  `protobuf:"varint,4,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldSpawn) Reset() {
+	*x = WorldSpawn{}
+	mi := &file_proto_world_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldSpawn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSpawn) ProtoMessage() {}
+
+func (x *WorldSpawn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSpawn.ProtoReflect.Descriptor instead.
+func (*WorldSpawn) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorldSpawn) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *WorldSpawn) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *WorldSpawn) GetZ() int32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *WorldSpawn) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+type SetWorldSpawnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z             int32                  `protobuf:"varint,3,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWorldSpawnRequest) Reset() {
+	*x = SetWorldSpawnRequest{}
+	mi := &file_proto_world_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorldSpawnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorldSpawnRequest) ProtoMessage() {}
+
+func (x *SetWorldSpawnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_world_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldSpawnRequest.ProtoReflect.Descriptor instead.
+func (*SetWorldSpawnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_world_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetWorldSpawnRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SetWorldSpawnRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SetWorldSpawnRequest) GetZ() int32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+var File_proto_world_proto protoreflect.FileDescriptor
+
+const file_proto_world_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/world.proto\x12\x13fateark.proto.world\x1a\x14proto/response.proto\"\x8a\x01\n" +
+	"\bGameRule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x02 \x01(\bH\x00R\tboolValue\x12\x1d\n" +
+	"\tint_value\x18\x03 \x01(\x05H\x00R\bintValue\x12!\n" +
+	"\vfloat_value\x18\x04 \x01(\x02H\x00R\n" +
+	"floatValueB\a\n" +
+	"\x05value\"\x15\n" +
+	"\x13GetWorldInfoRequest\"\xa3\x02\n" +
+	"\tWorldInfo\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x05R\x04time\x12\x19\n" +
+	"\bday_time\x18\x02 \x01(\x05R\adayTime\x12(\n" +
+	"\x10day_time_percent\x18\x03 \x01(\x02R\x0edayTimePercent\x12!\n" +
+	"\fcurrent_tick\x18\x04 \x01(\x03R\vcurrentTick\x12?\n" +
+	"\n" +
+	"difficulty\x18\x05 \x01(\x0e2\x1f.fateark.proto.world.DifficultyR\n" +
+	"difficulty\x12\x1b\n" +
+	"\tgame_mode\x18\x06 \x01(\x05R\bgameMode\x12<\n" +
+	"\n" +
+	"game_rules\x18\a \x03(\v2\x1d.fateark.proto.world.GameRuleR\tgameRules\"(\n" +
+	"\x12GetGameRuleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"G\n" +
+	"\x12SetGameRuleRequest\x121\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1d.fateark.proto.world.GameRuleR\x04rule\"}\n" +
+	"\x0eSetTimeRequest\x12\x16\n" +
+	"\x05ticks\x18\x01 \x01(\x05H\x00R\x05ticks\x129\n" +
+	"\x06preset\x18\x02 \x01(\x0e2\x1f.fateark.proto.world.TimePresetH\x00R\x06preset\x12\x10\n" +
+	"\x03add\x18\x03 \x01(\bR\x03addB\x06\n" +
+	"\x04time\"\x13\n" +
+	"\x11GetWeatherRequest\"L\n" +
+	"\x12GetWeatherResponse\x126\n" +
+	"\aweather\x18\x01 \x01(\x0e2\x1c.fateark.proto.world.WeatherR\aweather\"r\n" +
+	"\x11SetWeatherRequest\x126\n" +
+	"\aweather\x18\x01 \x01(\x0e2\x1c.fateark.proto.world.WeatherR\aweather\x12%\n" +
+	"\x0eduration_ticks\x18\x02 \x01(\x05R\rdurationTicks\"W\n" +
+	"\x14SetDifficultyRequest\x12?\n" +
+	"\n" +
+	"difficulty\x18\x01 \x01(\x0e2\x1f.fateark.proto.world.DifficultyR\n" +
+	"difficulty\"\x16\n" +
+	"\x14GetWorldSpawnRequest\"T\n" +
+	"\n" +
+	"WorldSpawn\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\f\n" +
+	"\x01z\x18\x03 \x01(\x05R\x01z\x12\x1c\n" +
+	"\tdimension\x18\x04 \x01(\x05R\tdimension\"@\n" +
+	"\x14SetWorldSpawnRequest\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\x12\f\n" +
+	"\x01z\x18\x03 \x01(\x05R\x01z*:\n" +
+	"\n" +
+	"Difficulty\x12\f\n" +
+	"\bPEACEFUL\x10\x00\x12\b\n" +
+	"\x04EASY\x10\x01\x12\n" +
+	"\n" +
+	"\x06NORMAL\x10\x02\x12\b\n" +
+	"\x04HARD\x10\x03*+\n" +
+	"\aWeather\x12\t\n" +
+	"\x05CLEAR\x10\x00\x12\b\n" +
+	"\x04RAIN\x10\x01\x12\v\n" +
+	"\aTHUNDER\x10\x02*Q\n" +
+	"\n" +
+	"TimePreset\x12\a\n" +
+	"\x03DAY\x10\x00\x12\b\n" +
+	"\x04NOON\x10\x01\x12\n" +
+	"\n" +
+	"\x06SUNSET\x10\x02\x12\t\n" +
+	"\x05NIGHT\x10\x03\x12\f\n" +
+	"\bMIDNIGHT\x10\x04\x12\v\n" +
+	"\aSUNRISE\x10\x052\xde\x06\n" +
+	"\fWorldService\x12X\n" +
+	"\fGetWorldInfo\x12(.fateark.proto.world.GetWorldInfoRequest\x1a\x1e.fateark.proto.world.WorldInfo\x12U\n" +
+	"\vGetGameRule\x12'.fateark.proto.world.GetGameRuleRequest\x1a\x1d.fateark.proto.world.GameRule\x12_\n" +
+	"\vSetGameRule\x12'.fateark.proto.world.SetGameRuleRequest\x1a'.fateark.proto.response.GeneralResponse\x12W\n" +
+	"\aSetTime\x12#.fateark.proto.world.SetTimeRequest\x1a'.fateark.proto.response.GeneralResponse\x12]\n" +
+	"\n" +
+	"GetWeather\x12&.fateark.proto.world.GetWeatherRequest\x1a'.fateark.proto.world.GetWeatherResponse\x12]\n" +
+	"\n" +
+	"SetWeather\x12&.fateark.proto.world.SetWeatherRequest\x1a'.fateark.proto.response.GeneralResponse\x12c\n" +
+	"\rSetDifficulty\x12).fateark.proto.world.SetDifficultyRequest\x1a'.fateark.proto.response.GeneralResponse\x12[\n" +
+	"\rGetWorldSpawn\x12).fateark.proto.world.GetWorldSpawnRequest\x1a\x1f.fateark.proto.world.WorldSpawn\x12c\n" +
+	"\rSetWorldSpawn\x12).fateark.proto.world.SetWorldSpawnRequest\x1a'.fateark.proto.response.GeneralResponseB;Z9github.com/Yeah114/tempest-core/network_api/world;worldpbb\x06proto3"
+
+var (
+	file_proto_world_proto_rawDescOnce sync.Once
+	file_proto_world_proto_rawDescData []byte
+)
+
+func file_proto_world_proto_rawDescGZIP() []byte {
+	file_proto_world_proto_rawDescOnce.Do(func() {
+		file_proto_world_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_world_proto_rawDesc), len(file_proto_world_proto_rawDesc)))
+	})
+	return file_proto_world_proto_rawDescData
+}
+
+var file_proto_world_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_world_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_world_proto_goTypes = []any{
+	(Difficulty)(0),                  // 0: fateark.proto.world.Difficulty
+	(Weather)(0),                     // 1: fateark.proto.world.Weather
+	(TimePreset)(0),                  // 2: fateark.proto.world.TimePreset
+	(*GameRule)(nil),                 // 3: fateark.proto.world.GameRule
+	(*GetWorldInfoRequest)(nil),      // 4: fateark.proto.world.GetWorldInfoRequest
+	(*WorldInfo)(nil),                // 5: fateark.proto.world.WorldInfo
+	(*GetGameRuleRequest)(nil),       // 6: fateark.proto.world.GetGameRuleRequest
+	(*SetGameRuleRequest)(nil),       // 7: fateark.proto.world.SetGameRuleRequest
+	(*SetTimeRequest)(nil),           // 8: fateark.proto.world.SetTimeRequest
+	(*GetWeatherRequest)(nil),        // 9: fateark.proto.world.GetWeatherRequest
+	(*GetWeatherResponse)(nil),       // 10: fateark.proto.world.GetWeatherResponse
+	(*SetWeatherRequest)(nil),        // 11: fateark.proto.world.SetWeatherRequest
+	(*SetDifficultyRequest)(nil),     // 12: fateark.proto.world.SetDifficultyRequest
+	(*GetWorldSpawnRequest)(nil),     // 13: fateark.proto.world.GetWorldSpawnRequest
+	(*WorldSpawn)(nil),               // 14: fateark.proto.world.WorldSpawn
+	(*SetWorldSpawnRequest)(nil),     // 15: fateark.proto.world.SetWorldSpawnRequest
+	(*response.GeneralResponse)(nil), // 16: fateark.proto.response.GeneralResponse
+}
+var file_proto_world_proto_depIdxs = []int32{
+	0,  // 0: fateark.proto.world.WorldInfo.difficulty:type_name -> fateark.proto.world.Difficulty
+	3,  // 1: fateark.proto.world.WorldInfo.game_rules:type_name -> fateark.proto.world.GameRule
+	3,  // 2: fateark.proto.world.SetGameRuleRequest.rule:type_name -> fateark.proto.world.GameRule
+	2,  // 3: fateark.proto.world.SetTimeRequest.preset:type_name -> fateark.proto.world.TimePreset
+	1,  // 4: fateark.proto.world.GetWeatherResponse.weather:type_name -> fateark.proto.world.Weather
+	1,  // 5: fateark.proto.world.SetWeatherRequest.weather:type_name -> fateark.proto.world.Weather
+	0,  // 6: fateark.proto.world.SetDifficultyRequest.difficulty:type_name -> fateark.proto.world.Difficulty
+	4,  // 7: fateark.proto.world.WorldService.GetWorldInfo:input_type -> fateark.proto.world.GetWorldInfoRequest
+	6,  // 8: fateark.proto.world.WorldService.GetGameRule:input_type -> fateark.proto.world.GetGameRuleRequest
+	7,  // 9: fateark.proto.world.WorldService.SetGameRule:input_type -> fateark.proto.world.SetGameRuleRequest
+	8,  // 10: fateark.proto.world.WorldService.SetTime:input_type -> fateark.proto.world.SetTimeRequest
+	9,  // 11: fateark.proto.world.WorldService.GetWeather:input_type -> fateark.proto.world.GetWeatherRequest
+	11, // 12: fateark.proto.world.WorldService.SetWeather:input_type -> fateark.proto.world.SetWeatherRequest
+	12, // 13: fateark.proto.world.WorldService.SetDifficulty:input_type -> fateark.proto.world.SetDifficultyRequest
+	13, // 14: fateark.proto.world.WorldService.GetWorldSpawn:input_type -> fateark.proto.world.GetWorldSpawnRequest
+	15, // 15: fateark.proto.world.WorldService.SetWorldSpawn:input_type -> fateark.proto.world.SetWorldSpawnRequest
+	5,  // 16: fateark.proto.world.WorldService.GetWorldInfo:output_type -> fateark.proto.world.WorldInfo
+	3,  // 17: fateark.proto.world.WorldService.GetGameRule:output_type -> fateark.proto.world.GameRule
+	16, // 18: fateark.proto.world.WorldService.SetGameRule:output_type -> fateark.proto.response.GeneralResponse
+	16, // 19: fateark.proto.world.WorldService.SetTime:output_type -> fateark.proto.response.GeneralResponse
+	10, // 20: fateark.proto.world.WorldService.GetWeather:output_type -> fateark.proto.world.GetWeatherResponse
+	16, // 21: fateark.proto.world.WorldService.SetWeather:output_type -> fateark.proto.response.GeneralResponse
+	16, // 22: fateark.proto.world.WorldService.SetDifficulty:output_type -> fateark.proto.response.GeneralResponse
+	14, // 23: fateark.proto.world.WorldService.GetWorldSpawn:output_type -> fateark.proto.world.WorldSpawn
+	16, // 24: fateark.proto.world.WorldService.SetWorldSpawn:output_type -> fateark.proto.response.GeneralResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_world_proto_init() }
+func file_proto_world_proto_init() {
+	if File_proto_world_proto != nil {
+		return
+	}
+	file_proto_world_proto_msgTypes[0].OneofWrappers = []any{
+		(*GameRule_BoolValue)(nil),
+		(*GameRule_IntValue)(nil),
+		(*GameRule_FloatValue)(nil),
+	}
+	file_proto_world_proto_msgTypes[5].OneofWrappers = []any{
+		(*SetTimeRequest_Ticks)(nil),
+		(*SetTimeRequest_Preset)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_world_proto_rawDesc), len(file_proto_world_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_world_proto_goTypes,
+		DependencyIndexes: file_proto_world_proto_depIdxs,
+		EnumInfos:         file_proto_world_proto_enumTypes,
+		MessageInfos:      file_proto_world_proto_msgTypes,
+	}.Build()
+	File_proto_world_proto = out.File
+	file_proto_world_proto_goTypes = nil
+	file_proto_world_proto_depIdxs = nil
+}
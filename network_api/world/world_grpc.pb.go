@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: proto/world.proto
+
+package worldpb
+
+import (
+	context "context"
+	response "github.com/Yeah114/tempest-core/network_api/response"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WorldService_GetWorldInfo_FullMethodName  = "/fateark.proto.world.WorldService/GetWorldInfo"
+	WorldService_GetGameRule_FullMethodName   = "/fateark.proto.world.WorldService/GetGameRule"
+	WorldService_SetGameRule_FullMethodName   = "/fateark.proto.world.WorldService/SetGameRule"
+	WorldService_SetTime_FullMethodName       = "/fateark.proto.world.WorldService/SetTime"
+	WorldService_GetWeather_FullMethodName    = "/fateark.proto.world.WorldService/GetWeather"
+	WorldService_SetWeather_FullMethodName    = "/fateark.proto.world.WorldService/SetWeather"
+	WorldService_SetDifficulty_FullMethodName = "/fateark.proto.world.WorldService/SetDifficulty"
+	WorldService_GetWorldSpawn_FullMethodName = "/fateark.proto.world.WorldService/GetWorldSpawn"
+	WorldService_SetWorldSpawn_FullMethodName = "/fateark.proto.world.WorldService/SetWorldSpawn"
+)
+
+// WorldServiceClient is the client API for WorldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorldServiceClient interface {
+	GetWorldInfo(ctx context.Context, in *GetWorldInfoRequest, opts ...grpc.CallOption) (*WorldInfo, error)
+	GetGameRule(ctx context.Context, in *GetGameRuleRequest, opts ...grpc.CallOption) (*GameRule, error)
+	SetGameRule(ctx context.Context, in *SetGameRuleRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	SetTime(ctx context.Context, in *SetTimeRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	GetWeather(ctx context.Context, in *GetWeatherRequest, opts ...grpc.CallOption) (*GetWeatherResponse, error)
+	SetWeather(ctx context.Context, in *SetWeatherRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	SetDifficulty(ctx context.Context, in *SetDifficultyRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+	GetWorldSpawn(ctx context.Context, in *GetWorldSpawnRequest, opts ...grpc.CallOption) (*WorldSpawn, error)
+	SetWorldSpawn(ctx context.Context, in *SetWorldSpawnRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error)
+}
+
+type worldServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorldServiceClient(cc grpc.ClientConnInterface) WorldServiceClient {
+	return &worldServiceClient{cc}
+}
+
+func (c *worldServiceClient) GetWorldInfo(ctx context.Context, in *GetWorldInfoRequest, opts ...grpc.CallOption) (*WorldInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorldInfo)
+	err := c.cc.Invoke(ctx, WorldService_GetWorldInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) GetGameRule(ctx context.Context, in *GetGameRuleRequest, opts ...grpc.CallOption) (*GameRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameRule)
+	err := c.cc.Invoke(ctx, WorldService_GetGameRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) SetGameRule(ctx context.Context, in *SetGameRuleRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(response.GeneralResponse)
+	err := c.cc.Invoke(ctx, WorldService_SetGameRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) SetTime(ctx context.Context, in *SetTimeRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(response.GeneralResponse)
+	err := c.cc.Invoke(ctx, WorldService_SetTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) GetWeather(ctx context.Context, in *GetWeatherRequest, opts ...grpc.CallOption) (*GetWeatherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWeatherResponse)
+	err := c.cc.Invoke(ctx, WorldService_GetWeather_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) SetWeather(ctx context.Context, in *SetWeatherRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(response.GeneralResponse)
+	err := c.cc.Invoke(ctx, WorldService_SetWeather_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) SetDifficulty(ctx context.Context, in *SetDifficultyRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(response.GeneralResponse)
+	err := c.cc.Invoke(ctx, WorldService_SetDifficulty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) GetWorldSpawn(ctx context.Context, in *GetWorldSpawnRequest, opts ...grpc.CallOption) (*WorldSpawn, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorldSpawn)
+	err := c.cc.Invoke(ctx, WorldService_GetWorldSpawn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *worldServiceClient) SetWorldSpawn(ctx context.Context, in *SetWorldSpawnRequest, opts ...grpc.CallOption) (*response.GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(response.GeneralResponse)
+	err := c.cc.Invoke(ctx, WorldService_SetWorldSpawn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorldServiceServer is the server API for WorldService service.
+// All implementations must embed UnimplementedWorldServiceServer
+// for forward compatibility.
+type WorldServiceServer interface {
+	GetWorldInfo(context.Context, *GetWorldInfoRequest) (*WorldInfo, error)
+	GetGameRule(context.Context, *GetGameRuleRequest) (*GameRule, error)
+	SetGameRule(context.Context, *SetGameRuleRequest) (*response.GeneralResponse, error)
+	SetTime(context.Context, *SetTimeRequest) (*response.GeneralResponse, error)
+	GetWeather(context.Context, *GetWeatherRequest) (*GetWeatherResponse, error)
+	SetWeather(context.Context, *SetWeatherRequest) (*response.GeneralResponse, error)
+	SetDifficulty(context.Context, *SetDifficultyRequest) (*response.GeneralResponse, error)
+	GetWorldSpawn(context.Context, *GetWorldSpawnRequest) (*WorldSpawn, error)
+	SetWorldSpawn(context.Context, *SetWorldSpawnRequest) (*response.GeneralResponse, error)
+	mustEmbedUnimplementedWorldServiceServer()
+}
+
+// UnimplementedWorldServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorldServiceServer struct{}
+
+func (UnimplementedWorldServiceServer) GetWorldInfo(context.Context, *GetWorldInfoRequest) (*WorldInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorldInfo not implemented")
+}
+func (UnimplementedWorldServiceServer) GetGameRule(context.Context, *GetGameRuleRequest) (*GameRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameRule not implemented")
+}
+func (UnimplementedWorldServiceServer) SetGameRule(context.Context, *SetGameRuleRequest) (*response.GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameRule not implemented")
+}
+func (UnimplementedWorldServiceServer) SetTime(context.Context, *SetTimeRequest) (*response.GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTime not implemented")
+}
+func (UnimplementedWorldServiceServer) GetWeather(context.Context, *GetWeatherRequest) (*GetWeatherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeather not implemented")
+}
+func (UnimplementedWorldServiceServer) SetWeather(context.Context, *SetWeatherRequest) (*response.GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWeather not implemented")
+}
+func (UnimplementedWorldServiceServer) SetDifficulty(context.Context, *SetDifficultyRequest) (*response.GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDifficulty not implemented")
+}
+func (UnimplementedWorldServiceServer) GetWorldSpawn(context.Context, *GetWorldSpawnRequest) (*WorldSpawn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorldSpawn not implemented")
+}
+func (UnimplementedWorldServiceServer) SetWorldSpawn(context.Context, *SetWorldSpawnRequest) (*response.GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorldSpawn not implemented")
+}
+func (UnimplementedWorldServiceServer) mustEmbedUnimplementedWorldServiceServer() {}
+func (UnimplementedWorldServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeWorldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorldServiceServer will
+// result in compilation errors.
+type UnsafeWorldServiceServer interface {
+	mustEmbedUnimplementedWorldServiceServer()
+}
+
+func RegisterWorldServiceServer(s grpc.ServiceRegistrar, srv WorldServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorldServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorldService_ServiceDesc, srv)
+}
+
+func _WorldService_GetWorldInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorldInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).GetWorldInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_GetWorldInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).GetWorldInfo(ctx, req.(*GetWorldInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_GetGameRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).GetGameRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_GetGameRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).GetGameRule(ctx, req.(*GetGameRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_SetGameRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGameRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).SetGameRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_SetGameRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).SetGameRule(ctx, req.(*SetGameRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_SetTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).SetTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_SetTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).SetTime(ctx, req.(*SetTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_GetWeather_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeatherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).GetWeather(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_GetWeather_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).GetWeather(ctx, req.(*GetWeatherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_SetWeather_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWeatherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).SetWeather(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_SetWeather_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).SetWeather(ctx, req.(*SetWeatherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_SetDifficulty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDifficultyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).SetDifficulty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_SetDifficulty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).SetDifficulty(ctx, req.(*SetDifficultyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_GetWorldSpawn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorldSpawnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).GetWorldSpawn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_GetWorldSpawn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).GetWorldSpawn(ctx, req.(*GetWorldSpawnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorldService_SetWorldSpawn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorldSpawnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldServiceServer).SetWorldSpawn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldService_SetWorldSpawn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldServiceServer).SetWorldSpawn(ctx, req.(*SetWorldSpawnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorldService_ServiceDesc is the grpc.ServiceDesc for WorldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fateark.proto.world.WorldService",
+	HandlerType: (*WorldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorldInfo",
+			Handler:    _WorldService_GetWorldInfo_Handler,
+		},
+		{
+			MethodName: "GetGameRule",
+			Handler:    _WorldService_GetGameRule_Handler,
+		},
+		{
+			MethodName: "SetGameRule",
+			Handler:    _WorldService_SetGameRule_Handler,
+		},
+		{
+			MethodName: "SetTime",
+			Handler:    _WorldService_SetTime_Handler,
+		},
+		{
+			MethodName: "GetWeather",
+			Handler:    _WorldService_GetWeather_Handler,
+		},
+		{
+			MethodName: "SetWeather",
+			Handler:    _WorldService_SetWeather_Handler,
+		},
+		{
+			MethodName: "SetDifficulty",
+			Handler:    _WorldService_SetDifficulty_Handler,
+		},
+		{
+			MethodName: "GetWorldSpawn",
+			Handler:    _WorldService_GetWorldSpawn_Handler,
+		},
+		{
+			MethodName: "SetWorldSpawn",
+			Handler:    _WorldService_SetWorldSpawn_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/world.proto",
+}